@@ -16,6 +16,9 @@ var _states_scenes: Dictionary[State, String] = {
 	State.BROWSING_HISCORES: "res://states/browsing_hiscores/browsing_hiscores.tscn",
 }
 
+#Sent to the server when connecting, it can turn away versions that are too old
+const VERSION := "1.1.0"
+
 var client_id: int
 var _current_scene_root: Node
 
@@ -1036,6 +1036,16 @@ class PlayerMessage:
 		service.field = __color
 		data[__color.tag] = service
 		
+		__skin_id = PBField.new("skin_id", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 9, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __skin_id
+		data[__skin_id.tag] = service
+		
+		__teleport = PBField.new("teleport", PB_DATA_TYPE.BOOL, PB_RULE.OPTIONAL, 10, true, DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL])
+		service = PBServiceField.new()
+		service.field = __teleport
+		data[__teleport.tag] = service
+		
 	var data = {}
 	
 	var __id: PBField
@@ -1142,6 +1152,32 @@ class PlayerMessage:
 	func set_color(value : int) -> void:
 		__color.value = value
 	
+	var __skin_id: PBField
+	func has_skin_id() -> bool:
+		if __skin_id.value != null:
+			return true
+		return false
+	func get_skin_id() -> int:
+		return __skin_id.value
+	func clear_skin_id() -> void:
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__skin_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_skin_id(value : int) -> void:
+		__skin_id.value = value
+	
+	var __teleport: PBField
+	func has_teleport() -> bool:
+		if __teleport.value != null:
+			return true
+		return false
+	func get_teleport() -> bool:
+		return __teleport.value
+	func clear_teleport() -> void:
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__teleport.value = DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL]
+	func set_teleport(value : bool) -> void:
+		__teleport.value = value
+	
 	func _to_string() -> String:
 		return PBPacker.message_to_string(data)
 		
@@ -1307,6 +1343,14 @@ class SporeMessage:
 			return PB_ERR.PARSE_INCOMPLETE
 		return result
 	
+enum ConsumeFeedback {
+	NONE = 0,
+	SMALL_SPORE = 1,
+	BIG_SPORE = 2,
+	SMALL_PLAYER = 3,
+	BIG_PLAYER = 4
+}
+
 class SporeConsumedMessage:
 	func _init():
 		var service
@@ -1316,6 +1360,16 @@ class SporeConsumedMessage:
 		service.field = __spore_id
 		data[__spore_id.tag] = service
 		
+		__feedback = PBField.new("feedback", PB_DATA_TYPE.ENUM, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.ENUM])
+		service = PBServiceField.new()
+		service.field = __feedback
+		data[__feedback.tag] = service
+		
+		__mass = PBField.new("mass", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = __mass
+		data[__mass.tag] = service
+		
 	var data = {}
 	
 	var __spore_id: PBField
@@ -1331,6 +1385,110 @@ class SporeConsumedMessage:
 	func set_spore_id(value : int) -> void:
 		__spore_id.value = value
 	
+	var __feedback: PBField
+	func has_feedback() -> bool:
+		if __feedback.value != null:
+			return true
+		return false
+	func get_feedback():
+		return __feedback.value
+	func clear_feedback() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__feedback.value = DEFAULT_VALUES_3[PB_DATA_TYPE.ENUM]
+	func set_feedback(value) -> void:
+		__feedback.value = value
+	
+	var __mass: PBField
+	func has_mass() -> bool:
+		if __mass.value != null:
+			return true
+		return false
+	func get_mass() -> float:
+		return __mass.value
+	func clear_mass() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_mass(value : float) -> void:
+		__mass.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class BatchConsumeMessage:
+	func _init():
+		var service
+		
+		var __spore_ids_default: Array[int] = []
+		__spore_ids = PBField.new("spore_ids", PB_DATA_TYPE.UINT64, PB_RULE.REPEATED, 1, true, __spore_ids_default)
+		service = PBServiceField.new()
+		service.field = __spore_ids
+		data[__spore_ids.tag] = service
+		
+		__mass = PBField.new("mass", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = __mass
+		data[__mass.tag] = service
+		
+		__feedback = PBField.new("feedback", PB_DATA_TYPE.ENUM, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.ENUM])
+		service = PBServiceField.new()
+		service.field = __feedback
+		data[__feedback.tag] = service
+		
+	var data = {}
+	
+	var __spore_ids: PBField
+	func get_spore_ids() -> Array[int]:
+		return __spore_ids.value
+	func clear_spore_ids() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__spore_ids.value.clear()
+	func add_spore_ids(value : int) -> void:
+		__spore_ids.value.append(value)
+	
+	var __mass: PBField
+	func has_mass() -> bool:
+		if __mass.value != null:
+			return true
+		return false
+	func get_mass() -> float:
+		return __mass.value
+	func clear_mass() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_mass(value : float) -> void:
+		__mass.value = value
+	
+	var __feedback: PBField
+	func has_feedback() -> bool:
+		if __feedback.value != null:
+			return true
+		return false
+	func get_feedback():
+		return __feedback.value
+	func clear_feedback() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__feedback.value = DEFAULT_VALUES_3[PB_DATA_TYPE.ENUM]
+	func set_feedback(value) -> void:
+		__feedback.value = value
+	
 	func _to_string() -> String:
 		return PBPacker.message_to_string(data)
 		
@@ -1406,6 +1564,16 @@ class PlayerConsumedMessage:
 		service.field = __player_id
 		data[__player_id.tag] = service
 		
+		__feedback = PBField.new("feedback", PB_DATA_TYPE.ENUM, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.ENUM])
+		service = PBServiceField.new()
+		service.field = __feedback
+		data[__feedback.tag] = service
+		
+		__mass = PBField.new("mass", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = __mass
+		data[__mass.tag] = service
+		
 	var data = {}
 	
 	var __player_id: PBField
@@ -1421,6 +1589,32 @@ class PlayerConsumedMessage:
 	func set_player_id(value : int) -> void:
 		__player_id.value = value
 	
+	var __feedback: PBField
+	func has_feedback() -> bool:
+		if __feedback.value != null:
+			return true
+		return false
+	func get_feedback():
+		return __feedback.value
+	func clear_feedback() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__feedback.value = DEFAULT_VALUES_3[PB_DATA_TYPE.ENUM]
+	func set_feedback(value) -> void:
+		__feedback.value = value
+	
+	var __mass: PBField
+	func has_mass() -> bool:
+		if __mass.value != null:
+			return true
+		return false
+	func get_mass() -> float:
+		return __mass.value
+	func clear_mass() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_mass(value : float) -> void:
+		__mass.value = value
+	
 	func _to_string() -> String:
 		return PBPacker.message_to_string(data)
 		
@@ -1676,6 +1870,21 @@ class DisconnectMessage:
 		service.field = __reason
 		data[__reason.tag] = service
 		
+		__in_game = PBField.new("in_game", PB_DATA_TYPE.BOOL, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL])
+		service = PBServiceField.new()
+		service.field = __in_game
+		data[__in_game.tag] = service
+		
+		__x = PBField.new("x", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = __x
+		data[__x.tag] = service
+		
+		__y = PBField.new("y", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = __y
+		data[__y.tag] = service
+		
 	var data = {}
 	
 	var __reason: PBField
@@ -1691,6 +1900,45 @@ class DisconnectMessage:
 	func set_reason(value : String) -> void:
 		__reason.value = value
 	
+	var __in_game: PBField
+	func has_in_game() -> bool:
+		if __in_game.value != null:
+			return true
+		return false
+	func get_in_game() -> bool:
+		return __in_game.value
+	func clear_in_game() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__in_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL]
+	func set_in_game(value : bool) -> void:
+		__in_game.value = value
+	
+	var __x: PBField
+	func has_x() -> bool:
+		if __x.value != null:
+			return true
+		return false
+	func get_x() -> float:
+		return __x.value
+	func clear_x() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__x.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_x(value : float) -> void:
+		__x.value = value
+	
+	var __y: PBField
+	func has_y() -> bool:
+		if __y.value != null:
+			return true
+		return false
+	func get_y() -> float:
+		return __y.value
+	func clear_y() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__y.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_y(value : float) -> void:
+		__y.value = value
+	
 	func _to_string() -> String:
 		return PBPacker.message_to_string(data)
 		
@@ -1712,150 +1960,10057 @@ class DisconnectMessage:
 			return PB_ERR.PARSE_INCOMPLETE
 		return result
 	
-class Packet:
+enum EmoteType {
+	EMOTE_WAVE = 0,
+	EMOTE_LAUGH = 1,
+	EMOTE_ANGRY = 2,
+	EMOTE_SAD = 3,
+	EMOTE_GG = 4
+}
+
+class EmoteMessage:
 	func _init():
 		var service
 		
-		__sender_id = PBField.new("sender_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
-		service = PBServiceField.new()
-		service.field = __sender_id
-		data[__sender_id.tag] = service
-		
-		__chat = PBField.new("chat", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
-		service = PBServiceField.new()
-		service.field = __chat
-		service.func_ref = Callable(self, "new_chat")
-		data[__chat.tag] = service
-		
-		__id = PBField.new("id", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
-		service = PBServiceField.new()
-		service.field = __id
-		service.func_ref = Callable(self, "new_id")
-		data[__id.tag] = service
-		
-		__login_request = PBField.new("login_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		__emote = PBField.new("emote", PB_DATA_TYPE.ENUM, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.ENUM])
 		service = PBServiceField.new()
-		service.field = __login_request
-		service.func_ref = Callable(self, "new_login_request")
-		data[__login_request.tag] = service
+		service.field = __emote
+		data[__emote.tag] = service
 		
-		__register_request = PBField.new("register_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 5, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
-		service = PBServiceField.new()
-		service.field = __register_request
-		service.func_ref = Callable(self, "new_register_request")
-		data[__register_request.tag] = service
+	var data = {}
+	
+	var __emote: PBField
+	func has_emote() -> bool:
+		if __emote.value != null:
+			return true
+		return false
+	func get_emote():
+		return __emote.value
+	func clear_emote() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.ENUM]
+	func set_emote(value) -> void:
+		__emote.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
 		
-		__ok_response = PBField.new("ok_response", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 6, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
-		service = PBServiceField.new()
-		service.field = __ok_response
-		service.func_ref = Callable(self, "new_ok_response")
-		data[__ok_response.tag] = service
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
 		
-		__deny_response = PBField.new("deny_response", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 7, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
-		service = PBServiceField.new()
-		service.field = __deny_response
-		service.func_ref = Callable(self, "new_deny_response")
-		data[__deny_response.tag] = service
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class RequestStatsMessage:
+	func _init():
+		var service
 		
-		__player = PBField.new("player", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 8, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		__name = PBField.new("name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
 		service = PBServiceField.new()
-		service.field = __player
-		service.func_ref = Callable(self, "new_player")
-		data[__player.tag] = service
+		service.field = __name
+		data[__name.tag] = service
 		
-		__player_direction = PBField.new("player_direction", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 9, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
-		service = PBServiceField.new()
-		service.field = __player_direction
-		service.func_ref = Callable(self, "new_player_direction")
-		data[__player_direction.tag] = service
+	var data = {}
+	
+	var __name: PBField
+	func has_name() -> bool:
+		if __name.value != null:
+			return true
+		return false
+	func get_name() -> String:
+		return __name.value
+	func clear_name() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_name(value : String) -> void:
+		__name.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
 		
-		__spore = PBField.new("spore", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 10, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
-		service = PBServiceField.new()
-		service.field = __spore
-		service.func_ref = Callable(self, "new_spore")
-		data[__spore.tag] = service
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
 		
-		__spore_consumed = PBField.new("spore_consumed", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 11, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
-		service = PBServiceField.new()
-		service.field = __spore_consumed
-		service.func_ref = Callable(self, "new_spore_consumed")
-		data[__spore_consumed.tag] = service
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class EnterGameMessage:
+	func _init():
+		var service
 		
-		__spores_batch = PBField.new("spores_batch", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 12, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		__name = PBField.new("name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
 		service = PBServiceField.new()
-		service.field = __spores_batch
-		service.func_ref = Callable(self, "new_spores_batch")
-		data[__spores_batch.tag] = service
+		service.field = __name
+		data[__name.tag] = service
 		
-		__player_consumed = PBField.new("player_consumed", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 13, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
-		service = PBServiceField.new()
-		service.field = __player_consumed
-		service.func_ref = Callable(self, "new_player_consumed")
-		data[__player_consumed.tag] = service
+	var data = {}
+	
+	var __name: PBField
+	func has_name() -> bool:
+		if __name.value != null:
+			return true
+		return false
+	func get_name() -> String:
+		return __name.value
+	func clear_name() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_name(value : String) -> void:
+		__name.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
 		
-		__hiscore_board_request = PBField.new("hiscore_board_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 14, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class WorldBoundsMessage:
+	func _init():
+		var service
+		
+		__bound = PBField.new("bound", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
 		service = PBServiceField.new()
-		service.field = __hiscore_board_request
-		service.func_ref = Callable(self, "new_hiscore_board_request")
-		data[__hiscore_board_request.tag] = service
+		service.field = __bound
+		data[__bound.tag] = service
 		
-		__hiscore = PBField.new("hiscore", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 15, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+	var data = {}
+	
+	var __bound: PBField
+	func has_bound() -> bool:
+		if __bound.value != null:
+			return true
+		return false
+	func get_bound() -> float:
+		return __bound.value
+	func clear_bound() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__bound.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_bound(value : float) -> void:
+		__bound.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class KillFeedMessage:
+	func _init():
+		var service
+		
+		__consumer_id = PBField.new("consumer_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
 		service = PBServiceField.new()
-		service.field = __hiscore
-		service.func_ref = Callable(self, "new_hiscore")
-		data[__hiscore.tag] = service
+		service.field = __consumer_id
+		data[__consumer_id.tag] = service
 		
-		__hiscore_board = PBField.new("hiscore_board", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 16, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		__consumer_name = PBField.new("consumer_name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
 		service = PBServiceField.new()
-		service.field = __hiscore_board
-		service.func_ref = Callable(self, "new_hiscore_board")
-		data[__hiscore_board.tag] = service
+		service.field = __consumer_name
+		data[__consumer_name.tag] = service
 		
-		__finished_browsing_hiscores = PBField.new("finished_browsing_hiscores", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 17, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		__victim_id = PBField.new("victim_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
 		service = PBServiceField.new()
-		service.field = __finished_browsing_hiscores
-		service.func_ref = Callable(self, "new_finished_browsing_hiscores")
-		data[__finished_browsing_hiscores.tag] = service
+		service.field = __victim_id
+		data[__victim_id.tag] = service
 		
-		__search_hiscore = PBField.new("search_hiscore", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 18, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		__victim_name = PBField.new("victim_name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
 		service = PBServiceField.new()
-		service.field = __search_hiscore
-		service.func_ref = Callable(self, "new_search_hiscore")
-		data[__search_hiscore.tag] = service
+		service.field = __victim_name
+		data[__victim_name.tag] = service
 		
-		__disconnect = PBField.new("disconnect", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 19, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+	var data = {}
+	
+	var __consumer_id: PBField
+	func has_consumer_id() -> bool:
+		if __consumer_id.value != null:
+			return true
+		return false
+	func get_consumer_id() -> int:
+		return __consumer_id.value
+	func clear_consumer_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__consumer_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_consumer_id(value : int) -> void:
+		__consumer_id.value = value
+	
+	var __consumer_name: PBField
+	func has_consumer_name() -> bool:
+		if __consumer_name.value != null:
+			return true
+		return false
+	func get_consumer_name() -> String:
+		return __consumer_name.value
+	func clear_consumer_name() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__consumer_name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_consumer_name(value : String) -> void:
+		__consumer_name.value = value
+	
+	var __victim_id: PBField
+	func has_victim_id() -> bool:
+		if __victim_id.value != null:
+			return true
+		return false
+	func get_victim_id() -> int:
+		return __victim_id.value
+	func clear_victim_id() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__victim_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_victim_id(value : int) -> void:
+		__victim_id.value = value
+	
+	var __victim_name: PBField
+	func has_victim_name() -> bool:
+		if __victim_name.value != null:
+			return true
+		return false
+	func get_victim_name() -> String:
+		return __victim_name.value
+	func clear_victim_name() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__victim_name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_victim_name(value : String) -> void:
+		__victim_name.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class GameConfigMessage:
+	func _init():
+		var service
+		
+		__world_bound = PBField.new("world_bound", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
 		service = PBServiceField.new()
-		service.field = __disconnect
-		service.func_ref = Callable(self, "new_disconnect")
-		data[__disconnect.tag] = service
+		service.field = __world_bound
+		data[__world_bound.tag] = service
 		
 	var data = {}
 	
-	var __sender_id: PBField
-	func has_sender_id() -> bool:
-		if __sender_id.value != null:
+	var __world_bound: PBField
+	func has_world_bound() -> bool:
+		if __world_bound.value != null:
 			return true
 		return false
-	func get_sender_id() -> int:
-		return __sender_id.value
-	func clear_sender_id() -> void:
+	func get_world_bound() -> float:
+		return __world_bound.value
+	func clear_world_bound() -> void:
 		data[1].state = PB_SERVICE_STATE.UNFILLED
-		__sender_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
-	func set_sender_id(value : int) -> void:
-		__sender_id.value = value
+		__world_bound.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_world_bound(value : float) -> void:
+		__world_bound.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class LeaderboardEntryMessage:
+	func _init():
+		var service
+		
+		__id = PBField.new("id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __id
+		data[__id.tag] = service
+		
+		__name = PBField.new("name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __name
+		data[__name.tag] = service
+		
+		__mass = PBField.new("mass", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __mass
+		data[__mass.tag] = service
+		
+		__rank = PBField.new("rank", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __rank
+		data[__rank.tag] = service
+		
+	var data = {}
+	
+	var __id: PBField
+	func has_id() -> bool:
+		if __id.value != null:
+			return true
+		return false
+	func get_id() -> int:
+		return __id.value
+	func clear_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_id(value : int) -> void:
+		__id.value = value
+	
+	var __name: PBField
+	func has_name() -> bool:
+		if __name.value != null:
+			return true
+		return false
+	func get_name() -> String:
+		return __name.value
+	func clear_name() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_name(value : String) -> void:
+		__name.value = value
+	
+	var __mass: PBField
+	func has_mass() -> bool:
+		if __mass.value != null:
+			return true
+		return false
+	func get_mass() -> int:
+		return __mass.value
+	func clear_mass() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_mass(value : int) -> void:
+		__mass.value = value
+	
+	var __rank: PBField
+	func has_rank() -> bool:
+		if __rank.value != null:
+			return true
+		return false
+	func get_rank() -> int:
+		return __rank.value
+	func clear_rank() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_rank(value : int) -> void:
+		__rank.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class LeaderboardMessage:
+	func _init():
+		var service
+		
+		var __entries_default: Array[LeaderboardEntryMessage] = []
+		__entries = PBField.new("entries", PB_DATA_TYPE.MESSAGE, PB_RULE.REPEATED, 1, true, __entries_default)
+		service = PBServiceField.new()
+		service.field = __entries
+		service.func_ref = Callable(self, "add_entries")
+		data[__entries.tag] = service
+		
+	var data = {}
+	
+	var __entries: PBField
+	func get_entries() -> Array[LeaderboardEntryMessage]:
+		return __entries.value
+	func clear_entries() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__entries.value.clear()
+	func add_entries() -> LeaderboardEntryMessage:
+		var element = LeaderboardEntryMessage.new()
+		__entries.value.append(element)
+		return element
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class LeaderboardDeltaMessage:
+	func _init():
+		var service
+		
+		var __changed_default: Array[LeaderboardEntryMessage] = []
+		__changed = PBField.new("changed", PB_DATA_TYPE.MESSAGE, PB_RULE.REPEATED, 1, true, __changed_default)
+		service = PBServiceField.new()
+		service.field = __changed
+		service.func_ref = Callable(self, "add_changed")
+		data[__changed.tag] = service
+		
+		var __removed_ids_default: Array[int] = []
+		__removed_ids = PBField.new("removed_ids", PB_DATA_TYPE.UINT64, PB_RULE.REPEATED, 2, true, __removed_ids_default)
+		service = PBServiceField.new()
+		service.field = __removed_ids
+		data[__removed_ids.tag] = service
+		
+	var data = {}
+	
+	var __changed: PBField
+	func get_changed() -> Array[LeaderboardEntryMessage]:
+		return __changed.value
+	func clear_changed() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__changed.value.clear()
+	func add_changed() -> LeaderboardEntryMessage:
+		var element = LeaderboardEntryMessage.new()
+		__changed.value.append(element)
+		return element
+	
+	var __removed_ids: PBField
+	func get_removed_ids() -> Array[int]:
+		return __removed_ids.value
+	func clear_removed_ids() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__removed_ids.value.clear()
+	func add_removed_ids(value : int) -> void:
+		__removed_ids.value.append(value)
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class ClientInfoMessage:
+	func _init():
+		var service
+		
+		__version = PBField.new("version", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __version
+		data[__version.tag] = service
+		
+		__supports_acks = PBField.new("supports_acks", PB_DATA_TYPE.BOOL, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL])
+		service = PBServiceField.new()
+		service.field = __supports_acks
+		data[__supports_acks.tag] = service
+		
+	var data = {}
+	
+	var __version: PBField
+	func has_version() -> bool:
+		if __version.value != null:
+			return true
+		return false
+	func get_version() -> String:
+		return __version.value
+	func clear_version() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__version.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_version(value : String) -> void:
+		__version.value = value
+	
+	var __supports_acks: PBField
+	func has_supports_acks() -> bool:
+		if __supports_acks.value != null:
+			return true
+		return false
+	func get_supports_acks() -> bool:
+		return __supports_acks.value
+	func clear_supports_acks() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__supports_acks.value = DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL]
+	func set_supports_acks(value : bool) -> void:
+		__supports_acks.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class AckMessage:
+	func _init():
+		var service
+		
+		__seq = PBField.new("seq", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __seq
+		data[__seq.tag] = service
+		
+	var data = {}
+	
+	var __seq: PBField
+	func has_seq() -> bool:
+		if __seq.value != null:
+			return true
+		return false
+	func get_seq() -> int:
+		return __seq.value
+	func clear_seq() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__seq.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_seq(value : int) -> void:
+		__seq.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class UpdateRequiredMessage:
+	func _init():
+		var service
+		
+		__min_version = PBField.new("min_version", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __min_version
+		data[__min_version.tag] = service
+		
+	var data = {}
+	
+	var __min_version: PBField
+	func has_min_version() -> bool:
+		if __min_version.value != null:
+			return true
+		return false
+	func get_min_version() -> String:
+		return __min_version.value
+	func clear_min_version() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__min_version.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_min_version(value : String) -> void:
+		__min_version.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class MinimapPlayerMessage:
+	func _init():
+		var service
+		
+		__id = PBField.new("id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __id
+		data[__id.tag] = service
+		
+		__cell_x = PBField.new("cell_x", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __cell_x
+		data[__cell_x.tag] = service
+		
+		__cell_y = PBField.new("cell_y", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __cell_y
+		data[__cell_y.tag] = service
+		
+		__mass = PBField.new("mass", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __mass
+		data[__mass.tag] = service
+		
+	var data = {}
+	
+	var __id: PBField
+	func has_id() -> bool:
+		if __id.value != null:
+			return true
+		return false
+	func get_id() -> int:
+		return __id.value
+	func clear_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_id(value : int) -> void:
+		__id.value = value
+	
+	var __cell_x: PBField
+	func has_cell_x() -> bool:
+		if __cell_x.value != null:
+			return true
+		return false
+	func get_cell_x() -> int:
+		return __cell_x.value
+	func clear_cell_x() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__cell_x.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_cell_x(value : int) -> void:
+		__cell_x.value = value
+	
+	var __cell_y: PBField
+	func has_cell_y() -> bool:
+		if __cell_y.value != null:
+			return true
+		return false
+	func get_cell_y() -> int:
+		return __cell_y.value
+	func clear_cell_y() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__cell_y.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_cell_y(value : int) -> void:
+		__cell_y.value = value
+	
+	var __mass: PBField
+	func has_mass() -> bool:
+		if __mass.value != null:
+			return true
+		return false
+	func get_mass() -> int:
+		return __mass.value
+	func clear_mass() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_mass(value : int) -> void:
+		__mass.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class MinimapMessage:
+	func _init():
+		var service
+		
+		__grid_size = PBField.new("grid_size", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __grid_size
+		data[__grid_size.tag] = service
+		
+		var __players_default: Array[MinimapPlayerMessage] = []
+		__players = PBField.new("players", PB_DATA_TYPE.MESSAGE, PB_RULE.REPEATED, 2, true, __players_default)
+		service = PBServiceField.new()
+		service.field = __players
+		service.func_ref = Callable(self, "add_players")
+		data[__players.tag] = service
+		
+		var __spore_density_default: Array[int] = []
+		__spore_density = PBField.new("spore_density", PB_DATA_TYPE.UINT32, PB_RULE.REPEATED, 3, true, __spore_density_default)
+		service = PBServiceField.new()
+		service.field = __spore_density
+		data[__spore_density.tag] = service
+		
+	var data = {}
+	
+	var __grid_size: PBField
+	func has_grid_size() -> bool:
+		if __grid_size.value != null:
+			return true
+		return false
+	func get_grid_size() -> int:
+		return __grid_size.value
+	func clear_grid_size() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__grid_size.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_grid_size(value : int) -> void:
+		__grid_size.value = value
+	
+	var __players: PBField
+	func get_players() -> Array[MinimapPlayerMessage]:
+		return __players.value
+	func clear_players() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__players.value.clear()
+	func add_players() -> MinimapPlayerMessage:
+		var element = MinimapPlayerMessage.new()
+		__players.value.append(element)
+		return element
+	
+	var __spore_density: PBField
+	func get_spore_density() -> Array[int]:
+		return __spore_density.value
+	func clear_spore_density() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__spore_density.value.clear()
+	func add_spore_density(value : int) -> void:
+		__spore_density.value.append(value)
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class ErrorMessage:
+	func _init():
+		var service
+		
+		__message = PBField.new("message", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __message
+		data[__message.tag] = service
+		
+	var data = {}
+	
+	var __message: PBField
+	func has_message() -> bool:
+		if __message.value != null:
+			return true
+		return false
+	func get_message() -> String:
+		return __message.value
+	func clear_message() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__message.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_message(value : String) -> void:
+		__message.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class KickMessage:
+	func _init():
+		var service
+		
+		__reason = PBField.new("reason", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __reason
+		data[__reason.tag] = service
+		
+	var data = {}
+	
+	var __reason: PBField
+	func has_reason() -> bool:
+		if __reason.value != null:
+			return true
+		return false
+	func get_reason() -> String:
+		return __reason.value
+	func clear_reason() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__reason.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_reason(value : String) -> void:
+		__reason.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class CountdownMessage:
+	func _init():
+		var service
+		
+		__seconds = PBField.new("seconds", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __seconds
+		data[__seconds.tag] = service
+		
+	var data = {}
+	
+	var __seconds: PBField
+	func has_seconds() -> bool:
+		if __seconds.value != null:
+			return true
+		return false
+	func get_seconds() -> int:
+		return __seconds.value
+	func clear_seconds() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__seconds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_seconds(value : int) -> void:
+		__seconds.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class ReconnectMessage:
+	func _init():
+		var service
+		
+		__client_id = PBField.new("client_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __client_id
+		data[__client_id.tag] = service
+		
+		__secret = PBField.new("secret", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __secret
+		data[__secret.tag] = service
+		
+	var data = {}
+	
+	var __client_id: PBField
+	func has_client_id() -> bool:
+		if __client_id.value != null:
+			return true
+		return false
+	func get_client_id() -> int:
+		return __client_id.value
+	func clear_client_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__client_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_client_id(value : int) -> void:
+		__client_id.value = value
+	
+	var __secret: PBField
+	func has_secret() -> bool:
+		if __secret.value != null:
+			return true
+		return false
+	func get_secret() -> String:
+		return __secret.value
+	func clear_secret() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__secret.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_secret(value : String) -> void:
+		__secret.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+enum SelfEcho {
+	ALWAYS = 0,
+	OFF = 1,
+	ON_DIVERGENCE = 2
+}
+
+class SettingsMessage:
+	func _init():
+		var service
+		
+		__chat_enabled = PBField.new("chat_enabled", PB_DATA_TYPE.BOOL, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL])
+		service = PBServiceField.new()
+		service.field = __chat_enabled
+		data[__chat_enabled.tag] = service
+		
+		__minimap_enabled = PBField.new("minimap_enabled", PB_DATA_TYPE.BOOL, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL])
+		service = PBServiceField.new()
+		service.field = __minimap_enabled
+		data[__minimap_enabled.tag] = service
+		
+		__auto_collect = PBField.new("auto_collect", PB_DATA_TYPE.BOOL, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL])
+		service = PBServiceField.new()
+		service.field = __auto_collect
+		data[__auto_collect.tag] = service
+		
+		__color = PBField.new("color", PB_DATA_TYPE.INT32, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.INT32])
+		service = PBServiceField.new()
+		service.field = __color
+		data[__color.tag] = service
+		
+		__skin_id = PBField.new("skin_id", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 5, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __skin_id
+		data[__skin_id.tag] = service
+		
+		__self_echo = PBField.new("self_echo", PB_DATA_TYPE.ENUM, PB_RULE.OPTIONAL, 6, true, DEFAULT_VALUES_3[PB_DATA_TYPE.ENUM])
+		service = PBServiceField.new()
+		service.field = __self_echo
+		data[__self_echo.tag] = service
+		
+		__haptics_enabled = PBField.new("haptics_enabled", PB_DATA_TYPE.BOOL, PB_RULE.OPTIONAL, 7, true, DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL])
+		service = PBServiceField.new()
+		service.field = __haptics_enabled
+		data[__haptics_enabled.tag] = service
+		
+	var data = {}
+	
+	var __chat_enabled: PBField
+	func has_chat_enabled() -> bool:
+		if __chat_enabled.value != null:
+			return true
+		return false
+	func get_chat_enabled() -> bool:
+		return __chat_enabled.value
+	func clear_chat_enabled() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__chat_enabled.value = DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL]
+	func set_chat_enabled(value : bool) -> void:
+		__chat_enabled.value = value
+	
+	var __minimap_enabled: PBField
+	func has_minimap_enabled() -> bool:
+		if __minimap_enabled.value != null:
+			return true
+		return false
+	func get_minimap_enabled() -> bool:
+		return __minimap_enabled.value
+	func clear_minimap_enabled() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__minimap_enabled.value = DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL]
+	func set_minimap_enabled(value : bool) -> void:
+		__minimap_enabled.value = value
+	
+	var __auto_collect: PBField
+	func has_auto_collect() -> bool:
+		if __auto_collect.value != null:
+			return true
+		return false
+	func get_auto_collect() -> bool:
+		return __auto_collect.value
+	func clear_auto_collect() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__auto_collect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL]
+	func set_auto_collect(value : bool) -> void:
+		__auto_collect.value = value
+	
+	var __color: PBField
+	func has_color() -> bool:
+		if __color.value != null:
+			return true
+		return false
+	func get_color() -> int:
+		return __color.value
+	func clear_color() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.INT32]
+	func set_color(value : int) -> void:
+		__color.value = value
+	
+	var __skin_id: PBField
+	func has_skin_id() -> bool:
+		if __skin_id.value != null:
+			return true
+		return false
+	func get_skin_id() -> int:
+		return __skin_id.value
+	func clear_skin_id() -> void:
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__skin_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_skin_id(value : int) -> void:
+		__skin_id.value = value
+	
+	var __self_echo: PBField
+	func has_self_echo() -> bool:
+		if __self_echo.value != null:
+			return true
+		return false
+	func get_self_echo():
+		return __self_echo.value
+	func clear_self_echo() -> void:
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__self_echo.value = DEFAULT_VALUES_3[PB_DATA_TYPE.ENUM]
+	func set_self_echo(value) -> void:
+		__self_echo.value = value
+	
+	var __haptics_enabled: PBField
+	func has_haptics_enabled() -> bool:
+		if __haptics_enabled.value != null:
+			return true
+		return false
+	func get_haptics_enabled() -> bool:
+		return __haptics_enabled.value
+	func clear_haptics_enabled() -> void:
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__haptics_enabled.value = DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL]
+	func set_haptics_enabled(value : bool) -> void:
+		__haptics_enabled.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class SporesDespawnedMessage:
+	func _init():
+		var service
+		
+		var __spore_ids_default: Array[int] = []
+		__spore_ids = PBField.new("spore_ids", PB_DATA_TYPE.UINT64, PB_RULE.REPEATED, 1, true, __spore_ids_default)
+		service = PBServiceField.new()
+		service.field = __spore_ids
+		data[__spore_ids.tag] = service
+		
+	var data = {}
+	
+	var __spore_ids: PBField
+	func get_spore_ids() -> Array[int]:
+		return __spore_ids.value
+	func clear_spore_ids() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__spore_ids.value.clear()
+	func add_spore_ids(value : int) -> void:
+		__spore_ids.value.append(value)
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class HeartbeatMessage:
+	func _init():
+		var service
+		
+		__sent_at = PBField.new("sent_at", PB_DATA_TYPE.INT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.INT64])
+		service = PBServiceField.new()
+		service.field = __sent_at
+		data[__sent_at.tag] = service
+		
+	var data = {}
+	
+	var __sent_at: PBField
+	func has_sent_at() -> bool:
+		if __sent_at.value != null:
+			return true
+		return false
+	func get_sent_at() -> int:
+		return __sent_at.value
+	func clear_sent_at() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__sent_at.value = DEFAULT_VALUES_3[PB_DATA_TYPE.INT64]
+	func set_sent_at(value : int) -> void:
+		__sent_at.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class SpectateMessage:
+	func _init():
+		var service
+		
+		__target_id = PBField.new("target_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __target_id
+		data[__target_id.tag] = service
+		
+	var data = {}
+	
+	var __target_id: PBField
+	func has_target_id() -> bool:
+		if __target_id.value != null:
+			return true
+		return false
+	func get_target_id() -> int:
+		return __target_id.value
+	func clear_target_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__target_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_target_id(value : int) -> void:
+		__target_id.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class SpectateEndedMessage:
+	func _init():
+		var service
+		
+		__reason = PBField.new("reason", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __reason
+		data[__reason.tag] = service
+		
+	var data = {}
+	
+	var __reason: PBField
+	func has_reason() -> bool:
+		if __reason.value != null:
+			return true
+		return false
+	func get_reason() -> String:
+		return __reason.value
+	func clear_reason() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__reason.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_reason(value : String) -> void:
+		__reason.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class ChatHistoryEntryMessage:
+	func _init():
+		var service
+		
+		__sender_id = PBField.new("sender_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __sender_id
+		data[__sender_id.tag] = service
+		
+		__sender_name = PBField.new("sender_name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __sender_name
+		data[__sender_name.tag] = service
+		
+		__msg = PBField.new("msg", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __msg
+		data[__msg.tag] = service
+		
+		__sent_at = PBField.new("sent_at", PB_DATA_TYPE.INT64, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.INT64])
+		service = PBServiceField.new()
+		service.field = __sent_at
+		data[__sent_at.tag] = service
+		
+	var data = {}
+	
+	var __sender_id: PBField
+	func has_sender_id() -> bool:
+		if __sender_id.value != null:
+			return true
+		return false
+	func get_sender_id() -> int:
+		return __sender_id.value
+	func clear_sender_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__sender_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_sender_id(value : int) -> void:
+		__sender_id.value = value
+	
+	var __sender_name: PBField
+	func has_sender_name() -> bool:
+		if __sender_name.value != null:
+			return true
+		return false
+	func get_sender_name() -> String:
+		return __sender_name.value
+	func clear_sender_name() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__sender_name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_sender_name(value : String) -> void:
+		__sender_name.value = value
+	
+	var __msg: PBField
+	func has_msg() -> bool:
+		if __msg.value != null:
+			return true
+		return false
+	func get_msg() -> String:
+		return __msg.value
+	func clear_msg() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__msg.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_msg(value : String) -> void:
+		__msg.value = value
+	
+	var __sent_at: PBField
+	func has_sent_at() -> bool:
+		if __sent_at.value != null:
+			return true
+		return false
+	func get_sent_at() -> int:
+		return __sent_at.value
+	func clear_sent_at() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__sent_at.value = DEFAULT_VALUES_3[PB_DATA_TYPE.INT64]
+	func set_sent_at(value : int) -> void:
+		__sent_at.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class ChatHistoryMessage:
+	func _init():
+		var service
+		
+		var __entries_default: Array[ChatHistoryEntryMessage] = []
+		__entries = PBField.new("entries", PB_DATA_TYPE.MESSAGE, PB_RULE.REPEATED, 1, true, __entries_default)
+		service = PBServiceField.new()
+		service.field = __entries
+		service.func_ref = Callable(self, "add_entries")
+		data[__entries.tag] = service
+		
+	var data = {}
+	
+	var __entries: PBField
+	func get_entries() -> Array[ChatHistoryEntryMessage]:
+		return __entries.value
+	func clear_entries() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__entries.value.clear()
+	func add_entries() -> ChatHistoryEntryMessage:
+		var element = ChatHistoryEntryMessage.new()
+		__entries.value.append(element)
+		return element
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class PlayerDespawnedMessage:
+	func _init():
+		var service
+		
+		__player_id = PBField.new("player_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __player_id
+		data[__player_id.tag] = service
+		
+	var data = {}
+	
+	var __player_id: PBField
+	func has_player_id() -> bool:
+		if __player_id.value != null:
+			return true
+		return false
+	func get_player_id() -> int:
+		return __player_id.value
+	func clear_player_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__player_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_player_id(value : int) -> void:
+		__player_id.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class EjectMessage:
+	func _init():
+		var service
+		
+	var data = {}
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class EventMessage:
+	func _init():
+		var service
+		
+		__name = PBField.new("name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __name
+		data[__name.tag] = service
+		
+		__active = PBField.new("active", PB_DATA_TYPE.BOOL, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL])
+		service = PBServiceField.new()
+		service.field = __active
+		data[__active.tag] = service
+		
+		__seconds_left = PBField.new("seconds_left", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __seconds_left
+		data[__seconds_left.tag] = service
+		
+		__mass_multiplier = PBField.new("mass_multiplier", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = __mass_multiplier
+		data[__mass_multiplier.tag] = service
+		
+		__spore_rate_multiplier = PBField.new("spore_rate_multiplier", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 5, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = __spore_rate_multiplier
+		data[__spore_rate_multiplier.tag] = service
+		
+	var data = {}
+	
+	var __name: PBField
+	func has_name() -> bool:
+		if __name.value != null:
+			return true
+		return false
+	func get_name() -> String:
+		return __name.value
+	func clear_name() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_name(value : String) -> void:
+		__name.value = value
+	
+	var __active: PBField
+	func has_active() -> bool:
+		if __active.value != null:
+			return true
+		return false
+	func get_active() -> bool:
+		return __active.value
+	func clear_active() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__active.value = DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL]
+	func set_active(value : bool) -> void:
+		__active.value = value
+	
+	var __seconds_left: PBField
+	func has_seconds_left() -> bool:
+		if __seconds_left.value != null:
+			return true
+		return false
+	func get_seconds_left() -> int:
+		return __seconds_left.value
+	func clear_seconds_left() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__seconds_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_seconds_left(value : int) -> void:
+		__seconds_left.value = value
+	
+	var __mass_multiplier: PBField
+	func has_mass_multiplier() -> bool:
+		if __mass_multiplier.value != null:
+			return true
+		return false
+	func get_mass_multiplier() -> float:
+		return __mass_multiplier.value
+	func clear_mass_multiplier() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__mass_multiplier.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_mass_multiplier(value : float) -> void:
+		__mass_multiplier.value = value
+	
+	var __spore_rate_multiplier: PBField
+	func has_spore_rate_multiplier() -> bool:
+		if __spore_rate_multiplier.value != null:
+			return true
+		return false
+	func get_spore_rate_multiplier() -> float:
+		return __spore_rate_multiplier.value
+	func clear_spore_rate_multiplier() -> void:
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__spore_rate_multiplier.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_spore_rate_multiplier(value : float) -> void:
+		__spore_rate_multiplier.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class AchievementUnlockedMessage:
+	func _init():
+		var service
+		
+		__id = PBField.new("id", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __id
+		data[__id.tag] = service
+		
+		__name = PBField.new("name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __name
+		data[__name.tag] = service
+		
+	var data = {}
+	
+	var __id: PBField
+	func has_id() -> bool:
+		if __id.value != null:
+			return true
+		return false
+	func get_id() -> String:
+		return __id.value
+	func clear_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_id(value : String) -> void:
+		__id.value = value
+	
+	var __name: PBField
+	func has_name() -> bool:
+		if __name.value != null:
+			return true
+		return false
+	func get_name() -> String:
+		return __name.value
+	func clear_name() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_name(value : String) -> void:
+		__name.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class ChatErrorMessage:
+	func _init():
+		var service
+		
+		__reason = PBField.new("reason", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __reason
+		data[__reason.tag] = service
+		
+	var data = {}
+	
+	var __reason: PBField
+	func has_reason() -> bool:
+		if __reason.value != null:
+			return true
+		return false
+	func get_reason() -> String:
+		return __reason.value
+	func clear_reason() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__reason.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_reason(value : String) -> void:
+		__reason.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class TypingMessage:
+	func _init():
+		var service
+		
+		__player_id = PBField.new("player_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __player_id
+		data[__player_id.tag] = service
+		
+		__typing = PBField.new("typing", PB_DATA_TYPE.BOOL, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL])
+		service = PBServiceField.new()
+		service.field = __typing
+		data[__typing.tag] = service
+		
+	var data = {}
+	
+	var __player_id: PBField
+	func has_player_id() -> bool:
+		if __player_id.value != null:
+			return true
+		return false
+	func get_player_id() -> int:
+		return __player_id.value
+	func clear_player_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__player_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_player_id(value : int) -> void:
+		__player_id.value = value
+	
+	var __typing: PBField
+	func has_typing() -> bool:
+		if __typing.value != null:
+			return true
+		return false
+	func get_typing() -> bool:
+		return __typing.value
+	func clear_typing() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL]
+	func set_typing(value : bool) -> void:
+		__typing.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class RespawnMessage:
+	func _init():
+		var service
+		
+	var data = {}
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class MyRankMessage:
+	func _init():
+		var service
+		
+		__rank = PBField.new("rank", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __rank
+		data[__rank.tag] = service
+		
+		__total_players = PBField.new("total_players", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __total_players
+		data[__total_players.tag] = service
+		
+		__mass = PBField.new("mass", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __mass
+		data[__mass.tag] = service
+		
+	var data = {}
+	
+	var __rank: PBField
+	func has_rank() -> bool:
+		if __rank.value != null:
+			return true
+		return false
+	func get_rank() -> int:
+		return __rank.value
+	func clear_rank() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_rank(value : int) -> void:
+		__rank.value = value
+	
+	var __total_players: PBField
+	func has_total_players() -> bool:
+		if __total_players.value != null:
+			return true
+		return false
+	func get_total_players() -> int:
+		return __total_players.value
+	func clear_total_players() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__total_players.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_total_players(value : int) -> void:
+		__total_players.value = value
+	
+	var __mass: PBField
+	func has_mass() -> bool:
+		if __mass.value != null:
+			return true
+		return false
+	func get_mass() -> int:
+		return __mass.value
+	func clear_mass() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_mass(value : int) -> void:
+		__mass.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class ConnectionStatsMessage:
+	func _init():
+		var service
+		
+		__ping_ms = PBField.new("ping_ms", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __ping_ms
+		data[__ping_ms.tag] = service
+		
+		__tick_rate = PBField.new("tick_rate", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = __tick_rate
+		data[__tick_rate.tag] = service
+		
+		__dropped_packets = PBField.new("dropped_packets", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __dropped_packets
+		data[__dropped_packets.tag] = service
+		
+	var data = {}
+	
+	var __ping_ms: PBField
+	func has_ping_ms() -> bool:
+		if __ping_ms.value != null:
+			return true
+		return false
+	func get_ping_ms() -> int:
+		return __ping_ms.value
+	func clear_ping_ms() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__ping_ms.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_ping_ms(value : int) -> void:
+		__ping_ms.value = value
+	
+	var __tick_rate: PBField
+	func has_tick_rate() -> bool:
+		if __tick_rate.value != null:
+			return true
+		return false
+	func get_tick_rate() -> float:
+		return __tick_rate.value
+	func clear_tick_rate() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__tick_rate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_tick_rate(value : float) -> void:
+		__tick_rate.value = value
+	
+	var __dropped_packets: PBField
+	func has_dropped_packets() -> bool:
+		if __dropped_packets.value != null:
+			return true
+		return false
+	func get_dropped_packets() -> int:
+		return __dropped_packets.value
+	func clear_dropped_packets() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__dropped_packets.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_dropped_packets(value : int) -> void:
+		__dropped_packets.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class MatchSummaryMessage:
+	func _init():
+		var service
+		
+		__spores_eaten = PBField.new("spores_eaten", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __spores_eaten
+		data[__spores_eaten.tag] = service
+		
+		__players_eaten = PBField.new("players_eaten", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __players_eaten
+		data[__players_eaten.tag] = service
+		
+		__times_eaten = PBField.new("times_eaten", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __times_eaten
+		data[__times_eaten.tag] = service
+		
+		__max_mass = PBField.new("max_mass", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __max_mass
+		data[__max_mass.tag] = service
+		
+		__distance = PBField.new("distance", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 5, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __distance
+		data[__distance.tag] = service
+		
+	var data = {}
+	
+	var __spores_eaten: PBField
+	func has_spores_eaten() -> bool:
+		if __spores_eaten.value != null:
+			return true
+		return false
+	func get_spores_eaten() -> int:
+		return __spores_eaten.value
+	func clear_spores_eaten() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__spores_eaten.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_spores_eaten(value : int) -> void:
+		__spores_eaten.value = value
+	
+	var __players_eaten: PBField
+	func has_players_eaten() -> bool:
+		if __players_eaten.value != null:
+			return true
+		return false
+	func get_players_eaten() -> int:
+		return __players_eaten.value
+	func clear_players_eaten() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__players_eaten.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_players_eaten(value : int) -> void:
+		__players_eaten.value = value
+	
+	var __times_eaten: PBField
+	func has_times_eaten() -> bool:
+		if __times_eaten.value != null:
+			return true
+		return false
+	func get_times_eaten() -> int:
+		return __times_eaten.value
+	func clear_times_eaten() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__times_eaten.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_times_eaten(value : int) -> void:
+		__times_eaten.value = value
+	
+	var __max_mass: PBField
+	func has_max_mass() -> bool:
+		if __max_mass.value != null:
+			return true
+		return false
+	func get_max_mass() -> int:
+		return __max_mass.value
+	func clear_max_mass() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__max_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_max_mass(value : int) -> void:
+		__max_mass.value = value
+	
+	var __distance: PBField
+	func has_distance() -> bool:
+		if __distance.value != null:
+			return true
+		return false
+	func get_distance() -> int:
+		return __distance.value
+	func clear_distance() -> void:
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__distance.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_distance(value : int) -> void:
+		__distance.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class GameOverMessage:
+	func _init():
+		var service
+		
+		__final_mass = PBField.new("final_mass", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __final_mass
+		data[__final_mass.tag] = service
+		
+		__max_mass = PBField.new("max_mass", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __max_mass
+		data[__max_mass.tag] = service
+		
+		__rank = PBField.new("rank", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __rank
+		data[__rank.tag] = service
+		
+		__total = PBField.new("total", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __total
+		data[__total.tag] = service
+		
+		__session_length = PBField.new("session_length", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 5, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = __session_length
+		data[__session_length.tag] = service
+		
+	var data = {}
+	
+	var __final_mass: PBField
+	func has_final_mass() -> bool:
+		if __final_mass.value != null:
+			return true
+		return false
+	func get_final_mass() -> int:
+		return __final_mass.value
+	func clear_final_mass() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__final_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_final_mass(value : int) -> void:
+		__final_mass.value = value
+	
+	var __max_mass: PBField
+	func has_max_mass() -> bool:
+		if __max_mass.value != null:
+			return true
+		return false
+	func get_max_mass() -> int:
+		return __max_mass.value
+	func clear_max_mass() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__max_mass.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_max_mass(value : int) -> void:
+		__max_mass.value = value
+	
+	var __rank: PBField
+	func has_rank() -> bool:
+		if __rank.value != null:
+			return true
+		return false
+	func get_rank() -> int:
+		return __rank.value
+	func clear_rank() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_rank(value : int) -> void:
+		__rank.value = value
+	
+	var __total: PBField
+	func has_total() -> bool:
+		if __total.value != null:
+			return true
+		return false
+	func get_total() -> int:
+		return __total.value
+	func clear_total() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__total.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_total(value : int) -> void:
+		__total.value = value
+	
+	var __session_length: PBField
+	func has_session_length() -> bool:
+		if __session_length.value != null:
+			return true
+		return false
+	func get_session_length() -> float:
+		return __session_length.value
+	func clear_session_length() -> void:
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__session_length.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_session_length(value : float) -> void:
+		__session_length.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class RequestServerInfoMessage:
+	func _init():
+		var service
+		
+	var data = {}
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class ServerInfoMessage:
+	func _init():
+		var service
+		
+		__name = PBField.new("name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __name
+		data[__name.tag] = service
+		
+		__version = PBField.new("version", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __version
+		data[__version.tag] = service
+		
+		__clients = PBField.new("clients", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __clients
+		data[__clients.tag] = service
+		
+		__players = PBField.new("players", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __players
+		data[__players.tag] = service
+		
+		__max_players = PBField.new("max_players", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 5, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __max_players
+		data[__max_players.tag] = service
+		
+		__game_mode = PBField.new("game_mode", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 6, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __game_mode
+		data[__game_mode.tag] = service
+		
+		__uptime_seconds = PBField.new("uptime_seconds", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 7, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __uptime_seconds
+		data[__uptime_seconds.tag] = service
+		
+	var data = {}
+	
+	var __name: PBField
+	func has_name() -> bool:
+		if __name.value != null:
+			return true
+		return false
+	func get_name() -> String:
+		return __name.value
+	func clear_name() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_name(value : String) -> void:
+		__name.value = value
+	
+	var __version: PBField
+	func has_version() -> bool:
+		if __version.value != null:
+			return true
+		return false
+	func get_version() -> String:
+		return __version.value
+	func clear_version() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__version.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_version(value : String) -> void:
+		__version.value = value
+	
+	var __clients: PBField
+	func has_clients() -> bool:
+		if __clients.value != null:
+			return true
+		return false
+	func get_clients() -> int:
+		return __clients.value
+	func clear_clients() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__clients.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_clients(value : int) -> void:
+		__clients.value = value
+	
+	var __players: PBField
+	func has_players() -> bool:
+		if __players.value != null:
+			return true
+		return false
+	func get_players() -> int:
+		return __players.value
+	func clear_players() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__players.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_players(value : int) -> void:
+		__players.value = value
+	
+	var __max_players: PBField
+	func has_max_players() -> bool:
+		if __max_players.value != null:
+			return true
+		return false
+	func get_max_players() -> int:
+		return __max_players.value
+	func clear_max_players() -> void:
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__max_players.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_max_players(value : int) -> void:
+		__max_players.value = value
+	
+	var __game_mode: PBField
+	func has_game_mode() -> bool:
+		if __game_mode.value != null:
+			return true
+		return false
+	func get_game_mode() -> String:
+		return __game_mode.value
+	func clear_game_mode() -> void:
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__game_mode.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_game_mode(value : String) -> void:
+		__game_mode.value = value
+	
+	var __uptime_seconds: PBField
+	func has_uptime_seconds() -> bool:
+		if __uptime_seconds.value != null:
+			return true
+		return false
+	func get_uptime_seconds() -> int:
+		return __uptime_seconds.value
+	func clear_uptime_seconds() -> void:
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__uptime_seconds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_uptime_seconds(value : int) -> void:
+		__uptime_seconds.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class FrozenMessage:
+	func _init():
+		var service
+		
+		__frozen = PBField.new("frozen", PB_DATA_TYPE.BOOL, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL])
+		service = PBServiceField.new()
+		service.field = __frozen
+		data[__frozen.tag] = service
+		
+	var data = {}
+	
+	var __frozen: PBField
+	func has_frozen() -> bool:
+		if __frozen.value != null:
+			return true
+		return false
+	func get_frozen() -> bool:
+		return __frozen.value
+	func clear_frozen() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.BOOL]
+	func set_frozen(value : bool) -> void:
+		__frozen.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+enum HapticKind {
+	HAPTIC_ATE_PLAYER = 0,
+	HAPTIC_EATEN = 1
+}
+
+class HapticMessage:
+	func _init():
+		var service
+		
+		__kind = PBField.new("kind", PB_DATA_TYPE.ENUM, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.ENUM])
+		service = PBServiceField.new()
+		service.field = __kind
+		data[__kind.tag] = service
+		
+		__intensity = PBField.new("intensity", PB_DATA_TYPE.DOUBLE, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE])
+		service = PBServiceField.new()
+		service.field = __intensity
+		data[__intensity.tag] = service
+		
+	var data = {}
+	
+	var __kind: PBField
+	func has_kind() -> bool:
+		if __kind.value != null:
+			return true
+		return false
+	func get_kind():
+		return __kind.value
+	func clear_kind() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__kind.value = DEFAULT_VALUES_3[PB_DATA_TYPE.ENUM]
+	func set_kind(value) -> void:
+		__kind.value = value
+	
+	var __intensity: PBField
+	func has_intensity() -> bool:
+		if __intensity.value != null:
+			return true
+		return false
+	func get_intensity() -> float:
+		return __intensity.value
+	func clear_intensity() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__intensity.value = DEFAULT_VALUES_3[PB_DATA_TYPE.DOUBLE]
+	func set_intensity(value : float) -> void:
+		__intensity.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class RequestResyncMessage:
+	func _init():
+		var service
+		
+	var data = {}
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class ResyncMessage:
+	func _init():
+		var service
+		
+		__player = PBField.new("player", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __player
+		service.func_ref = Callable(self, "new_player")
+		data[__player.tag] = service
+		
+		var __players_default: Array[PlayerMessage] = []
+		__players = PBField.new("players", PB_DATA_TYPE.MESSAGE, PB_RULE.REPEATED, 2, true, __players_default)
+		service = PBServiceField.new()
+		service.field = __players
+		service.func_ref = Callable(self, "add_players")
+		data[__players.tag] = service
+		
+		var __spores_default: Array[SporeMessage] = []
+		__spores = PBField.new("spores", PB_DATA_TYPE.MESSAGE, PB_RULE.REPEATED, 3, true, __spores_default)
+		service = PBServiceField.new()
+		service.field = __spores
+		service.func_ref = Callable(self, "add_spores")
+		data[__spores.tag] = service
+		
+	var data = {}
+	
+	var __player: PBField
+	func has_player() -> bool:
+		if __player.value != null:
+			return true
+		return false
+	func get_player() -> PlayerMessage:
+		return __player.value
+	func clear_player() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_player() -> PlayerMessage:
+		__player.value = PlayerMessage.new()
+		return __player.value
+	
+	var __players: PBField
+	func get_players() -> Array[PlayerMessage]:
+		return __players.value
+	func clear_players() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__players.value.clear()
+	func add_players() -> PlayerMessage:
+		var element = PlayerMessage.new()
+		__players.value.append(element)
+		return element
+	
+	var __spores: PBField
+	func get_spores() -> Array[SporeMessage]:
+		return __spores.value
+	func clear_spores() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__spores.value.clear()
+	func add_spores() -> SporeMessage:
+		var element = SporeMessage.new()
+		__spores.value.append(element)
+		return element
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class PlayerJoinedMessage:
+	func _init():
+		var service
+		
+		__id = PBField.new("id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __id
+		data[__id.tag] = service
+		
+		__name = PBField.new("name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __name
+		data[__name.tag] = service
+		
+		__color = PBField.new("color", PB_DATA_TYPE.INT32, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.INT32])
+		service = PBServiceField.new()
+		service.field = __color
+		data[__color.tag] = service
+		
+	var data = {}
+	
+	var __id: PBField
+	func has_id() -> bool:
+		if __id.value != null:
+			return true
+		return false
+	func get_id() -> int:
+		return __id.value
+	func clear_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_id(value : int) -> void:
+		__id.value = value
+	
+	var __name: PBField
+	func has_name() -> bool:
+		if __name.value != null:
+			return true
+		return false
+	func get_name() -> String:
+		return __name.value
+	func clear_name() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_name(value : String) -> void:
+		__name.value = value
+	
+	var __color: PBField
+	func has_color() -> bool:
+		if __color.value != null:
+			return true
+		return false
+	func get_color() -> int:
+		return __color.value
+	func clear_color() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.INT32]
+	func set_color(value : int) -> void:
+		__color.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class PlayerLeftMessage:
+	func _init():
+		var service
+		
+		__id = PBField.new("id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __id
+		data[__id.tag] = service
+		
+	var data = {}
+	
+	var __id: PBField
+	func has_id() -> bool:
+		if __id.value != null:
+			return true
+		return false
+	func get_id() -> int:
+		return __id.value
+	func clear_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_id(value : int) -> void:
+		__id.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class ChangeColorMessage:
+	func _init():
+		var service
+		
+		__player_id = PBField.new("player_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __player_id
+		data[__player_id.tag] = service
+		
+		__color = PBField.new("color", PB_DATA_TYPE.INT32, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.INT32])
+		service = PBServiceField.new()
+		service.field = __color
+		data[__color.tag] = service
+		
+		__skin_id = PBField.new("skin_id", PB_DATA_TYPE.UINT32, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32])
+		service = PBServiceField.new()
+		service.field = __skin_id
+		data[__skin_id.tag] = service
+		
+	var data = {}
+	
+	var __player_id: PBField
+	func has_player_id() -> bool:
+		if __player_id.value != null:
+			return true
+		return false
+	func get_player_id() -> int:
+		return __player_id.value
+	func clear_player_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__player_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_player_id(value : int) -> void:
+		__player_id.value = value
+	
+	var __color: PBField
+	func has_color() -> bool:
+		if __color.value != null:
+			return true
+		return false
+	func get_color() -> int:
+		return __color.value
+	func clear_color() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.INT32]
+	func set_color(value : int) -> void:
+		__color.value = value
+	
+	var __skin_id: PBField
+	func has_skin_id() -> bool:
+		if __skin_id.value != null:
+			return true
+		return false
+	func get_skin_id() -> int:
+		return __skin_id.value
+	func clear_skin_id() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__skin_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT32]
+	func set_skin_id(value : int) -> void:
+		__skin_id.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class MuteMessage:
+	func _init():
+		var service
+		
+		__target_id = PBField.new("target_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __target_id
+		data[__target_id.tag] = service
+		
+	var data = {}
+	
+	var __target_id: PBField
+	func has_target_id() -> bool:
+		if __target_id.value != null:
+			return true
+		return false
+	func get_target_id() -> int:
+		return __target_id.value
+	func clear_target_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__target_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_target_id(value : int) -> void:
+		__target_id.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class UnmuteMessage:
+	func _init():
+		var service
+		
+		__target_id = PBField.new("target_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __target_id
+		data[__target_id.tag] = service
+		
+	var data = {}
+	
+	var __target_id: PBField
+	func has_target_id() -> bool:
+		if __target_id.value != null:
+			return true
+		return false
+	func get_target_id() -> int:
+		return __target_id.value
+	func clear_target_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__target_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_target_id(value : int) -> void:
+		__target_id.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class PlayerStatsMessage:
+	func _init():
+		var service
+		
+		__name = PBField.new("name", PB_DATA_TYPE.STRING, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.STRING])
+		service = PBServiceField.new()
+		service.field = __name
+		data[__name.tag] = service
+		
+		__best_score = PBField.new("best_score", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __best_score
+		data[__best_score.tag] = service
+		
+		__matches_played = PBField.new("matches_played", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __matches_played
+		data[__matches_played.tag] = service
+		
+		__total_mass_eaten = PBField.new("total_mass_eaten", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __total_mass_eaten
+		data[__total_mass_eaten.tag] = service
+		
+	var data = {}
+	
+	var __name: PBField
+	func has_name() -> bool:
+		if __name.value != null:
+			return true
+		return false
+	func get_name() -> String:
+		return __name.value
+	func clear_name() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__name.value = DEFAULT_VALUES_3[PB_DATA_TYPE.STRING]
+	func set_name(value : String) -> void:
+		__name.value = value
+	
+	var __best_score: PBField
+	func has_best_score() -> bool:
+		if __best_score.value != null:
+			return true
+		return false
+	func get_best_score() -> int:
+		return __best_score.value
+	func clear_best_score() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__best_score.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_best_score(value : int) -> void:
+		__best_score.value = value
+	
+	var __matches_played: PBField
+	func has_matches_played() -> bool:
+		if __matches_played.value != null:
+			return true
+		return false
+	func get_matches_played() -> int:
+		return __matches_played.value
+	func clear_matches_played() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__matches_played.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_matches_played(value : int) -> void:
+		__matches_played.value = value
+	
+	var __total_mass_eaten: PBField
+	func has_total_mass_eaten() -> bool:
+		if __total_mass_eaten.value != null:
+			return true
+		return false
+	func get_total_mass_eaten() -> int:
+		return __total_mass_eaten.value
+	func clear_total_mass_eaten() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__total_mass_eaten.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_total_mass_eaten(value : int) -> void:
+		__total_mass_eaten.value = value
+	
+	func _to_string() -> String:
+		return PBPacker.message_to_string(data)
+		
+	func to_bytes() -> PackedByteArray:
+		return PBPacker.pack_message(data)
+		
+	func from_bytes(bytes : PackedByteArray, offset : int = 0, limit : int = -1) -> int:
+		var cur_limit = bytes.size()
+		if limit != -1:
+			cur_limit = limit
+		var result = PBPacker.unpack_message(data, bytes, offset, cur_limit)
+		if result == cur_limit:
+			if PBPacker.check_required(data):
+				if limit == -1:
+					return PB_ERR.NO_ERRORS
+			else:
+				return PB_ERR.REQUIRED_FIELDS
+		elif limit == -1 && result > 0:
+			return PB_ERR.PARSE_INCOMPLETE
+		return result
+	
+class Packet:
+	func _init():
+		var service
+		
+		__sender_id = PBField.new("sender_id", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 1, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __sender_id
+		data[__sender_id.tag] = service
+		
+		__seq = PBField.new("seq", PB_DATA_TYPE.UINT64, PB_RULE.OPTIONAL, 100, true, DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64])
+		service = PBServiceField.new()
+		service.field = __seq
+		data[__seq.tag] = service
+		
+		__chat = PBField.new("chat", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 2, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __chat
+		service.func_ref = Callable(self, "new_chat")
+		data[__chat.tag] = service
+		
+		__id = PBField.new("id", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 3, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __id
+		service.func_ref = Callable(self, "new_id")
+		data[__id.tag] = service
+		
+		__login_request = PBField.new("login_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 4, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __login_request
+		service.func_ref = Callable(self, "new_login_request")
+		data[__login_request.tag] = service
+		
+		__register_request = PBField.new("register_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 5, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __register_request
+		service.func_ref = Callable(self, "new_register_request")
+		data[__register_request.tag] = service
+		
+		__ok_response = PBField.new("ok_response", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 6, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __ok_response
+		service.func_ref = Callable(self, "new_ok_response")
+		data[__ok_response.tag] = service
+		
+		__deny_response = PBField.new("deny_response", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 7, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __deny_response
+		service.func_ref = Callable(self, "new_deny_response")
+		data[__deny_response.tag] = service
+		
+		__player = PBField.new("player", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 8, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __player
+		service.func_ref = Callable(self, "new_player")
+		data[__player.tag] = service
+		
+		__player_direction = PBField.new("player_direction", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 9, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __player_direction
+		service.func_ref = Callable(self, "new_player_direction")
+		data[__player_direction.tag] = service
+		
+		__spore = PBField.new("spore", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 10, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __spore
+		service.func_ref = Callable(self, "new_spore")
+		data[__spore.tag] = service
+		
+		__spore_consumed = PBField.new("spore_consumed", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 11, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __spore_consumed
+		service.func_ref = Callable(self, "new_spore_consumed")
+		data[__spore_consumed.tag] = service
+		
+		__spores_batch = PBField.new("spores_batch", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 12, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __spores_batch
+		service.func_ref = Callable(self, "new_spores_batch")
+		data[__spores_batch.tag] = service
+		
+		__player_consumed = PBField.new("player_consumed", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 13, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __player_consumed
+		service.func_ref = Callable(self, "new_player_consumed")
+		data[__player_consumed.tag] = service
+		
+		__hiscore_board_request = PBField.new("hiscore_board_request", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 14, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __hiscore_board_request
+		service.func_ref = Callable(self, "new_hiscore_board_request")
+		data[__hiscore_board_request.tag] = service
+		
+		__hiscore = PBField.new("hiscore", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 15, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __hiscore
+		service.func_ref = Callable(self, "new_hiscore")
+		data[__hiscore.tag] = service
+		
+		__hiscore_board = PBField.new("hiscore_board", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 16, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __hiscore_board
+		service.func_ref = Callable(self, "new_hiscore_board")
+		data[__hiscore_board.tag] = service
+		
+		__finished_browsing_hiscores = PBField.new("finished_browsing_hiscores", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 17, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __finished_browsing_hiscores
+		service.func_ref = Callable(self, "new_finished_browsing_hiscores")
+		data[__finished_browsing_hiscores.tag] = service
+		
+		__search_hiscore = PBField.new("search_hiscore", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 18, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __search_hiscore
+		service.func_ref = Callable(self, "new_search_hiscore")
+		data[__search_hiscore.tag] = service
+		
+		__disconnect = PBField.new("disconnect", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 19, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __disconnect
+		service.func_ref = Callable(self, "new_disconnect")
+		data[__disconnect.tag] = service
+		
+		__emote = PBField.new("emote", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 20, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __emote
+		service.func_ref = Callable(self, "new_emote")
+		data[__emote.tag] = service
+		
+		__request_stats = PBField.new("request_stats", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 21, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __request_stats
+		service.func_ref = Callable(self, "new_request_stats")
+		data[__request_stats.tag] = service
+		
+		__player_stats = PBField.new("player_stats", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 22, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __player_stats
+		service.func_ref = Callable(self, "new_player_stats")
+		data[__player_stats.tag] = service
+		
+		__enter_game = PBField.new("enter_game", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 23, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __enter_game
+		service.func_ref = Callable(self, "new_enter_game")
+		data[__enter_game.tag] = service
+		
+		__world_bounds = PBField.new("world_bounds", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 24, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __world_bounds
+		service.func_ref = Callable(self, "new_world_bounds")
+		data[__world_bounds.tag] = service
+		
+		__kill_feed = PBField.new("kill_feed", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 25, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __kill_feed
+		service.func_ref = Callable(self, "new_kill_feed")
+		data[__kill_feed.tag] = service
+		
+		__kick = PBField.new("kick", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 26, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __kick
+		service.func_ref = Callable(self, "new_kick")
+		data[__kick.tag] = service
+		
+		__game_config = PBField.new("game_config", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 27, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __game_config
+		service.func_ref = Callable(self, "new_game_config")
+		data[__game_config.tag] = service
+		
+		__leaderboard = PBField.new("leaderboard", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 28, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __leaderboard
+		service.func_ref = Callable(self, "new_leaderboard")
+		data[__leaderboard.tag] = service
+		
+		__client_info = PBField.new("client_info", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 29, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __client_info
+		service.func_ref = Callable(self, "new_client_info")
+		data[__client_info.tag] = service
+		
+		__update_required = PBField.new("update_required", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 30, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __update_required
+		service.func_ref = Callable(self, "new_update_required")
+		data[__update_required.tag] = service
+		
+		__error = PBField.new("error", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 31, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __error
+		service.func_ref = Callable(self, "new_error")
+		data[__error.tag] = service
+		
+		__minimap = PBField.new("minimap", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 32, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __minimap
+		service.func_ref = Callable(self, "new_minimap")
+		data[__minimap.tag] = service
+		
+		__ack = PBField.new("ack", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 33, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __ack
+		service.func_ref = Callable(self, "new_ack")
+		data[__ack.tag] = service
+		
+		__countdown = PBField.new("countdown", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 34, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __countdown
+		service.func_ref = Callable(self, "new_countdown")
+		data[__countdown.tag] = service
+		
+		__reconnect = PBField.new("reconnect", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 35, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __reconnect
+		service.func_ref = Callable(self, "new_reconnect")
+		data[__reconnect.tag] = service
+		
+		__settings = PBField.new("settings", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 36, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __settings
+		service.func_ref = Callable(self, "new_settings")
+		data[__settings.tag] = service
+		
+		__spores_despawned = PBField.new("spores_despawned", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 37, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __spores_despawned
+		service.func_ref = Callable(self, "new_spores_despawned")
+		data[__spores_despawned.tag] = service
+		
+		__heartbeat = PBField.new("heartbeat", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 38, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __heartbeat
+		service.func_ref = Callable(self, "new_heartbeat")
+		data[__heartbeat.tag] = service
+		
+		__spectate = PBField.new("spectate", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 39, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __spectate
+		service.func_ref = Callable(self, "new_spectate")
+		data[__spectate.tag] = service
+		
+		__spectate_ended = PBField.new("spectate_ended", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 40, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __spectate_ended
+		service.func_ref = Callable(self, "new_spectate_ended")
+		data[__spectate_ended.tag] = service
+		
+		__chat_history = PBField.new("chat_history", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 41, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __chat_history
+		service.func_ref = Callable(self, "new_chat_history")
+		data[__chat_history.tag] = service
+		
+		__player_despawned = PBField.new("player_despawned", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 42, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __player_despawned
+		service.func_ref = Callable(self, "new_player_despawned")
+		data[__player_despawned.tag] = service
+		
+		__eject = PBField.new("eject", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 43, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __eject
+		service.func_ref = Callable(self, "new_eject")
+		data[__eject.tag] = service
+		
+		__leaderboard_delta = PBField.new("leaderboard_delta", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 44, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __leaderboard_delta
+		service.func_ref = Callable(self, "new_leaderboard_delta")
+		data[__leaderboard_delta.tag] = service
+		
+		__event = PBField.new("event", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 45, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __event
+		service.func_ref = Callable(self, "new_event")
+		data[__event.tag] = service
+		
+		__achievement_unlocked = PBField.new("achievement_unlocked", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 46, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __achievement_unlocked
+		service.func_ref = Callable(self, "new_achievement_unlocked")
+		data[__achievement_unlocked.tag] = service
+		
+		__chat_error = PBField.new("chat_error", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 47, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __chat_error
+		service.func_ref = Callable(self, "new_chat_error")
+		data[__chat_error.tag] = service
+		
+		__typing = PBField.new("typing", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 48, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __typing
+		service.func_ref = Callable(self, "new_typing")
+		data[__typing.tag] = service
+		
+		__respawn = PBField.new("respawn", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 49, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __respawn
+		service.func_ref = Callable(self, "new_respawn")
+		data[__respawn.tag] = service
+		
+		__my_rank = PBField.new("my_rank", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 50, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __my_rank
+		service.func_ref = Callable(self, "new_my_rank")
+		data[__my_rank.tag] = service
+		
+		__connection_stats = PBField.new("connection_stats", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 51, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __connection_stats
+		service.func_ref = Callable(self, "new_connection_stats")
+		data[__connection_stats.tag] = service
+		
+		__match_summary = PBField.new("match_summary", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 52, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __match_summary
+		service.func_ref = Callable(self, "new_match_summary")
+		data[__match_summary.tag] = service
+		
+		__request_server_info = PBField.new("request_server_info", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 53, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __request_server_info
+		service.func_ref = Callable(self, "new_request_server_info")
+		data[__request_server_info.tag] = service
+		
+		__server_info = PBField.new("server_info", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 54, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __server_info
+		service.func_ref = Callable(self, "new_server_info")
+		data[__server_info.tag] = service
+		
+		__batch_consume = PBField.new("batch_consume", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 55, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __batch_consume
+		service.func_ref = Callable(self, "new_batch_consume")
+		data[__batch_consume.tag] = service
+		
+		__frozen = PBField.new("frozen", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 56, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __frozen
+		service.func_ref = Callable(self, "new_frozen")
+		data[__frozen.tag] = service
+		
+		__haptic = PBField.new("haptic", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 57, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __haptic
+		service.func_ref = Callable(self, "new_haptic")
+		data[__haptic.tag] = service
+		
+		__request_resync = PBField.new("request_resync", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 58, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __request_resync
+		service.func_ref = Callable(self, "new_request_resync")
+		data[__request_resync.tag] = service
+		
+		__resync = PBField.new("resync", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 59, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __resync
+		service.func_ref = Callable(self, "new_resync")
+		data[__resync.tag] = service
+		
+		__game_over = PBField.new("game_over", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 60, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __game_over
+		service.func_ref = Callable(self, "new_game_over")
+		data[__game_over.tag] = service
+		
+		__player_joined = PBField.new("player_joined", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 61, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __player_joined
+		service.func_ref = Callable(self, "new_player_joined")
+		data[__player_joined.tag] = service
+		
+		__player_left = PBField.new("player_left", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 62, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __player_left
+		service.func_ref = Callable(self, "new_player_left")
+		data[__player_left.tag] = service
+		
+		__change_color = PBField.new("change_color", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 63, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __change_color
+		service.func_ref = Callable(self, "new_change_color")
+		data[__change_color.tag] = service
+		
+		__mute = PBField.new("mute", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 64, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __mute
+		service.func_ref = Callable(self, "new_mute")
+		data[__mute.tag] = service
+		
+		__unmute = PBField.new("unmute", PB_DATA_TYPE.MESSAGE, PB_RULE.OPTIONAL, 65, true, DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE])
+		service = PBServiceField.new()
+		service.field = __unmute
+		service.func_ref = Callable(self, "new_unmute")
+		data[__unmute.tag] = service
+		
+	var data = {}
+	
+	var __sender_id: PBField
+	func has_sender_id() -> bool:
+		if __sender_id.value != null:
+			return true
+		return false
+	func get_sender_id() -> int:
+		return __sender_id.value
+	func clear_sender_id() -> void:
+		data[1].state = PB_SERVICE_STATE.UNFILLED
+		__sender_id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_sender_id(value : int) -> void:
+		__sender_id.value = value
+	
+	var __seq: PBField
+	func has_seq() -> bool:
+		if __seq.value != null:
+			return true
+		return false
+	func get_seq() -> int:
+		return __seq.value
+	func clear_seq() -> void:
+		data[100].state = PB_SERVICE_STATE.UNFILLED
+		__seq.value = DEFAULT_VALUES_3[PB_DATA_TYPE.UINT64]
+	func set_seq(value : int) -> void:
+		__seq.value = value
+	
+	var __chat: PBField
+	func has_chat() -> bool:
+		if __chat.value != null:
+			return true
+		return false
+	func get_chat() -> ChatMessage:
+		return __chat.value
+	func clear_chat() -> void:
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_chat() -> ChatMessage:
+		data[2].state = PB_SERVICE_STATE.FILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__chat.value = ChatMessage.new()
+		return __chat.value
+	
+	var __id: PBField
+	func has_id() -> bool:
+		if __id.value != null:
+			return true
+		return false
+	func get_id() -> IdMessage:
+		return __id.value
+	func clear_id() -> void:
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_id() -> IdMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		data[3].state = PB_SERVICE_STATE.FILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = IdMessage.new()
+		return __id.value
+	
+	var __login_request: PBField
+	func has_login_request() -> bool:
+		if __login_request.value != null:
+			return true
+		return false
+	func get_login_request() -> LoginRequestMessage:
+		return __login_request.value
+	func clear_login_request() -> void:
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_login_request() -> LoginRequestMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		data[4].state = PB_SERVICE_STATE.FILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = LoginRequestMessage.new()
+		return __login_request.value
+	
+	var __register_request: PBField
+	func has_register_request() -> bool:
+		if __register_request.value != null:
+			return true
+		return false
+	func get_register_request() -> RegisterRequestMessage:
+		return __register_request.value
+	func clear_register_request() -> void:
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_register_request() -> RegisterRequestMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		data[5].state = PB_SERVICE_STATE.FILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = RegisterRequestMessage.new()
+		return __register_request.value
+	
+	var __ok_response: PBField
+	func has_ok_response() -> bool:
+		if __ok_response.value != null:
+			return true
+		return false
+	func get_ok_response() -> OkResponseMessage:
+		return __ok_response.value
+	func clear_ok_response() -> void:
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_ok_response() -> OkResponseMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		data[6].state = PB_SERVICE_STATE.FILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = OkResponseMessage.new()
+		return __ok_response.value
+	
+	var __deny_response: PBField
+	func has_deny_response() -> bool:
+		if __deny_response.value != null:
+			return true
+		return false
+	func get_deny_response() -> DenyResponseMessage:
+		return __deny_response.value
+	func clear_deny_response() -> void:
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_deny_response() -> DenyResponseMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		data[7].state = PB_SERVICE_STATE.FILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DenyResponseMessage.new()
+		return __deny_response.value
+	
+	var __player: PBField
+	func has_player() -> bool:
+		if __player.value != null:
+			return true
+		return false
+	func get_player() -> PlayerMessage:
+		return __player.value
+	func clear_player() -> void:
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_player() -> PlayerMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		data[8].state = PB_SERVICE_STATE.FILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = PlayerMessage.new()
+		return __player.value
+	
+	var __player_direction: PBField
+	func has_player_direction() -> bool:
+		if __player_direction.value != null:
+			return true
+		return false
+	func get_player_direction() -> PlayerDirectionMessage:
+		return __player_direction.value
+	func clear_player_direction() -> void:
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_player_direction() -> PlayerDirectionMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		data[9].state = PB_SERVICE_STATE.FILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = PlayerDirectionMessage.new()
+		return __player_direction.value
+	
+	var __spore: PBField
+	func has_spore() -> bool:
+		if __spore.value != null:
+			return true
+		return false
+	func get_spore() -> SporeMessage:
+		return __spore.value
+	func clear_spore() -> void:
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_spore() -> SporeMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		data[10].state = PB_SERVICE_STATE.FILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = SporeMessage.new()
+		return __spore.value
+	
+	var __spore_consumed: PBField
+	func has_spore_consumed() -> bool:
+		if __spore_consumed.value != null:
+			return true
+		return false
+	func get_spore_consumed() -> SporeConsumedMessage:
+		return __spore_consumed.value
+	func clear_spore_consumed() -> void:
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_spore_consumed() -> SporeConsumedMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		data[11].state = PB_SERVICE_STATE.FILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = SporeConsumedMessage.new()
+		return __spore_consumed.value
+	
+	var __spores_batch: PBField
+	func has_spores_batch() -> bool:
+		if __spores_batch.value != null:
+			return true
+		return false
+	func get_spores_batch() -> SporeBatchMessage:
+		return __spores_batch.value
+	func clear_spores_batch() -> void:
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_spores_batch() -> SporeBatchMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		data[12].state = PB_SERVICE_STATE.FILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = SporeBatchMessage.new()
+		return __spores_batch.value
+	
+	var __player_consumed: PBField
+	func has_player_consumed() -> bool:
+		if __player_consumed.value != null:
+			return true
+		return false
+	func get_player_consumed() -> PlayerConsumedMessage:
+		return __player_consumed.value
+	func clear_player_consumed() -> void:
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_player_consumed() -> PlayerConsumedMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		data[13].state = PB_SERVICE_STATE.FILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = PlayerConsumedMessage.new()
+		return __player_consumed.value
+	
+	var __hiscore_board_request: PBField
+	func has_hiscore_board_request() -> bool:
+		if __hiscore_board_request.value != null:
+			return true
+		return false
+	func get_hiscore_board_request() -> HiscoreBoardRequestMessage:
+		return __hiscore_board_request.value
+	func clear_hiscore_board_request() -> void:
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_hiscore_board_request() -> HiscoreBoardRequestMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		data[14].state = PB_SERVICE_STATE.FILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = HiscoreBoardRequestMessage.new()
+		return __hiscore_board_request.value
+	
+	var __hiscore: PBField
+	func has_hiscore() -> bool:
+		if __hiscore.value != null:
+			return true
+		return false
+	func get_hiscore() -> HiscoreMessage:
+		return __hiscore.value
+	func clear_hiscore() -> void:
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_hiscore() -> HiscoreMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		data[15].state = PB_SERVICE_STATE.FILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = HiscoreMessage.new()
+		return __hiscore.value
+	
+	var __hiscore_board: PBField
+	func has_hiscore_board() -> bool:
+		if __hiscore_board.value != null:
+			return true
+		return false
+	func get_hiscore_board() -> HiscoreBoardMessage:
+		return __hiscore_board.value
+	func clear_hiscore_board() -> void:
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_hiscore_board() -> HiscoreBoardMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		data[16].state = PB_SERVICE_STATE.FILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = HiscoreBoardMessage.new()
+		return __hiscore_board.value
+	
+	var __finished_browsing_hiscores: PBField
+	func has_finished_browsing_hiscores() -> bool:
+		if __finished_browsing_hiscores.value != null:
+			return true
+		return false
+	func get_finished_browsing_hiscores() -> FinishedBrowsingHiscoresMessage:
+		return __finished_browsing_hiscores.value
+	func clear_finished_browsing_hiscores() -> void:
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_finished_browsing_hiscores() -> FinishedBrowsingHiscoresMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		data[17].state = PB_SERVICE_STATE.FILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = FinishedBrowsingHiscoresMessage.new()
+		return __finished_browsing_hiscores.value
+	
+	var __search_hiscore: PBField
+	func has_search_hiscore() -> bool:
+		if __search_hiscore.value != null:
+			return true
+		return false
+	func get_search_hiscore() -> SearchHiscoreMessage:
+		return __search_hiscore.value
+	func clear_search_hiscore() -> void:
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_search_hiscore() -> SearchHiscoreMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		data[18].state = PB_SERVICE_STATE.FILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = SearchHiscoreMessage.new()
+		return __search_hiscore.value
+	
+	var __disconnect: PBField
+	func has_disconnect() -> bool:
+		if __disconnect.value != null:
+			return true
+		return false
+	func get_disconnect() -> DisconnectMessage:
+		return __disconnect.value
+	func clear_disconnect() -> void:
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_disconnect() -> DisconnectMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		data[19].state = PB_SERVICE_STATE.FILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DisconnectMessage.new()
+		return __disconnect.value
+	
+	var __emote: PBField
+	func has_emote() -> bool:
+		if __emote.value != null:
+			return true
+		return false
+	func get_emote() -> EmoteMessage:
+		return __emote.value
+	func clear_emote() -> void:
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_emote() -> EmoteMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		data[20].state = PB_SERVICE_STATE.FILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = EmoteMessage.new()
+		return __emote.value
+	
+	var __request_stats: PBField
+	func has_request_stats() -> bool:
+		if __request_stats.value != null:
+			return true
+		return false
+	func get_request_stats() -> RequestStatsMessage:
+		return __request_stats.value
+	func clear_request_stats() -> void:
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_request_stats() -> RequestStatsMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		data[21].state = PB_SERVICE_STATE.FILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = RequestStatsMessage.new()
+		return __request_stats.value
+	
+	var __player_stats: PBField
+	func has_player_stats() -> bool:
+		if __player_stats.value != null:
+			return true
+		return false
+	func get_player_stats() -> PlayerStatsMessage:
+		return __player_stats.value
+	func clear_player_stats() -> void:
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_player_stats() -> PlayerStatsMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		data[22].state = PB_SERVICE_STATE.FILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = PlayerStatsMessage.new()
+		return __player_stats.value
+	
+	var __enter_game: PBField
+	func has_enter_game() -> bool:
+		if __enter_game.value != null:
+			return true
+		return false
+	func get_enter_game() -> EnterGameMessage:
+		return __enter_game.value
+	func clear_enter_game() -> void:
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_enter_game() -> EnterGameMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		data[23].state = PB_SERVICE_STATE.FILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = EnterGameMessage.new()
+		return __enter_game.value
+	
+	var __world_bounds: PBField
+	func has_world_bounds() -> bool:
+		if __world_bounds.value != null:
+			return true
+		return false
+	func get_world_bounds() -> WorldBoundsMessage:
+		return __world_bounds.value
+	func clear_world_bounds() -> void:
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_world_bounds() -> WorldBoundsMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		data[24].state = PB_SERVICE_STATE.FILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = WorldBoundsMessage.new()
+		return __world_bounds.value
+	
+	var __kill_feed: PBField
+	func has_kill_feed() -> bool:
+		if __kill_feed.value != null:
+			return true
+		return false
+	func get_kill_feed() -> KillFeedMessage:
+		return __kill_feed.value
+	func clear_kill_feed() -> void:
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_kill_feed() -> KillFeedMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		data[25].state = PB_SERVICE_STATE.FILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = KillFeedMessage.new()
+		return __kill_feed.value
+	
+	var __kick: PBField
+	func has_kick() -> bool:
+		if __kick.value != null:
+			return true
+		return false
+	func get_kick() -> KickMessage:
+		return __kick.value
+	func clear_kick() -> void:
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_kick() -> KickMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		data[26].state = PB_SERVICE_STATE.FILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = KickMessage.new()
+		return __kick.value
+	
+	var __game_config: PBField
+	func has_game_config() -> bool:
+		if __game_config.value != null:
+			return true
+		return false
+	func get_game_config() -> GameConfigMessage:
+		return __game_config.value
+	func clear_game_config() -> void:
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_game_config() -> GameConfigMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		data[27].state = PB_SERVICE_STATE.FILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = GameConfigMessage.new()
+		return __game_config.value
+	
+	var __leaderboard: PBField
+	func has_leaderboard() -> bool:
+		if __leaderboard.value != null:
+			return true
+		return false
+	func get_leaderboard() -> LeaderboardMessage:
+		return __leaderboard.value
+	func clear_leaderboard() -> void:
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_leaderboard() -> LeaderboardMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		data[28].state = PB_SERVICE_STATE.FILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = LeaderboardMessage.new()
+		return __leaderboard.value
+	
+	var __client_info: PBField
+	func has_client_info() -> bool:
+		if __client_info.value != null:
+			return true
+		return false
+	func get_client_info() -> ClientInfoMessage:
+		return __client_info.value
+	func clear_client_info() -> void:
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_client_info() -> ClientInfoMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		data[29].state = PB_SERVICE_STATE.FILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = ClientInfoMessage.new()
+		return __client_info.value
+	
+	var __update_required: PBField
+	func has_update_required() -> bool:
+		if __update_required.value != null:
+			return true
+		return false
+	func get_update_required() -> UpdateRequiredMessage:
+		return __update_required.value
+	func clear_update_required() -> void:
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_update_required() -> UpdateRequiredMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		data[30].state = PB_SERVICE_STATE.FILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = UpdateRequiredMessage.new()
+		return __update_required.value
+	
+	var __error: PBField
+	func has_error() -> bool:
+		if __error.value != null:
+			return true
+		return false
+	func get_error() -> ErrorMessage:
+		return __error.value
+	func clear_error() -> void:
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_error() -> ErrorMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		data[31].state = PB_SERVICE_STATE.FILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = ErrorMessage.new()
+		return __error.value
+	
+	var __minimap: PBField
+	func has_minimap() -> bool:
+		if __minimap.value != null:
+			return true
+		return false
+	func get_minimap() -> MinimapMessage:
+		return __minimap.value
+	func clear_minimap() -> void:
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_minimap() -> MinimapMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		data[32].state = PB_SERVICE_STATE.FILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = MinimapMessage.new()
+		return __minimap.value
+	
+	var __ack: PBField
+	func has_ack() -> bool:
+		if __ack.value != null:
+			return true
+		return false
+	func get_ack() -> AckMessage:
+		return __ack.value
+	func clear_ack() -> void:
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_ack() -> AckMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		data[33].state = PB_SERVICE_STATE.FILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = AckMessage.new()
+		return __ack.value
+	
+	var __countdown: PBField
+	func has_countdown() -> bool:
+		if __countdown.value != null:
+			return true
+		return false
+	func get_countdown() -> CountdownMessage:
+		return __countdown.value
+	func clear_countdown() -> void:
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_countdown() -> CountdownMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		data[34].state = PB_SERVICE_STATE.FILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = CountdownMessage.new()
+		return __countdown.value
+	
+	var __reconnect: PBField
+	func has_reconnect() -> bool:
+		if __reconnect.value != null:
+			return true
+		return false
+	func get_reconnect() -> ReconnectMessage:
+		return __reconnect.value
+	func clear_reconnect() -> void:
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_reconnect() -> ReconnectMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		data[35].state = PB_SERVICE_STATE.FILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = ReconnectMessage.new()
+		return __reconnect.value
+	
+	var __settings: PBField
+	func has_settings() -> bool:
+		if __settings.value != null:
+			return true
+		return false
+	func get_settings() -> SettingsMessage:
+		return __settings.value
+	func clear_settings() -> void:
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_settings() -> SettingsMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		data[36].state = PB_SERVICE_STATE.FILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = SettingsMessage.new()
+		return __settings.value
+	
+	var __spores_despawned: PBField
+	func has_spores_despawned() -> bool:
+		if __spores_despawned.value != null:
+			return true
+		return false
+	func get_spores_despawned() -> SporesDespawnedMessage:
+		return __spores_despawned.value
+	func clear_spores_despawned() -> void:
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_spores_despawned() -> SporesDespawnedMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		data[37].state = PB_SERVICE_STATE.FILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = SporesDespawnedMessage.new()
+		return __spores_despawned.value
+	
+	var __heartbeat: PBField
+	func has_heartbeat() -> bool:
+		if __heartbeat.value != null:
+			return true
+		return false
+	func get_heartbeat() -> HeartbeatMessage:
+		return __heartbeat.value
+	func clear_heartbeat() -> void:
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_heartbeat() -> HeartbeatMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		data[38].state = PB_SERVICE_STATE.FILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = HeartbeatMessage.new()
+		return __heartbeat.value
+	
+	var __spectate: PBField
+	func has_spectate() -> bool:
+		if __spectate.value != null:
+			return true
+		return false
+	func get_spectate() -> SpectateMessage:
+		return __spectate.value
+	func clear_spectate() -> void:
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_spectate() -> SpectateMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		data[39].state = PB_SERVICE_STATE.FILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = SpectateMessage.new()
+		return __spectate.value
+	
+	var __spectate_ended: PBField
+	func has_spectate_ended() -> bool:
+		if __spectate_ended.value != null:
+			return true
+		return false
+	func get_spectate_ended() -> SpectateEndedMessage:
+		return __spectate_ended.value
+	func clear_spectate_ended() -> void:
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_spectate_ended() -> SpectateEndedMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		data[40].state = PB_SERVICE_STATE.FILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = SpectateEndedMessage.new()
+		return __spectate_ended.value
+	
+	var __chat_history: PBField
+	func has_chat_history() -> bool:
+		if __chat_history.value != null:
+			return true
+		return false
+	func get_chat_history() -> ChatHistoryMessage:
+		return __chat_history.value
+	func clear_chat_history() -> void:
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_chat_history() -> ChatHistoryMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		data[41].state = PB_SERVICE_STATE.FILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = ChatHistoryMessage.new()
+		return __chat_history.value
+	
+	var __player_despawned: PBField
+	func has_player_despawned() -> bool:
+		if __player_despawned.value != null:
+			return true
+		return false
+	func get_player_despawned() -> PlayerDespawnedMessage:
+		return __player_despawned.value
+	func clear_player_despawned() -> void:
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_player_despawned() -> PlayerDespawnedMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		data[42].state = PB_SERVICE_STATE.FILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = PlayerDespawnedMessage.new()
+		return __player_despawned.value
+	
+	var __eject: PBField
+	func has_eject() -> bool:
+		if __eject.value != null:
+			return true
+		return false
+	func get_eject() -> EjectMessage:
+		return __eject.value
+	func clear_eject() -> void:
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_eject() -> EjectMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		data[43].state = PB_SERVICE_STATE.FILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = EjectMessage.new()
+		return __eject.value
+	
+	var __leaderboard_delta: PBField
+	func has_leaderboard_delta() -> bool:
+		if __leaderboard_delta.value != null:
+			return true
+		return false
+	func get_leaderboard_delta() -> LeaderboardDeltaMessage:
+		return __leaderboard_delta.value
+	func clear_leaderboard_delta() -> void:
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_leaderboard_delta() -> LeaderboardDeltaMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		data[44].state = PB_SERVICE_STATE.FILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = LeaderboardDeltaMessage.new()
+		return __leaderboard_delta.value
+	
+	var __event: PBField
+	func has_event() -> bool:
+		if __event.value != null:
+			return true
+		return false
+	func get_event() -> EventMessage:
+		return __event.value
+	func clear_event() -> void:
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_event() -> EventMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		data[45].state = PB_SERVICE_STATE.FILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = EventMessage.new()
+		return __event.value
+	
+	var __achievement_unlocked: PBField
+	func has_achievement_unlocked() -> bool:
+		if __achievement_unlocked.value != null:
+			return true
+		return false
+	func get_achievement_unlocked() -> AchievementUnlockedMessage:
+		return __achievement_unlocked.value
+	func clear_achievement_unlocked() -> void:
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_achievement_unlocked() -> AchievementUnlockedMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		data[46].state = PB_SERVICE_STATE.FILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = AchievementUnlockedMessage.new()
+		return __achievement_unlocked.value
+	
+	var __chat_error: PBField
+	func has_chat_error() -> bool:
+		if __chat_error.value != null:
+			return true
+		return false
+	func get_chat_error() -> ChatErrorMessage:
+		return __chat_error.value
+	func clear_chat_error() -> void:
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_chat_error() -> ChatErrorMessage:
+		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[2].state = PB_SERVICE_STATE.UNFILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		data[47].state = PB_SERVICE_STATE.FILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = ChatErrorMessage.new()
+		return __chat_error.value
 	
-	var __chat: PBField
-	func has_chat() -> bool:
-		if __chat.value != null:
+	var __typing: PBField
+	func has_typing() -> bool:
+		if __typing.value != null:
 			return true
 		return false
-	func get_chat() -> ChatMessage:
-		return __chat.value
-	func clear_chat() -> void:
-		data[2].state = PB_SERVICE_STATE.UNFILLED
+	func get_typing() -> TypingMessage:
+		return __typing.value
+	func clear_typing() -> void:
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_typing() -> TypingMessage:
 		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_chat() -> ChatMessage:
-		data[2].state = PB_SERVICE_STATE.FILLED
+		data[2].state = PB_SERVICE_STATE.UNFILLED
 		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[3].state = PB_SERVICE_STATE.UNFILLED
 		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -1890,23 +12045,115 @@ class Packet:
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		__chat.value = ChatMessage.new()
-		return __chat.value
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		data[48].state = PB_SERVICE_STATE.FILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = TypingMessage.new()
+		return __typing.value
 	
-	var __id: PBField
-	func has_id() -> bool:
-		if __id.value != null:
+	var __respawn: PBField
+	func has_respawn() -> bool:
+		if __respawn.value != null:
 			return true
 		return false
-	func get_id() -> IdMessage:
-		return __id.value
-	func clear_id() -> void:
-		data[3].state = PB_SERVICE_STATE.UNFILLED
-		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_id() -> IdMessage:
+	func get_respawn() -> RespawnMessage:
+		return __respawn.value
+	func clear_respawn() -> void:
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_respawn() -> RespawnMessage:
 		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
-		data[3].state = PB_SERVICE_STATE.FILLED
+		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[3].state = PB_SERVICE_STATE.UNFILLED
 		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[4].state = PB_SERVICE_STATE.UNFILLED
 		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -1939,25 +12186,117 @@ class Packet:
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		__id.value = IdMessage.new()
-		return __id.value
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		data[49].state = PB_SERVICE_STATE.FILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = RespawnMessage.new()
+		return __respawn.value
 	
-	var __login_request: PBField
-	func has_login_request() -> bool:
-		if __login_request.value != null:
+	var __my_rank: PBField
+	func has_my_rank() -> bool:
+		if __my_rank.value != null:
 			return true
 		return false
-	func get_login_request() -> LoginRequestMessage:
-		return __login_request.value
-	func clear_login_request() -> void:
-		data[4].state = PB_SERVICE_STATE.UNFILLED
-		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_login_request() -> LoginRequestMessage:
+	func get_my_rank() -> MyRankMessage:
+		return __my_rank.value
+	func clear_my_rank() -> void:
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_my_rank() -> MyRankMessage:
 		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[3].state = PB_SERVICE_STATE.UNFILLED
-		data[4].state = PB_SERVICE_STATE.FILLED
+		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[4].state = PB_SERVICE_STATE.UNFILLED
 		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[5].state = PB_SERVICE_STATE.UNFILLED
 		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -1988,27 +12327,119 @@ class Packet:
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		__login_request.value = LoginRequestMessage.new()
-		return __login_request.value
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		data[50].state = PB_SERVICE_STATE.FILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = MyRankMessage.new()
+		return __my_rank.value
 	
-	var __register_request: PBField
-	func has_register_request() -> bool:
-		if __register_request.value != null:
+	var __connection_stats: PBField
+	func has_connection_stats() -> bool:
+		if __connection_stats.value != null:
 			return true
 		return false
-	func get_register_request() -> RegisterRequestMessage:
-		return __register_request.value
-	func clear_register_request() -> void:
-		data[5].state = PB_SERVICE_STATE.UNFILLED
-		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_register_request() -> RegisterRequestMessage:
+	func get_connection_stats() -> ConnectionStatsMessage:
+		return __connection_stats.value
+	func clear_connection_stats() -> void:
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_connection_stats() -> ConnectionStatsMessage:
 		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[3].state = PB_SERVICE_STATE.UNFILLED
 		__login_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[4].state = PB_SERVICE_STATE.UNFILLED
-		data[5].state = PB_SERVICE_STATE.FILLED
+		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[5].state = PB_SERVICE_STATE.UNFILLED
 		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[6].state = PB_SERVICE_STATE.UNFILLED
 		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2037,20 +12468,111 @@ class Packet:
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		__register_request.value = RegisterRequestMessage.new()
-		return __register_request.value
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		data[51].state = PB_SERVICE_STATE.FILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = ConnectionStatsMessage.new()
+		return __connection_stats.value
 	
-	var __ok_response: PBField
-	func has_ok_response() -> bool:
-		if __ok_response.value != null:
+	var __match_summary: PBField
+	func has_match_summary() -> bool:
+		if __match_summary.value != null:
 			return true
 		return false
-	func get_ok_response() -> OkResponseMessage:
-		return __ok_response.value
-	func clear_ok_response() -> void:
-		data[6].state = PB_SERVICE_STATE.UNFILLED
-		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_ok_response() -> OkResponseMessage:
+	func get_match_summary() -> MatchSummaryMessage:
+		return __match_summary.value
+	func clear_match_summary() -> void:
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_match_summary() -> MatchSummaryMessage:
 		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2059,7 +12581,8 @@ class Packet:
 		data[4].state = PB_SERVICE_STATE.UNFILLED
 		__register_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[5].state = PB_SERVICE_STATE.UNFILLED
-		data[6].state = PB_SERVICE_STATE.FILLED
+		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[6].state = PB_SERVICE_STATE.UNFILLED
 		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[7].state = PB_SERVICE_STATE.UNFILLED
 		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2086,20 +12609,111 @@ class Packet:
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		__ok_response.value = OkResponseMessage.new()
-		return __ok_response.value
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		data[52].state = PB_SERVICE_STATE.FILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = MatchSummaryMessage.new()
+		return __match_summary.value
 	
-	var __deny_response: PBField
-	func has_deny_response() -> bool:
-		if __deny_response.value != null:
+	var __request_server_info: PBField
+	func has_request_server_info() -> bool:
+		if __request_server_info.value != null:
 			return true
 		return false
-	func get_deny_response() -> DenyResponseMessage:
-		return __deny_response.value
-	func clear_deny_response() -> void:
-		data[7].state = PB_SERVICE_STATE.UNFILLED
-		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_deny_response() -> DenyResponseMessage:
+	func get_request_server_info() -> RequestServerInfoMessage:
+		return __request_server_info.value
+	func clear_request_server_info() -> void:
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_request_server_info() -> RequestServerInfoMessage:
 		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2110,7 +12724,8 @@ class Packet:
 		data[5].state = PB_SERVICE_STATE.UNFILLED
 		__ok_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[6].state = PB_SERVICE_STATE.UNFILLED
-		data[7].state = PB_SERVICE_STATE.FILLED
+		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[7].state = PB_SERVICE_STATE.UNFILLED
 		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[8].state = PB_SERVICE_STATE.UNFILLED
 		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2135,20 +12750,111 @@ class Packet:
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		__deny_response.value = DenyResponseMessage.new()
-		return __deny_response.value
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		data[53].state = PB_SERVICE_STATE.FILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = RequestServerInfoMessage.new()
+		return __request_server_info.value
 	
-	var __player: PBField
-	func has_player() -> bool:
-		if __player.value != null:
+	var __server_info: PBField
+	func has_server_info() -> bool:
+		if __server_info.value != null:
 			return true
 		return false
-	func get_player() -> PlayerMessage:
-		return __player.value
-	func clear_player() -> void:
-		data[8].state = PB_SERVICE_STATE.UNFILLED
-		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_player() -> PlayerMessage:
+	func get_server_info() -> ServerInfoMessage:
+		return __server_info.value
+	func clear_server_info() -> void:
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_server_info() -> ServerInfoMessage:
 		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2161,7 +12867,8 @@ class Packet:
 		data[6].state = PB_SERVICE_STATE.UNFILLED
 		__deny_response.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[7].state = PB_SERVICE_STATE.UNFILLED
-		data[8].state = PB_SERVICE_STATE.FILLED
+		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[8].state = PB_SERVICE_STATE.UNFILLED
 		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[9].state = PB_SERVICE_STATE.UNFILLED
 		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2184,20 +12891,111 @@ class Packet:
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		__player.value = PlayerMessage.new()
-		return __player.value
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		data[54].state = PB_SERVICE_STATE.FILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = ServerInfoMessage.new()
+		return __server_info.value
 	
-	var __player_direction: PBField
-	func has_player_direction() -> bool:
-		if __player_direction.value != null:
+	var __batch_consume: PBField
+	func has_batch_consume() -> bool:
+		if __batch_consume.value != null:
 			return true
 		return false
-	func get_player_direction() -> PlayerDirectionMessage:
-		return __player_direction.value
-	func clear_player_direction() -> void:
-		data[9].state = PB_SERVICE_STATE.UNFILLED
-		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_player_direction() -> PlayerDirectionMessage:
+	func get_batch_consume() -> BatchConsumeMessage:
+		return __batch_consume.value
+	func clear_batch_consume() -> void:
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_batch_consume() -> BatchConsumeMessage:
 		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2212,7 +13010,8 @@ class Packet:
 		data[7].state = PB_SERVICE_STATE.UNFILLED
 		__player.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[8].state = PB_SERVICE_STATE.UNFILLED
-		data[9].state = PB_SERVICE_STATE.FILLED
+		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[9].state = PB_SERVICE_STATE.UNFILLED
 		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[10].state = PB_SERVICE_STATE.UNFILLED
 		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2233,20 +13032,111 @@ class Packet:
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		__player_direction.value = PlayerDirectionMessage.new()
-		return __player_direction.value
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		data[55].state = PB_SERVICE_STATE.FILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = BatchConsumeMessage.new()
+		return __batch_consume.value
 	
-	var __spore: PBField
-	func has_spore() -> bool:
-		if __spore.value != null:
+	var __frozen: PBField
+	func has_frozen() -> bool:
+		if __frozen.value != null:
 			return true
 		return false
-	func get_spore() -> SporeMessage:
-		return __spore.value
-	func clear_spore() -> void:
-		data[10].state = PB_SERVICE_STATE.UNFILLED
-		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_spore() -> SporeMessage:
+	func get_frozen() -> FrozenMessage:
+		return __frozen.value
+	func clear_frozen() -> void:
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_frozen() -> FrozenMessage:
 		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2263,7 +13153,8 @@ class Packet:
 		data[8].state = PB_SERVICE_STATE.UNFILLED
 		__player_direction.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[9].state = PB_SERVICE_STATE.UNFILLED
-		data[10].state = PB_SERVICE_STATE.FILLED
+		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[10].state = PB_SERVICE_STATE.UNFILLED
 		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[11].state = PB_SERVICE_STATE.UNFILLED
 		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2282,20 +13173,111 @@ class Packet:
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		__spore.value = SporeMessage.new()
-		return __spore.value
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		data[56].state = PB_SERVICE_STATE.FILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = FrozenMessage.new()
+		return __frozen.value
 	
-	var __spore_consumed: PBField
-	func has_spore_consumed() -> bool:
-		if __spore_consumed.value != null:
+	var __haptic: PBField
+	func has_haptic() -> bool:
+		if __haptic.value != null:
 			return true
 		return false
-	func get_spore_consumed() -> SporeConsumedMessage:
-		return __spore_consumed.value
-	func clear_spore_consumed() -> void:
-		data[11].state = PB_SERVICE_STATE.UNFILLED
-		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_spore_consumed() -> SporeConsumedMessage:
+	func get_haptic() -> HapticMessage:
+		return __haptic.value
+	func clear_haptic() -> void:
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_haptic() -> HapticMessage:
 		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2314,7 +13296,8 @@ class Packet:
 		data[9].state = PB_SERVICE_STATE.UNFILLED
 		__spore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[10].state = PB_SERVICE_STATE.UNFILLED
-		data[11].state = PB_SERVICE_STATE.FILLED
+		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[11].state = PB_SERVICE_STATE.UNFILLED
 		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[12].state = PB_SERVICE_STATE.UNFILLED
 		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2331,20 +13314,111 @@ class Packet:
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		__spore_consumed.value = SporeConsumedMessage.new()
-		return __spore_consumed.value
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		data[57].state = PB_SERVICE_STATE.FILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = HapticMessage.new()
+		return __haptic.value
 	
-	var __spores_batch: PBField
-	func has_spores_batch() -> bool:
-		if __spores_batch.value != null:
+	var __request_resync: PBField
+	func has_request_resync() -> bool:
+		if __request_resync.value != null:
 			return true
 		return false
-	func get_spores_batch() -> SporeBatchMessage:
-		return __spores_batch.value
-	func clear_spores_batch() -> void:
-		data[12].state = PB_SERVICE_STATE.UNFILLED
-		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_spores_batch() -> SporeBatchMessage:
+	func get_request_resync() -> RequestResyncMessage:
+		return __request_resync.value
+	func clear_request_resync() -> void:
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_request_resync() -> RequestResyncMessage:
 		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2365,7 +13439,8 @@ class Packet:
 		data[10].state = PB_SERVICE_STATE.UNFILLED
 		__spore_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[11].state = PB_SERVICE_STATE.UNFILLED
-		data[12].state = PB_SERVICE_STATE.FILLED
+		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[12].state = PB_SERVICE_STATE.UNFILLED
 		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[13].state = PB_SERVICE_STATE.UNFILLED
 		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2380,20 +13455,111 @@ class Packet:
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		__spores_batch.value = SporeBatchMessage.new()
-		return __spores_batch.value
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		data[58].state = PB_SERVICE_STATE.FILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = RequestResyncMessage.new()
+		return __request_resync.value
 	
-	var __player_consumed: PBField
-	func has_player_consumed() -> bool:
-		if __player_consumed.value != null:
+	var __resync: PBField
+	func has_resync() -> bool:
+		if __resync.value != null:
 			return true
 		return false
-	func get_player_consumed() -> PlayerConsumedMessage:
-		return __player_consumed.value
-	func clear_player_consumed() -> void:
-		data[13].state = PB_SERVICE_STATE.UNFILLED
-		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_player_consumed() -> PlayerConsumedMessage:
+	func get_resync() -> ResyncMessage:
+		return __resync.value
+	func clear_resync() -> void:
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_resync() -> ResyncMessage:
 		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2416,7 +13582,8 @@ class Packet:
 		data[11].state = PB_SERVICE_STATE.UNFILLED
 		__spores_batch.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[12].state = PB_SERVICE_STATE.UNFILLED
-		data[13].state = PB_SERVICE_STATE.FILLED
+		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[13].state = PB_SERVICE_STATE.UNFILLED
 		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[14].state = PB_SERVICE_STATE.UNFILLED
 		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2429,20 +13596,111 @@ class Packet:
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		__player_consumed.value = PlayerConsumedMessage.new()
-		return __player_consumed.value
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		data[59].state = PB_SERVICE_STATE.FILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = ResyncMessage.new()
+		return __resync.value
 	
-	var __hiscore_board_request: PBField
-	func has_hiscore_board_request() -> bool:
-		if __hiscore_board_request.value != null:
+	var __game_over: PBField
+	func has_game_over() -> bool:
+		if __game_over.value != null:
 			return true
 		return false
-	func get_hiscore_board_request() -> HiscoreBoardRequestMessage:
-		return __hiscore_board_request.value
-	func clear_hiscore_board_request() -> void:
-		data[14].state = PB_SERVICE_STATE.UNFILLED
-		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_hiscore_board_request() -> HiscoreBoardRequestMessage:
+	func get_game_over() -> GameOverMessage:
+		return __game_over.value
+	func clear_game_over() -> void:
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_game_over() -> GameOverMessage:
 		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2467,7 +13725,8 @@ class Packet:
 		data[12].state = PB_SERVICE_STATE.UNFILLED
 		__player_consumed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[13].state = PB_SERVICE_STATE.UNFILLED
-		data[14].state = PB_SERVICE_STATE.FILLED
+		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[14].state = PB_SERVICE_STATE.UNFILLED
 		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[15].state = PB_SERVICE_STATE.UNFILLED
 		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2478,20 +13737,111 @@ class Packet:
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		__hiscore_board_request.value = HiscoreBoardRequestMessage.new()
-		return __hiscore_board_request.value
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		data[60].state = PB_SERVICE_STATE.FILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = GameOverMessage.new()
+		return __game_over.value
 	
-	var __hiscore: PBField
-	func has_hiscore() -> bool:
-		if __hiscore.value != null:
+	var __player_joined: PBField
+	func has_player_joined() -> bool:
+		if __player_joined.value != null:
 			return true
 		return false
-	func get_hiscore() -> HiscoreMessage:
-		return __hiscore.value
-	func clear_hiscore() -> void:
-		data[15].state = PB_SERVICE_STATE.UNFILLED
-		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_hiscore() -> HiscoreMessage:
+	func get_player_joined() -> PlayerJoinedMessage:
+		return __player_joined.value
+	func clear_player_joined() -> void:
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_player_joined() -> PlayerJoinedMessage:
 		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2518,7 +13868,8 @@ class Packet:
 		data[13].state = PB_SERVICE_STATE.UNFILLED
 		__hiscore_board_request.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[14].state = PB_SERVICE_STATE.UNFILLED
-		data[15].state = PB_SERVICE_STATE.FILLED
+		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[15].state = PB_SERVICE_STATE.UNFILLED
 		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[16].state = PB_SERVICE_STATE.UNFILLED
 		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2527,20 +13878,111 @@ class Packet:
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		__hiscore.value = HiscoreMessage.new()
-		return __hiscore.value
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		data[61].state = PB_SERVICE_STATE.FILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = PlayerJoinedMessage.new()
+		return __player_joined.value
 	
-	var __hiscore_board: PBField
-	func has_hiscore_board() -> bool:
-		if __hiscore_board.value != null:
+	var __player_left: PBField
+	func has_player_left() -> bool:
+		if __player_left.value != null:
 			return true
 		return false
-	func get_hiscore_board() -> HiscoreBoardMessage:
-		return __hiscore_board.value
-	func clear_hiscore_board() -> void:
-		data[16].state = PB_SERVICE_STATE.UNFILLED
-		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_hiscore_board() -> HiscoreBoardMessage:
+	func get_player_left() -> PlayerLeftMessage:
+		return __player_left.value
+	func clear_player_left() -> void:
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_player_left() -> PlayerLeftMessage:
 		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2569,27 +14011,119 @@ class Packet:
 		data[14].state = PB_SERVICE_STATE.UNFILLED
 		__hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[15].state = PB_SERVICE_STATE.UNFILLED
-		data[16].state = PB_SERVICE_STATE.FILLED
+		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[16].state = PB_SERVICE_STATE.UNFILLED
 		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[17].state = PB_SERVICE_STATE.UNFILLED
 		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		__hiscore_board.value = HiscoreBoardMessage.new()
-		return __hiscore_board.value
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		data[62].state = PB_SERVICE_STATE.FILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = PlayerLeftMessage.new()
+		return __player_left.value
 	
-	var __finished_browsing_hiscores: PBField
-	func has_finished_browsing_hiscores() -> bool:
-		if __finished_browsing_hiscores.value != null:
+	var __change_color: PBField
+	func has_change_color() -> bool:
+		if __change_color.value != null:
 			return true
 		return false
-	func get_finished_browsing_hiscores() -> FinishedBrowsingHiscoresMessage:
-		return __finished_browsing_hiscores.value
-	func clear_finished_browsing_hiscores() -> void:
-		data[17].state = PB_SERVICE_STATE.UNFILLED
-		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_finished_browsing_hiscores() -> FinishedBrowsingHiscoresMessage:
+	func get_change_color() -> ChangeColorMessage:
+		return __change_color.value
+	func clear_change_color() -> void:
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_change_color() -> ChangeColorMessage:
 		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2620,25 +14154,117 @@ class Packet:
 		data[15].state = PB_SERVICE_STATE.UNFILLED
 		__hiscore_board.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[16].state = PB_SERVICE_STATE.UNFILLED
-		data[17].state = PB_SERVICE_STATE.FILLED
+		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[17].state = PB_SERVICE_STATE.UNFILLED
 		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[18].state = PB_SERVICE_STATE.UNFILLED
 		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		__finished_browsing_hiscores.value = FinishedBrowsingHiscoresMessage.new()
-		return __finished_browsing_hiscores.value
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		data[63].state = PB_SERVICE_STATE.FILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = ChangeColorMessage.new()
+		return __change_color.value
 	
-	var __search_hiscore: PBField
-	func has_search_hiscore() -> bool:
-		if __search_hiscore.value != null:
+	var __mute: PBField
+	func has_mute() -> bool:
+		if __mute.value != null:
 			return true
 		return false
-	func get_search_hiscore() -> SearchHiscoreMessage:
-		return __search_hiscore.value
-	func clear_search_hiscore() -> void:
-		data[18].state = PB_SERVICE_STATE.UNFILLED
-		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_search_hiscore() -> SearchHiscoreMessage:
+	func get_mute() -> MuteMessage:
+		return __mute.value
+	func clear_mute() -> void:
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_mute() -> MuteMessage:
 		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2671,23 +14297,115 @@ class Packet:
 		data[16].state = PB_SERVICE_STATE.UNFILLED
 		__finished_browsing_hiscores.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[17].state = PB_SERVICE_STATE.UNFILLED
-		data[18].state = PB_SERVICE_STATE.FILLED
+		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[18].state = PB_SERVICE_STATE.UNFILLED
 		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[19].state = PB_SERVICE_STATE.UNFILLED
-		__search_hiscore.value = SearchHiscoreMessage.new()
-		return __search_hiscore.value
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		data[64].state = PB_SERVICE_STATE.FILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = MuteMessage.new()
+		return __mute.value
 	
-	var __disconnect: PBField
-	func has_disconnect() -> bool:
-		if __disconnect.value != null:
+	var __unmute: PBField
+	func has_unmute() -> bool:
+		if __unmute.value != null:
 			return true
 		return false
-	func get_disconnect() -> DisconnectMessage:
-		return __disconnect.value
-	func clear_disconnect() -> void:
-		data[19].state = PB_SERVICE_STATE.UNFILLED
-		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
-	func new_disconnect() -> DisconnectMessage:
+	func get_unmute() -> UnmuteMessage:
+		return __unmute.value
+	func clear_unmute() -> void:
+		data[65].state = PB_SERVICE_STATE.UNFILLED
+		__unmute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+	func new_unmute() -> UnmuteMessage:
 		__chat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[2].state = PB_SERVICE_STATE.UNFILLED
 		__id.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
@@ -2722,9 +14440,101 @@ class Packet:
 		data[17].state = PB_SERVICE_STATE.UNFILLED
 		__search_hiscore.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
 		data[18].state = PB_SERVICE_STATE.UNFILLED
-		data[19].state = PB_SERVICE_STATE.FILLED
-		__disconnect.value = DisconnectMessage.new()
-		return __disconnect.value
+		__disconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[19].state = PB_SERVICE_STATE.UNFILLED
+		__emote.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[20].state = PB_SERVICE_STATE.UNFILLED
+		__request_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[21].state = PB_SERVICE_STATE.UNFILLED
+		__player_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[22].state = PB_SERVICE_STATE.UNFILLED
+		__enter_game.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[23].state = PB_SERVICE_STATE.UNFILLED
+		__world_bounds.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[24].state = PB_SERVICE_STATE.UNFILLED
+		__kill_feed.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[25].state = PB_SERVICE_STATE.UNFILLED
+		__kick.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[26].state = PB_SERVICE_STATE.UNFILLED
+		__game_config.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[27].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[28].state = PB_SERVICE_STATE.UNFILLED
+		__client_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[29].state = PB_SERVICE_STATE.UNFILLED
+		__update_required.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[30].state = PB_SERVICE_STATE.UNFILLED
+		__error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[31].state = PB_SERVICE_STATE.UNFILLED
+		__minimap.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[32].state = PB_SERVICE_STATE.UNFILLED
+		__ack.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[33].state = PB_SERVICE_STATE.UNFILLED
+		__countdown.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[34].state = PB_SERVICE_STATE.UNFILLED
+		__reconnect.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[35].state = PB_SERVICE_STATE.UNFILLED
+		__settings.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[36].state = PB_SERVICE_STATE.UNFILLED
+		__spores_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[37].state = PB_SERVICE_STATE.UNFILLED
+		__heartbeat.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[38].state = PB_SERVICE_STATE.UNFILLED
+		__spectate.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[39].state = PB_SERVICE_STATE.UNFILLED
+		__spectate_ended.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[40].state = PB_SERVICE_STATE.UNFILLED
+		__chat_history.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[41].state = PB_SERVICE_STATE.UNFILLED
+		__player_despawned.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[42].state = PB_SERVICE_STATE.UNFILLED
+		__eject.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[43].state = PB_SERVICE_STATE.UNFILLED
+		__leaderboard_delta.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[44].state = PB_SERVICE_STATE.UNFILLED
+		__event.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[45].state = PB_SERVICE_STATE.UNFILLED
+		__achievement_unlocked.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[46].state = PB_SERVICE_STATE.UNFILLED
+		__chat_error.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[47].state = PB_SERVICE_STATE.UNFILLED
+		__typing.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[48].state = PB_SERVICE_STATE.UNFILLED
+		__respawn.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[49].state = PB_SERVICE_STATE.UNFILLED
+		__my_rank.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[50].state = PB_SERVICE_STATE.UNFILLED
+		__connection_stats.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[51].state = PB_SERVICE_STATE.UNFILLED
+		__match_summary.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[52].state = PB_SERVICE_STATE.UNFILLED
+		__request_server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[53].state = PB_SERVICE_STATE.UNFILLED
+		__server_info.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[54].state = PB_SERVICE_STATE.UNFILLED
+		__batch_consume.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[55].state = PB_SERVICE_STATE.UNFILLED
+		__frozen.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[56].state = PB_SERVICE_STATE.UNFILLED
+		__haptic.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[57].state = PB_SERVICE_STATE.UNFILLED
+		__request_resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[58].state = PB_SERVICE_STATE.UNFILLED
+		__resync.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[59].state = PB_SERVICE_STATE.UNFILLED
+		__game_over.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[60].state = PB_SERVICE_STATE.UNFILLED
+		__player_joined.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[61].state = PB_SERVICE_STATE.UNFILLED
+		__player_left.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[62].state = PB_SERVICE_STATE.UNFILLED
+		__change_color.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[63].state = PB_SERVICE_STATE.UNFILLED
+		__mute.value = DEFAULT_VALUES_3[PB_DATA_TYPE.MESSAGE]
+		data[64].state = PB_SERVICE_STATE.UNFILLED
+		data[65].state = PB_SERVICE_STATE.FILLED
+		__unmute.value = UnmuteMessage.new()
+		return __unmute.value
 	
 	func _to_string() -> String:
 		return PBPacker.message_to_string(data)
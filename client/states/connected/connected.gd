@@ -26,6 +26,8 @@ func _on_ws_packet_recieved(packet: packets.Packet) -> void:
 		_log.error(deny_response_msg.get_reason())
 	elif packet.has_ok_response():
 		_action_on_ok_received.call()
+	elif packet.has_update_required():
+		_log.error("This client is too old, version %s or newer is needed" % packet.get_update_required().get_min_version())
 
 #Connection closed:
 func _on_ws_connection_closed() -> void:
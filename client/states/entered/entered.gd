@@ -14,6 +14,12 @@ func _ready() -> void:
 
 func _on_ws_connected_to_server() -> void:
 	_log.success("Connected succesfully")
+	
+	var packet := packets.Packet.new()
+	var client_info_msg := packet.new_client_info()
+	client_info_msg.set_version(GameManager.VERSION)
+	client_info_msg.set_supports_acks(true)
+	WS.send(packet)
 
 func _on_ws_connection_closed() -> void:
 	_log.warning("Connection closed")
@@ -22,6 +28,8 @@ func _on_ws_packet_received(packet: packets.Packet) -> void:
 	var sender_id := packet.get_sender_id()
 	if packet.has_id():
 		_handle_id_msg(sender_id, packet.get_id())
+	elif packet.has_update_required():
+		_log.error("This client is too old, version %s or newer is needed" % packet.get_update_required().get_min_version())
 
 func _handle_id_msg(sender_id: int, id_msg: packets.IdMessage) -> void:
 	GameManager.client_id = id_msg.get_id()
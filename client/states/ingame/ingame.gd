@@ -7,6 +7,7 @@ const Spore := preload("res://objects/spore/spore.gd")
 
 @onready var _logout_button: Button = $UI/MarginContainer/VBoxContainer/HBoxContainer/LogoutButton
 @onready var _send_button: Button = $UI/MarginContainer/VBoxContainer/HBoxContainer/SendButton
+@onready var _respawn_button: Button = $UI/MarginContainer/VBoxContainer/HBoxContainer/RespawnButton
 @onready var _line_edit: LineEdit = $UI/MarginContainer/VBoxContainer/HBoxContainer/LineEdit
 @onready var _log: Log = $UI/MarginContainer/VBoxContainer/Log
 @onready var _hiscores: Hiscores = $UI/MarginContainer/VBoxContainer/Hiscores
@@ -21,6 +22,7 @@ func _ready() -> void:
 	
 	_send_button.pressed.connect(_on_send_button_pressed)
 	_logout_button.pressed.connect(_on_logout_button_pressed)
+	_respawn_button.pressed.connect(_on_respawn_button_pressed)
 	_line_edit.text_submitted.connect(_on_line_edit_text_submitted)
 
 func _handle_chat_msg(sender_id: int, chat_msg: packets.ChatMessage) -> void:
@@ -71,6 +73,12 @@ func _update_actor(actor_id: int, x: float, y: float, direction: float, speed: f
 	if not is_player:
 		actor.velocity = Vector2.from_angle(direction) * speed
 
+func _on_respawn_button_pressed() -> void:
+	var packet := packets.Packet.new()
+	packet.new_respawn()
+	WS.send(packet)
+	_respawn_button.hide()
+
 func _on_logout_button_pressed() -> void:
 	var packet := packets.Packet.new()
 	var disconnect_msg := packet.new_disconnect()
@@ -108,6 +116,28 @@ func _on_ws_packet_received(packet: packets.Packet) -> void:
 		_handle_spores_batch_msg(sender_id, packet.get_spores_batch())
 	elif packet.has_spore_consumed():
 		_handle_spore_consumed_msg(sender_id, packet.get_spore_consumed())
+	elif packet.has_batch_consume():
+		_handle_batch_consume_msg(sender_id, packet.get_batch_consume())
+	elif packet.has_spores_despawned():
+		_handle_spores_despawned_msg(sender_id, packet.get_spores_despawned())
+	elif packet.has_player_consumed():
+		_handle_player_consumed_msg(sender_id, packet.get_player_consumed())
+	elif packet.has_player_despawned():
+		_handle_player_despawned_msg(sender_id, packet.get_player_despawned())
+	elif packet.has_player_left():
+		_handle_player_left_msg(sender_id, packet.get_player_left())
+	elif packet.has_kill_feed():
+		_handle_kill_feed_msg(sender_id, packet.get_kill_feed())
+	elif packet.has_game_over():
+		_handle_game_over_msg(sender_id, packet.get_game_over())
+	elif packet.has_countdown():
+		_handle_countdown_msg(sender_id, packet.get_countdown())
+	elif packet.has_error():
+		_log.error(packet.get_error().get_message())
+	elif packet.has_chat_error():
+		_log.error(packet.get_chat_error().get_reason())
+	elif packet.has_kick():
+		_log.error("Kicked: %s" % packet.get_kick().get_reason())
 	elif packet.has_disconnect():
 		_handle_disconnect_msg(sender_id, packet.get_disconnect())
 
@@ -128,6 +158,9 @@ func _handle_spore_msg(sender_id: int, spore_msg: packets.SporeMessage) -> void:
 		var spore := Spore.instantiate(spore_id, x, y, radius, underneath_player)
 		_world.add_child(spore)
 		_spores[spore_id] =  spore
+	else:
+		#Spores that were shot out keep moving for a bit, the server sends where they are every tick
+		_spores[spore_id].position = Vector2(x, y)
 
 func _handle_spores_batch_msg(sender_id: int, spores_batch_msg: packets.SporeBatchMessage) -> void:
 	for spore_msg in spores_batch_msg.get_spores():
@@ -135,17 +168,72 @@ func _handle_spores_batch_msg(sender_id: int, spores_batch_msg: packets.SporeBat
 
 func _handle_spore_consumed_msg(sender_id: int, spore_consumed_msg: packets.SporeConsumedMessage) -> void:
 	#everything has already been verified on the server side so we'll simply update
+	var spore_id := spore_consumed_msg.get_spore_id()
+	if spore_id in _spores:
+		var spore := _spores[spore_id]
+		if sender_id in _players:
+			#The server tells us the mass it gave, events and such can make it more than the spore's size
+			var spore_mass := spore_consumed_msg.get_mass()
+			if spore_mass <= 0:
+				spore_mass = _rad_to_mass(spore.radius)
+			_grow_actor(_players[sender_id], spore_mass)
+		_remove_spore(spore)
+
+func _handle_batch_consume_msg(sender_id: int, batch_consume_msg: packets.BatchConsumeMessage) -> void:
+	for spore_id in batch_consume_msg.get_spore_ids():
+		if spore_id in _spores:
+			_remove_spore(_spores[spore_id])
 	if sender_id in _players:
-		var actor := _players[sender_id]
-		var actor_mass := _rad_to_mass(actor.radius)
-		
-		var spore_id := spore_consumed_msg.get_spore_id()
+		_grow_actor(_players[sender_id], batch_consume_msg.get_mass())
+
+#Spores that went away without anyone eating them, or that were eaten too far away for us to see who by
+func _handle_spores_despawned_msg(_sender_id: int, spores_despawned_msg: packets.SporesDespawnedMessage) -> void:
+	for spore_id in spores_despawned_msg.get_spore_ids():
 		if spore_id in _spores:
-			var spore := _spores[spore_id]
-			var spore_mass := _rad_to_mass(spore.radius)
-			
-			_set_actor_mass(actor, actor_mass + spore_mass)
-			_remove_spore(spore)
+			_remove_spore(_spores[spore_id])
+
+func _handle_player_consumed_msg(sender_id: int, player_consumed_msg: packets.PlayerConsumedMessage) -> void:
+	var victim_id := player_consumed_msg.get_player_id()
+	if sender_id in _players and sender_id != GameManager.client_id:
+		_grow_actor(_players[sender_id], player_consumed_msg.get_mass())
+	
+	if victim_id == GameManager.client_id:
+		var eater_name := "someone"
+		if sender_id in _players:
+			eater_name = _players[sender_id].actor_name
+		_log.warning("You were eaten by %s" % eater_name)
+		_respawn_button.show()
+	
+	if victim_id in _players:
+		_remove_actor(_players[victim_id])
+
+func _handle_player_despawned_msg(_sender_id: int, player_despawned_msg: packets.PlayerDespawnedMessage) -> void:
+	var actor_id := player_despawned_msg.get_player_id()
+	if actor_id in _players:
+		_remove_actor(_players[actor_id])
+
+func _handle_player_left_msg(_sender_id: int, player_left_msg: packets.PlayerLeftMessage) -> void:
+	var actor_id := player_left_msg.get_id()
+	if actor_id in _players:
+		_remove_actor(_players[actor_id])
+
+func _handle_kill_feed_msg(_sender_id: int, kill_feed_msg: packets.KillFeedMessage) -> void:
+	_log.info("%s ate %s" % [kill_feed_msg.get_consumer_name(), kill_feed_msg.get_victim_name()])
+
+func _handle_game_over_msg(_sender_id: int, game_over_msg: packets.GameOverMessage) -> void:
+	_log.info("Final mass %d (best this life %d), ranked %d of %d" % [
+		game_over_msg.get_final_mass(),
+		game_over_msg.get_max_mass(),
+		game_over_msg.get_rank(),
+		game_over_msg.get_total(),
+	])
+
+func _handle_countdown_msg(_sender_id: int, countdown_msg: packets.CountdownMessage) -> void:
+	var seconds := countdown_msg.get_seconds()
+	if seconds > 0:
+		_log.info("Round starts in %d..." % seconds)
+	else:
+		_log.success("Go!")
 
 func _handle_disconnect_msg(sender_id: int, disconnect_msg: packets.DisconnectMessage) -> void:
 	if sender_id in _players:
@@ -163,6 +251,9 @@ func _on_player_area_entered(area: Area2D) -> void:
 	elif area is Actor:
 		_collide_actor(area as Actor)
 
+func _grow_actor(actor: Actor, mass: float) -> void:
+	_set_actor_mass(actor, _rad_to_mass(actor.radius) + mass)
+
 func _set_actor_mass(actor: Actor, new_mass: float) -> void:
 	actor.radius = sqrt(new_mass / PI)
 	_hiscores.set_hiscore(actor.actor_name, roundi(new_mass))
@@ -34,9 +34,18 @@ func get_packet() -> packets.Packet:
 	var result := packet.from_bytes(data)
 	if result != OK:
 		printerr("Error forming packet from data %s" % data.get_string_from_utf8())
+	elif packet.get_seq() > 0:
+		_ack(packet.get_seq())
 	
 	return packet
 
+#Important packets come with a seq, the server keeps sending them until we say we got them
+func _ack(seq: int) -> void:
+	var packet := packets.Packet.new()
+	var ack_msg := packet.new_ack()
+	ack_msg.set_seq(seq)
+	send(packet)
+
 func close(code: int = 1000, reason: String = "") -> void:
 	socket.close(code, reason)
 	last_state = socket.get_ready_state()
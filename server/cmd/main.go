@@ -5,19 +5,105 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"os"
+	"os/signal"
 	"server/internal/server"
 	"server/internal/server/clients"
+	"syscall"
 )
 
 var (
-	port = flag.Int("port", 8080, "Port to listen on")
+	port   = flag.Int("port", 8080, "Port to listen on")
+	maxBps = flag.Uint64("maxbps", 0, "Soft cap on bytes per second sent to a single client (0 for no cap)")
+
+	sporeDist      = flag.String("sporedist", server.DistributionNormal, "Distribution of spore radii (normal or uniform)")
+	sporeMean      = flag.Float64("sporemean", 10, "Mean spore radius for the normal distribution")
+	sporeSd        = flag.Float64("sporesd", 3, "Standard deviation of the spore radius for the normal distribution")
+	sporeMin       = flag.Float64("sporemin", 5, "Minimum spore radius")
+	sporeMax       = flag.Float64("sporemax", 15, "Maximum spore radius for the uniform distribution")
+	sporeTTL       = flag.Duration("sporettl", 0, "How long spores dropped by players last if nobody eats them (0 for forever)")
+	sporePlacement = flag.String("sporeplacement", server.PlacementUniform, "How spores are spread around the map (uniform, edge or ring)")
+
+	logFile    = flag.String("logfile", "", "File to write logs to, rotated when it gets big (empty for stdout only)")
+	minVersion = flag.String("minversion", "", "Oldest client version allowed to play, like 1.2.0 (empty allows any)")
+	adminToken = flag.String("admintoken", "", "Token for the /admin routes (admin routes are off if empty)")
+	season     = flag.Duration("season", 0, "How often to archive and reset the leaderboard (0 for never)")
+	minPlayers = flag.Int("minplayers", 0, "Players needed before the round counts down and starts (0 starts right away)")
+
+	reconnectGrace = flag.Duration("reconnectgrace", 0, "How long a dropped player is kept so its client can reconnect (0 for off)")
+	drift          = flag.String("drift", server.DriftNone, "Pull on players on top of their movement (none, center, point or wind)")
+	collide        = flag.Bool("collide", false, "Push apart overlapping players that can't eat each other")
+	mapSeed        = flag.Int64("mapseed", 0, "Seed for the starting spores, the same seed gives the same map (0 picks one at random)")
+	serverName     = flag.String("name", "nodeHunger", "Name of the server shown to clients and server browsers")
+	maxPlayers     = flag.Int("maxplayers", 0, "Most players allowed in the game at once (0 for no limit)")
+	balanceFile    = flag.String("balance", "", "JSON file with balance settings, reloaded on SIGHUP (empty for the defaults)")
+	eventsFile     = flag.String("events", "", "JSON file with the map wide events to run on a schedule (empty for none)")
+	sporeValue     = flag.String("sporevalue", server.SporeValueNone, "How spores' value follows the player count (none, linear or inverse)")
+	writeQueue     = flag.String("writequeue", "", "File to queue database writes in so they survive outages and restarts (empty writes straight to the database)")
+	idleKick       = flag.Duration("idlekick", 0, "How long a player can go without sending any input before being kicked back to the menu (0 for never)")
+	menuTimeout    = flag.Duration("menutimeout", 0, "How long a connection can sit in the menu without sending anything before it's dropped (0 for never)")
+	afkThreshold   = flag.Duration("afk", 0, "How long a player can go without steering or eating before their updates slow down (0 for never)")
+	requireDb      = flag.Bool("requiredb", true, "Refuse to start if the database can't be used (if false, play on without saving anything)")
 )
 
 func main() {
 	flag.Parse()
 
+	config := server.DefaultConfig()
+	config.MaxClientBytesPerSec = *maxBps
+	config.SporeRadiusDistribution = *sporeDist
+	config.SporeRadiusMean = *sporeMean
+	config.SporeRadiusStdDev = *sporeSd
+	config.SporeRadiusMin = *sporeMin
+	config.SporeRadiusMax = *sporeMax
+	config.SporePlacement = *sporePlacement
+	config.SporeTrailTTL = *sporeTTL
+	config.MinClientVersion = *minVersion
+	config.LogFile = *logFile
+	config.AdminToken = *adminToken
+	config.SeasonInterval = *season
+	config.RoundMinPlayers = *minPlayers
+	config.ReconnectGrace = *reconnectGrace
+	config.RequireDb = *requireDb
+	config.IdleKickTimeout = *idleKick
+	config.AfkThreshold = *afkThreshold
+	config.PreGameTimeout = *menuTimeout
+	config.SporeValueScaling = *sporeValue
+	config.WriteQueueFile = *writeQueue
+	config.ServerName = *serverName
+	config.MaxPlayers = *maxPlayers
+	config.DriftMode = *drift
+	config.SoftCollision = *collide
+	config.MapSeed = *mapSeed
+	config.BalanceFile = *balanceFile
+
+	if *eventsFile != "" {
+		events, err := server.LoadEvents(*eventsFile)
+		if err != nil {
+			log.Fatalf("Error loading the events: %v", err)
+		}
+		config.Events = events
+	}
+
 	// Defining the game hub
-	hub := server.NewHub()
+	hub := server.NewHub(config)
+
+	//The balance file goes on top of the flags, and can be changed and reloaded without a restart
+	if config.BalanceFile != "" {
+		if err := hub.ReloadBalance(); err != nil {
+			log.Fatalf("Error loading the balance: %v", err)
+		}
+
+		reload := make(chan os.Signal, 1)
+		signal.Notify(reload, syscall.SIGHUP)
+		go func() {
+			for range reload {
+				if err := hub.ReloadBalance(); err != nil {
+					log.Printf("Error reloading the balance, keeping the old one: %v", err)
+				}
+			}
+		}()
+	}
 
 	// Defining handler for WebSocket connections
 	//Using "ws"(web socket) route, allowing full duplex communication
@@ -28,6 +114,19 @@ func main() {
 	//serve the new connection with the hub by creating a new websocket connection and start
 	//processing requests
 
+	//Basic stats about the server in plain text
+	http.HandleFunc("/metrics", hub.ServeMetrics)
+
+	//Server info as JSON, for server browsers
+	http.HandleFunc("/info", hub.ServeInfo)
+
+	//Admin commands, they need the -admintoken as a bearer token
+	http.HandleFunc("/admin/season/reset", hub.ServeSeasonReset)
+	http.HandleFunc("/admin/spawn", hub.ServeSpawn)
+	http.HandleFunc("/admin/freeze", hub.ServeFreeze)
+	http.HandleFunc("/admin/teleport", hub.ServeTeleport)
+	http.HandleFunc("/admin/balance/reload", hub.ServeBalanceReload)
+
 	//Now that the handler is defined, let's run (start) the hub using a go routine to make sure the hub
 	//can always run in the background
 	go hub.Run()
@@ -0,0 +1,151 @@
+package server
+
+import (
+	"log"
+	"math"
+	"server/internal/server/db"
+	"server/pkg/packets"
+	"sync"
+	"time"
+)
+
+// Something a player can unlock once, by doing well enough within a single life
+type Achievement struct {
+	Id       string //what gets saved in the DB, never change these
+	Name     string //shown to the player
+	unlocked func(life *lifeProgress, now time.Time) bool
+}
+
+var achievements = []Achievement{
+	{
+		Id:   "glutton",
+		Name: "Eat 10 players in one life",
+		unlocked: func(life *lifeProgress, _ time.Time) bool {
+			return life.playersEaten >= 10
+		},
+	},
+	{
+		Id:   "heavyweight",
+		Name: "Reach 5000 mass",
+		unlocked: func(life *lifeProgress, _ time.Time) bool {
+			return life.mass >= 5000
+		},
+	},
+	{
+		Id:   "survivor",
+		Name: "Survive 5 minutes",
+		unlocked: func(life *lifeProgress, now time.Time) bool {
+			return now.Sub(life.joinedAt) >= 5*time.Minute
+		},
+	},
+}
+
+// How a player is doing in their current life, it starts over when they die or leave
+type lifeProgress struct {
+	joinedAt     time.Time
+	playersEaten int
+	mass         float64
+	dbId         int64           //0 for guests, their unlocks aren't saved
+	unlocked     map[string]bool //shared with the player, so it carries over to the next life
+}
+
+// Keeps track of every player's progress towards the achievements through the event bus
+type AchievementTracker struct {
+	hub   *Hub
+	mu    sync.Mutex
+	lives map[uint64]*lifeProgress
+}
+
+func NewAchievementTracker(hub *Hub) *AchievementTracker {
+	return &AchievementTracker{
+		hub:   hub,
+		lives: make(map[uint64]*lifeProgress),
+	}
+}
+
+// Subscriber for the event bus
+func (t *AchievementTracker) handle(event GameEvent) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	switch event := event.(type) {
+	case PlayerJoined:
+		player := event.Player
+		if player.Achievements == nil {
+			player.Achievements = make(map[string]bool)
+		}
+		t.lives[event.PlayerId] = &lifeProgress{
+			joinedAt: t.hub.Clock.Now(),
+			mass:     math.Pi * player.Radius * player.Radius,
+			dbId:     player.DbId,
+			unlocked: player.Achievements,
+		}
+	case PlayerLeft:
+		delete(t.lives, event.PlayerId)
+	case PlayerEaten:
+		delete(t.lives, event.VictimId)
+		if life, exists := t.lives[event.EaterId]; exists {
+			life.playersEaten++
+			t.updateMass(event.EaterId, life)
+			t.check(event.EaterId, life)
+		}
+	case SporeEaten:
+		if life, exists := t.lives[event.PlayerId]; exists {
+			t.updateMass(event.PlayerId, life)
+			t.check(event.PlayerId, life)
+		}
+	}
+}
+
+func (t *AchievementTracker) updateMass(playerId uint64, life *lifeProgress) {
+	if player, exists := t.hub.SharedGameObjects.Players.Get(playerId); exists {
+		life.mass = math.Pi * player.Radius * player.Radius
+	}
+}
+
+// Unlocks anything the player qualifies for and hasn't unlocked yet
+func (t *AchievementTracker) check(playerId uint64, life *lifeProgress) {
+	now := t.hub.Clock.Now()
+	for _, achievement := range achievements {
+		if life.unlocked[achievement.Id] || !achievement.unlocked(life, now) {
+			continue
+		}
+
+		life.unlocked[achievement.Id] = true
+		log.Printf("Client %d unlocked the achievement %s", playerId, achievement.Id)
+		if client, exists := t.hub.Clients.Get(playerId); exists {
+			client.SocketSend(packets.NewAchievementUnlocked(achievement.Id, achievement.Name))
+		}
+		if life.dbId != 0 {
+			go t.save(life.dbId, achievement.Id)
+		}
+	}
+}
+
+func (t *AchievementTracker) save(dbId int64, achievementId string) {
+	dbTx := t.hub.NewDbTx()
+	if !dbTx.Available() {
+		return
+	}
+	err := dbTx.Queries.CreatePlayerAchievement(dbTx.Ctx, db.CreatePlayerAchievementParams{
+		PlayerID:      dbId,
+		AchievementID: achievementId,
+	})
+	if err != nil {
+		log.Printf("Error saving the achievement %s for player %d: %v", achievementId, dbId, err)
+	}
+}
+
+// Some achievements only need time to pass, so everyone gets checked every interval too
+func (t *AchievementTracker) loop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		t.mu.Lock()
+		for playerId, life := range t.lives {
+			t.check(playerId, life)
+		}
+		t.mu.Unlock()
+	}
+}
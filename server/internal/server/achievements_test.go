@@ -0,0 +1,76 @@
+package server_test
+
+import (
+	"server/internal/server"
+	"server/internal/server/objects"
+	"server/internal/servertest"
+	"server/pkg/packets"
+	"testing"
+	"time"
+)
+
+// Ids of the achievements the client was told it unlocked, in order
+func unlockedIds(client *servertest.TestClient) []string {
+	ids := []string{}
+	for _, unlocked := range servertest.MessagesOf[*packets.Packet_AchievementUnlocked](client.SentMessages()) {
+		ids = append(ids, unlocked.AchievementUnlocked.Id)
+	}
+	return ids
+}
+
+func TestAchievementsUnlockOnceWithinALife(t *testing.T) {
+	hub, clock := servertest.NewTestHub(server.DefaultConfig())
+	//The kill feed goes out for every kill, nobody here needs it
+	go func() {
+		for range hub.BroadcastChan {
+		}
+	}()
+	client := servertest.NewTestClient(hub)
+	player := &objects.Player{Name: "hungry", Radius: 20}
+	hub.SharedGameObjects.Players.Add(player, client.Id())
+	hub.EventBus.Publish(server.PlayerJoined{PlayerId: client.Id(), Player: player})
+
+	for range 9 {
+		hub.EventBus.Publish(server.PlayerEaten{EaterId: client.Id(), VictimId: client.Id() + 1})
+	}
+	if ids := unlockedIds(client); len(ids) != 0 {
+		t.Fatalf("unlocked %v after 9 kills", ids)
+	}
+	hub.EventBus.Publish(server.PlayerEaten{EaterId: client.Id(), VictimId: client.Id() + 1})
+	hub.EventBus.Publish(server.PlayerEaten{EaterId: client.Id(), VictimId: client.Id() + 1})
+	if ids := unlockedIds(client); len(ids) != 1 || ids[0] != "glutton" {
+		t.Errorf("unlocked %v after 11 kills, want glutton once", ids)
+	}
+
+	//Time alone is enough for some, the next thing eaten checks again
+	clock.Advance(5 * time.Minute)
+	hub.EventBus.Publish(server.SporeEaten{PlayerId: client.Id()})
+	if ids := unlockedIds(client); len(ids) != 2 || ids[1] != "survivor" {
+		t.Errorf("unlocked %v after 5 minutes, want survivor next", ids)
+	}
+	if !player.Achievements["glutton"] || !player.Achievements["survivor"] {
+		t.Errorf("the player has %v, the unlocks should stay with them", player.Achievements)
+	}
+}
+
+func TestDyingStartsTheProgressOver(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	go func() {
+		for range hub.BroadcastChan {
+		}
+	}()
+	client := servertest.NewTestClient(hub)
+	player := &objects.Player{Name: "unlucky", Radius: 20}
+	hub.SharedGameObjects.Players.Add(player, client.Id())
+	hub.EventBus.Publish(server.PlayerJoined{PlayerId: client.Id(), Player: player})
+
+	for range 9 {
+		hub.EventBus.Publish(server.PlayerEaten{EaterId: client.Id(), VictimId: client.Id() + 1})
+	}
+	hub.EventBus.Publish(server.PlayerEaten{EaterId: client.Id() + 1, VictimId: client.Id()})
+	hub.EventBus.Publish(server.PlayerJoined{PlayerId: client.Id(), Player: player})
+	hub.EventBus.Publish(server.PlayerEaten{EaterId: client.Id(), VictimId: client.Id() + 1})
+	if ids := unlockedIds(client); len(ids) != 0 {
+		t.Errorf("unlocked %v with kills spread over two lives", ids)
+	}
+}
@@ -0,0 +1,199 @@
+package server
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"strconv"
+	"strings"
+)
+
+// Checks the request has the admin token as a bearer token, writes an error and returns false if not
+// Admin routes are turned off completely when no token is configured
+func (h *Hub) checkAdmin(writer http.ResponseWriter, request *http.Request) bool {
+	if h.Config().AdminToken == "" {
+		http.Error(writer, "admin commands are disabled", http.StatusNotFound)
+		return false
+	}
+
+	token := strings.TrimPrefix(request.Header.Get("Authorization"), "Bearer ")
+	if subtle.ConstantTimeCompare([]byte(token), []byte(h.Config().AdminToken)) != 1 {
+		http.Error(writer, "unauthorized", http.StatusUnauthorized)
+		return false
+	}
+
+	return true
+}
+
+// Handler for /admin/season/reset?season=<label>, archives and resets the leaderboard
+func (h *Hub) ServeSeasonReset(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodPost {
+		http.Error(writer, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !h.checkAdmin(writer, request) {
+		return
+	}
+
+	season := request.URL.Query().Get("season")
+	if season == "" {
+		http.Error(writer, "missing season", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.ResetSeason(request.Context(), season); err != nil {
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	//Behind a write queue it's only been queued, it happens once the queue gets to it
+	if h.WriteQueue != nil {
+		writer.WriteHeader(http.StatusAccepted)
+		return
+	}
+	writer.WriteHeader(http.StatusNoContent)
+}
+
+// Handler for /admin/freeze?player=<id>&frozen=<true|false>, stops a player where it is without
+// kicking it (it can still be seen and eaten) or lets it move again, and tells its client
+func (h *Hub) ServeFreeze(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodPost {
+		http.Error(writer, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !h.checkAdmin(writer, request) {
+		return
+	}
+
+	query := request.URL.Query()
+	playerId, err := strconv.ParseUint(query.Get("player"), 10, 64)
+	if err != nil {
+		http.Error(writer, "player must be a player id", http.StatusBadRequest)
+		return
+	}
+	frozen, err := strconv.ParseBool(query.Get("frozen"))
+	if err != nil {
+		http.Error(writer, "frozen must be true or false", http.StatusBadRequest)
+		return
+	}
+
+	player, exists := h.SharedGameObjects.Players.Get(playerId)
+	if !exists {
+		http.Error(writer, "that player isn't in the game", http.StatusNotFound)
+		return
+	}
+
+	player.Frozen.Store(frozen)
+	if client, exists := h.Clients.Get(playerId); exists {
+		client.SocketSend(packets.NewFrozen(frozen))
+	}
+
+	writer.WriteHeader(http.StatusNoContent)
+}
+
+// Handler for /admin/teleport?player=<id>&x=<x>&y=<y> or /admin/teleport?player=<id>&to=<id>, moves
+// a player straight to the coords (or to another player) on its next tick, and everyone gets told
+// it teleported so they don't draw it sliding across the map
+func (h *Hub) ServeTeleport(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodPost {
+		http.Error(writer, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !h.checkAdmin(writer, request) {
+		return
+	}
+
+	query := request.URL.Query()
+	playerId, err := strconv.ParseUint(query.Get("player"), 10, 64)
+	if err != nil {
+		http.Error(writer, "player must be a player id", http.StatusBadRequest)
+		return
+	}
+	player, exists := h.SharedGameObjects.Players.Get(playerId)
+	if !exists {
+		http.Error(writer, "that player isn't in the game", http.StatusNotFound)
+		return
+	}
+
+	var to objects.Position
+	if toStr := query.Get("to"); toStr != "" {
+		toId, err := strconv.ParseUint(toStr, 10, 64)
+		if err != nil {
+			http.Error(writer, "to must be a player id", http.StatusBadRequest)
+			return
+		}
+		target, exists := h.SharedGameObjects.Players.Get(toId)
+		if !exists {
+			http.Error(writer, "the player to teleport to isn't in the game", http.StatusNotFound)
+			return
+		}
+		to = objects.Position{X: target.X, Y: target.Y}
+	} else {
+		x, errX := strconv.ParseFloat(query.Get("x"), 64)
+		y, errY := strconv.ParseFloat(query.Get("y"), 64)
+		if errX != nil || errY != nil {
+			http.Error(writer, "x and y must be numbers, or to a player id", http.StatusBadRequest)
+			return
+		}
+		to = objects.Position{X: x, Y: y}
+	}
+
+	if !h.SharedGameObjects.WorldBound.Contains(to.X, to.Y) {
+		http.Error(writer, "coords are outside the world bound", http.StatusBadRequest)
+		return
+	}
+
+	player.PendingTeleport.Store(&to)
+	writer.WriteHeader(http.StatusAccepted)
+}
+
+// Handler for /admin/spawn?type=spore&x=<x>&y=<y>[&radius=<radius>], places an object exactly
+// where it's asked to instead of at random coords, and tells every client about it
+// Spores are the only objects there are for now
+func (h *Hub) ServeSpawn(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodPost {
+		http.Error(writer, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !h.checkAdmin(writer, request) {
+		return
+	}
+
+	query := request.URL.Query()
+	x, errX := strconv.ParseFloat(query.Get("x"), 64)
+	y, errY := strconv.ParseFloat(query.Get("y"), 64)
+	if errX != nil || errY != nil {
+		http.Error(writer, "x and y must be numbers", http.StatusBadRequest)
+		return
+	}
+	if !h.SharedGameObjects.WorldBound.Contains(x, y) {
+		http.Error(writer, "coords are outside the world bound", http.StatusBadRequest)
+		return
+	}
+
+	switch objectType := query.Get("type"); objectType {
+	case "spore":
+		radius := h.newSporeRadius(objects.GlobalRand)
+		if radiusStr := query.Get("radius"); radiusStr != "" {
+			r, err := strconv.ParseFloat(radiusStr, 64)
+			if err != nil || r <= 0 {
+				http.Error(writer, "radius must be a positive number", http.StatusBadRequest)
+				return
+			}
+			radius = r
+		}
+
+		spore := &objects.Spore{X: x, Y: y, Radius: radius}
+		sporeId := h.SharedGameObjects.Spores.Add(spore)
+		h.BroadcastChan <- &packets.Packet{
+			SenderId: 0,
+			Msg:      packets.NewSpore(sporeId, spore, h.SharedGameObjects.WorldBound),
+		}
+		fmt.Fprintf(writer, "%d\n", sporeId)
+
+	default:
+		http.Error(writer, fmt.Sprintf("can't spawn objects of type %q", objectType), http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,166 @@
+package server_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"server/internal/server"
+	"server/internal/server/objects"
+	"server/internal/servertest"
+	"server/pkg/packets"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// Sends a request to an admin handler with the token as a bearer token, the broadcasts it makes
+// are taken off the hub's channel and given back
+func adminRequest(hub *server.Hub, handler http.HandlerFunc, method, url, token string) (*httptest.ResponseRecorder, []*packets.Packet) {
+	request := httptest.NewRequest(method, url, nil)
+	if token != "" {
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+	recorder := httptest.NewRecorder()
+
+	done := make(chan struct{})
+	go func() {
+		handler(recorder, request)
+		close(done)
+	}()
+	var broadcasts []*packets.Packet
+	for {
+		select {
+		case packet := <-hub.BroadcastChan:
+			broadcasts = append(broadcasts, packet)
+		case <-done:
+			return recorder, broadcasts
+		}
+	}
+}
+
+func TestSpawnRefusesBadRequests(t *testing.T) {
+	config := server.DefaultConfig()
+	config.AdminToken = "secret"
+	config.WorldBound = 1000
+	hub, _ := servertest.NewTestHub(config)
+
+	tests := []struct {
+		name   string
+		method string
+		url    string
+		token  string
+		want   int
+	}{
+		{"wrong method", http.MethodGet, "/admin/spawn?type=spore&x=0&y=0", "secret", http.StatusMethodNotAllowed},
+		{"no token", http.MethodPost, "/admin/spawn?type=spore&x=0&y=0", "", http.StatusUnauthorized},
+		{"wrong token", http.MethodPost, "/admin/spawn?type=spore&x=0&y=0", "guess", http.StatusUnauthorized},
+		{"not a number", http.MethodPost, "/admin/spawn?type=spore&x=left&y=0", "secret", http.StatusBadRequest},
+		{"outside the world", http.MethodPost, "/admin/spawn?type=spore&x=1001&y=0", "secret", http.StatusBadRequest},
+		{"negative radius", http.MethodPost, "/admin/spawn?type=spore&x=0&y=0&radius=-3", "secret", http.StatusBadRequest},
+		{"unknown type", http.MethodPost, "/admin/spawn?type=boulder&x=0&y=0", "secret", http.StatusBadRequest},
+	}
+	for _, test := range tests {
+		recorder, broadcasts := adminRequest(hub, hub.ServeSpawn, test.method, test.url, test.token)
+		if recorder.Code != test.want {
+			t.Errorf("%s: got status %d, want %d", test.name, recorder.Code, test.want)
+		}
+		if len(broadcasts) != 0 {
+			t.Errorf("%s: broadcast %v", test.name, broadcasts)
+		}
+	}
+	if hub.SharedGameObjects.Spores.Len() != 0 {
+		t.Errorf("%d spores were spawned by bad requests", hub.SharedGameObjects.Spores.Len())
+	}
+}
+
+func TestSpawnPutsTheSporeWhereItsAsked(t *testing.T) {
+	config := server.DefaultConfig()
+	config.AdminToken = "secret"
+	hub, _ := servertest.NewTestHub(config)
+
+	recorder, broadcasts := adminRequest(hub, hub.ServeSpawn, http.MethodPost, "/admin/spawn?type=spore&x=120&y=-40&radius=12", "secret")
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("got status %d: %s", recorder.Code, recorder.Body)
+	}
+
+	sporeId, err := strconv.ParseUint(strings.TrimSpace(recorder.Body.String()), 10, 64)
+	if err != nil {
+		t.Fatalf("the response %q isn't the spore id", recorder.Body)
+	}
+	spore, exists := hub.SharedGameObjects.Spores.Get(sporeId)
+	if !exists || spore.X != 120 || spore.Y != -40 || spore.Radius != 12 {
+		t.Errorf("spore %d is %+v", sporeId, spore)
+	}
+	if len(broadcasts) != 1 || broadcasts[0].Msg.(*packets.Packet_Spore).Spore.Id != sporeId {
+		t.Errorf("broadcast %v, want the new spore", broadcasts)
+	}
+}
+
+func TestAdminRoutesAreOffWithoutAToken(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	recorder, _ := adminRequest(hub, hub.ServeSpawn, http.MethodPost, "/admin/spawn?type=spore&x=0&y=0", "")
+	if recorder.Code != http.StatusNotFound {
+		t.Errorf("got status %d, want %d", recorder.Code, http.StatusNotFound)
+	}
+}
+
+func TestFreezeStopsThePlayerAndTellsTheirClient(t *testing.T) {
+	config := server.DefaultConfig()
+	config.AdminToken = "secret"
+	hub, _ := servertest.NewTestHub(config)
+	client := playerAt(hub, "icy", 0)
+	player, _ := hub.SharedGameObjects.Players.Get(client.Id())
+
+	for _, frozen := range []bool{true, false} {
+		url := fmt.Sprintf("/admin/freeze?player=%d&frozen=%t", client.Id(), frozen)
+		if recorder, _ := adminRequest(hub, hub.ServeFreeze, http.MethodPost, url, "secret"); recorder.Code != http.StatusNoContent {
+			t.Fatalf("freezing got status %d: %s", recorder.Code, recorder.Body)
+		}
+		if player.Frozen.Load() != frozen {
+			t.Errorf("player frozen is %t, want %t", player.Frozen.Load(), frozen)
+		}
+	}
+	told := servertest.MessagesOf[*packets.Packet_Frozen](client.SentMessages())
+	if len(told) != 2 || !told[0].Frozen.Frozen || told[1].Frozen.Frozen {
+		t.Errorf("the client was sent %v, want frozen then not", told)
+	}
+
+	url := fmt.Sprintf("/admin/freeze?player=%d&frozen=true", client.Id()+1)
+	if recorder, _ := adminRequest(hub, hub.ServeFreeze, http.MethodPost, url, "secret"); recorder.Code != http.StatusNotFound {
+		t.Errorf("freezing a player that isn't in the game got status %d, want %d", recorder.Code, http.StatusNotFound)
+	}
+}
+
+func TestTeleportLeavesThePlayerAPendingPosition(t *testing.T) {
+	config := server.DefaultConfig()
+	config.AdminToken = "secret"
+	hub, _ := servertest.NewTestHub(config)
+	lost := playerAt(hub, "lost", 0)
+	guide := playerAt(hub, "guide", 300)
+	player, _ := hub.SharedGameObjects.Players.Get(lost.Id())
+
+	tests := []struct {
+		query  string
+		status int
+		want   *objects.Position
+	}{
+		{fmt.Sprintf("player=%d&x=100&y=-50", lost.Id()), http.StatusAccepted, &objects.Position{X: 100, Y: -50}},
+		{fmt.Sprintf("player=%d&to=%d", lost.Id(), guide.Id()), http.StatusAccepted, &objects.Position{X: 300, Y: 0}},
+		{fmt.Sprintf("player=%d&x=100", lost.Id()), http.StatusBadRequest, nil},
+		{fmt.Sprintf("player=%d&x=1e9&y=0", lost.Id()), http.StatusBadRequest, nil},
+		{fmt.Sprintf("player=%d&to=%d", lost.Id(), guide.Id()+10), http.StatusNotFound, nil},
+		{fmt.Sprintf("player=%d&x=0&y=0", guide.Id()+10), http.StatusNotFound, nil},
+		{"player=nobody&x=0&y=0", http.StatusBadRequest, nil},
+	}
+	for _, test := range tests {
+		player.PendingTeleport.Store(nil)
+		recorder, _ := adminRequest(hub, hub.ServeTeleport, http.MethodPost, "/admin/teleport?"+test.query, "secret")
+		if recorder.Code != test.status {
+			t.Errorf("%s got status %d, want %d", test.query, recorder.Code, test.status)
+		}
+		pending := player.PendingTeleport.Load()
+		if (pending == nil) != (test.want == nil) || pending != nil && *pending != *test.want {
+			t.Errorf("%s left the player headed to %v, want %v", test.query, pending, test.want)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package server
+
+import (
+	"io"
+	"log"
+	"sync"
+	"time"
+)
+
+// The kinds of suspicious things a client can do
+type SuspicionType string
+
+const (
+	SuspicionTooFar        SuspicionType = "too_far"        //consumed something it wasn't close enough to
+	SuspicionDropCooldown  SuspicionType = "drop_cooldown"  //ate back its own spore too quickly
+	SuspicionNotMassive    SuspicionType = "not_massive"    //tried to eat a player that isn't small enough
+	SuspicionMissingObject SuspicionType = "missing_object" //referenced a spore or player that doesn't exist
+	SuspicionFastGrowth    SuspicionType = "fast_growth"    //gained mass faster than it possibly could
+)
+
+// A single validation failure from a client
+type SuspicionEvent struct {
+	ClientId uint64
+	Type     SuspicionType
+	Details  string
+	Time     time.Time
+	Count    int //how many suspicion events this client has had so far, including this one
+}
+
+// Anything that wants to receive suspicion events (a log, a file, some external system...)
+type SuspicionSink interface {
+	Report(event SuspicionEvent)
+}
+
+// The default sink, just writes every event as a line with the standard logger format
+type LogSuspicionSink struct {
+	logger *log.Logger
+}
+
+func NewLogSuspicionSink(writer io.Writer) *LogSuspicionSink {
+	return &LogSuspicionSink{
+		logger: log.New(writer, "AntiCheat: ", log.LstdFlags),
+	}
+}
+
+func (s *LogSuspicionSink) Report(event SuspicionEvent) {
+	s.logger.Printf("client=%d type=%s count=%d details=%q", event.ClientId, event.Type, event.Count, event.Details)
+}
+
+// Keeps count of suspicion events per client and passes them on to the sink
+type AntiCheat struct {
+	sink      SuspicionSink
+	threshold int //0 means there's no threshold
+	clock     Clock
+
+	counts   map[uint64]int
+	countMux sync.Mutex
+}
+
+func NewAntiCheat(sink SuspicionSink, threshold int, clock Clock) *AntiCheat {
+	return &AntiCheat{
+		sink:      sink,
+		threshold: threshold,
+		clock:     clock,
+		counts:    make(map[uint64]int),
+	}
+}
+
+// Records the event for its client and sends it to the sink
+// Returns true once the client has reached the threshold
+func (a *AntiCheat) Report(clientId uint64, kind SuspicionType, details string) bool {
+	a.countMux.Lock()
+	a.counts[clientId]++
+	count := a.counts[clientId]
+	a.countMux.Unlock()
+
+	a.sink.Report(SuspicionEvent{
+		ClientId: clientId,
+		Type:     kind,
+		Details:  details,
+		Time:     a.clock.Now(),
+		Count:    count,
+	})
+
+	return a.threshold > 0 && count >= a.threshold
+}
+
+// Returns how many suspicion events a client has had
+func (a *AntiCheat) Count(clientId uint64) int {
+	a.countMux.Lock()
+	defer a.countMux.Unlock()
+	return a.counts[clientId]
+}
+
+// Clears the count for a client, called when the client leaves so ids can be reused
+func (a *AntiCheat) Forget(clientId uint64) {
+	a.countMux.Lock()
+	defer a.countMux.Unlock()
+	delete(a.counts, clientId)
+}
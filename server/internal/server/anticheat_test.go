@@ -0,0 +1,73 @@
+package server_test
+
+import (
+	"bytes"
+	"server/internal/server"
+	"server/internal/servertest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Keeps every event it gets so the test can look at them
+type recordingSink struct {
+	events []server.SuspicionEvent
+}
+
+func (s *recordingSink) Report(event server.SuspicionEvent) {
+	s.events = append(s.events, event)
+}
+
+func TestAntiCheatCountsEachClientOnItsOwn(t *testing.T) {
+	sink := &recordingSink{}
+	clock := servertest.NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	antiCheat := server.NewAntiCheat(sink, 3, clock)
+
+	for i := 1; i <= 3; i++ {
+		reached := antiCheat.Report(1, server.SuspicionTooFar, "ate a spore across the map")
+		if reached != (i == 3) {
+			t.Errorf("report %d says the threshold was reached: %v", i, reached)
+		}
+	}
+	if antiCheat.Report(2, server.SuspicionNotMassive, "tried to eat a bigger player") {
+		t.Error("another client's reports counted towards its threshold")
+	}
+
+	if len(sink.events) != 4 {
+		t.Fatalf("the sink got %d events, want 4", len(sink.events))
+	}
+	last := sink.events[2]
+	if last.ClientId != 1 || last.Type != server.SuspicionTooFar || last.Count != 3 || !last.Time.Equal(clock.Now()) {
+		t.Errorf("third event is %+v", last)
+	}
+	if other := sink.events[3]; other.ClientId != 2 || other.Count != 1 {
+		t.Errorf("other client's event is %+v", other)
+	}
+
+	antiCheat.Forget(1)
+	if antiCheat.Count(1) != 0 || antiCheat.Count(2) != 1 {
+		t.Errorf("counts after forgetting client 1 are %d and %d", antiCheat.Count(1), antiCheat.Count(2))
+	}
+}
+
+func TestAntiCheatWithoutAThresholdNeverActs(t *testing.T) {
+	antiCheat := server.NewAntiCheat(&recordingSink{}, 0, server.RealClock{})
+	for i := 0; i < 100; i++ {
+		if antiCheat.Report(1, server.SuspicionMissingObject, "") {
+			t.Fatalf("report %d reached a threshold of 0", i+1)
+		}
+	}
+}
+
+func TestLogSuspicionSinkWritesALinePerEvent(t *testing.T) {
+	var out bytes.Buffer
+	sink := server.NewLogSuspicionSink(&out)
+	sink.Report(server.SuspicionEvent{ClientId: 7, Type: server.SuspicionDropCooldown, Details: "too soon", Count: 2})
+
+	line := out.String()
+	for _, want := range []string{"AntiCheat: ", "client=7", "type=drop_cooldown", "count=2", `details="too soon"`} {
+		if !strings.Contains(line, want) {
+			t.Errorf("logged %q, missing %q", line, want)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+)
+
+// The settings that can be changed while the server is running, everything else in the config
+// needs a restart. The balance file is JSON with the same field names, fields left out keep
+// their current value and anything that isn't one of these is an error
+type Balance struct {
+	ConsumeMassRatio      float64
+	SporeConsumeRatio     float64
+	ConsumeBuffer         float64
+	ConsumeOverlap        float64
+	DeathScatterFraction  float64
+	OutOfBoundsMassLoss   float64
+	DriftStrength         float64
+	SoftCollision         bool
+	CollisionPushStrength float64
+	CollisionRadiusScale  float64
+	EjectMass             float64
+	EjectSpeed            float64
+	SporeRadiusMean       float64
+	SporeRadiusStdDev     float64
+	SporeRadiusMin        float64
+	SporeRadiusMax        float64
+}
+
+func balanceOf(config *Config) Balance {
+	return Balance{
+		ConsumeMassRatio:      config.ConsumeMassRatio,
+		SporeConsumeRatio:     config.SporeConsumeRatio,
+		ConsumeBuffer:         config.ConsumeBuffer,
+		ConsumeOverlap:        config.ConsumeOverlap,
+		DeathScatterFraction:  config.DeathScatterFraction,
+		OutOfBoundsMassLoss:   config.OutOfBoundsMassLoss,
+		DriftStrength:         config.DriftStrength,
+		SoftCollision:         config.SoftCollision,
+		CollisionPushStrength: config.CollisionPushStrength,
+		CollisionRadiusScale:  config.CollisionRadiusScale,
+		EjectMass:             config.EjectMass,
+		EjectSpeed:            config.EjectSpeed,
+		SporeRadiusMean:       config.SporeRadiusMean,
+		SporeRadiusStdDev:     config.SporeRadiusStdDev,
+		SporeRadiusMin:        config.SporeRadiusMin,
+		SporeRadiusMax:        config.SporeRadiusMax,
+	}
+}
+
+func (b Balance) applyTo(config *Config) {
+	config.ConsumeMassRatio = b.ConsumeMassRatio
+	config.SporeConsumeRatio = b.SporeConsumeRatio
+	config.ConsumeBuffer = b.ConsumeBuffer
+	config.ConsumeOverlap = b.ConsumeOverlap
+	config.DeathScatterFraction = b.DeathScatterFraction
+	config.OutOfBoundsMassLoss = b.OutOfBoundsMassLoss
+	config.DriftStrength = b.DriftStrength
+	config.SoftCollision = b.SoftCollision
+	config.CollisionPushStrength = b.CollisionPushStrength
+	config.CollisionRadiusScale = b.CollisionRadiusScale
+	config.EjectMass = b.EjectMass
+	config.EjectSpeed = b.EjectSpeed
+	config.SporeRadiusMean = b.SporeRadiusMean
+	config.SporeRadiusStdDev = b.SporeRadiusStdDev
+	config.SporeRadiusMin = b.SporeRadiusMin
+	config.SporeRadiusMax = b.SporeRadiusMax
+}
+
+// Catches values the game can't run with, so a bad file never makes it into the config
+func (b Balance) validate() error {
+	switch {
+	case b.ConsumeMassRatio < 1:
+		return errors.New("ConsumeMassRatio has to be at least 1, or players could eat each other")
+	case b.SporeConsumeRatio < 0:
+		return errors.New("SporeConsumeRatio can't be negative")
+	case b.ConsumeBuffer < 0:
+		return errors.New("ConsumeBuffer can't be negative")
+	case b.ConsumeOverlap < 0 || b.ConsumeOverlap > 1:
+		return errors.New("ConsumeOverlap has to be between 0 and 1")
+	case b.DeathScatterFraction < 0 || b.DeathScatterFraction > 1:
+		return errors.New("DeathScatterFraction has to be between 0 and 1")
+	case b.OutOfBoundsMassLoss < 0 || b.DriftStrength < 0 || b.CollisionPushStrength < 0:
+		return errors.New("OutOfBoundsMassLoss, DriftStrength and CollisionPushStrength can't be negative")
+	case b.CollisionRadiusScale <= 0:
+		return errors.New("CollisionRadiusScale has to be positive")
+	case b.EjectMass <= 0 || b.EjectSpeed < 0:
+		return errors.New("EjectMass has to be positive and EjectSpeed can't be negative")
+	case b.SporeRadiusMin <= 0 || b.SporeRadiusMax < b.SporeRadiusMin:
+		return errors.New("SporeRadiusMin has to be positive and SporeRadiusMax at least as big")
+	case b.SporeRadiusMean <= 0 || b.SporeRadiusStdDev < 0:
+		return errors.New("SporeRadiusMean has to be positive and SporeRadiusStdDev can't be negative")
+	}
+	return nil
+}
+
+// Reads the balance file from the config and swaps in a new config with its values
+// Everything reading the config picks them up the next time it reads it (most things read it
+// every tick), and if the file can't be read or has bad values nothing changes
+func (h *Hub) ReloadBalance() error {
+	h.reloadMux.Lock()
+	defer h.reloadMux.Unlock()
+
+	current := h.Config()
+	if current.BalanceFile == "" {
+		return errors.New("no balance file configured")
+	}
+
+	data, err := os.ReadFile(current.BalanceFile)
+	if err != nil {
+		return fmt.Errorf("error reading the balance file: %w", err)
+	}
+
+	balance := balanceOf(current)
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&balance); err != nil {
+		return fmt.Errorf("error parsing the balance file: %w", err)
+	}
+	if err := balance.validate(); err != nil {
+		return fmt.Errorf("invalid balance: %w", err)
+	}
+
+	updated := *current
+	balance.applyTo(&updated)
+	h.config.Store(&updated)
+	log.Printf("Balance reloaded from %s", current.BalanceFile)
+	return nil
+}
+
+// Handler for /admin/balance/reload, same as sending the server a SIGHUP
+func (h *Hub) ServeBalanceReload(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodPost {
+		http.Error(writer, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !h.checkAdmin(writer, request) {
+		return
+	}
+
+	if err := h.ReloadBalance(); err != nil {
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	writer.WriteHeader(http.StatusNoContent)
+}
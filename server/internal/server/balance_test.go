@@ -0,0 +1,66 @@
+package server_test
+
+import (
+	"os"
+	"path/filepath"
+	"server/internal/server"
+	"server/internal/servertest"
+	"testing"
+)
+
+// A hub reading its balance from a file in a temporary directory, with what writes to that file
+func balanceHub(t *testing.T) (*server.Hub, func(string)) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "balance.json")
+	config := server.DefaultConfig()
+	config.BalanceFile = path
+	hub, _ := servertest.NewTestHub(config)
+	write := func(contents string) {
+		if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return hub, write
+}
+
+func TestReloadingTheBalanceOnlyChangesWhatsInTheFile(t *testing.T) {
+	hub, write := balanceHub(t)
+	before := hub.Config()
+	write(`{"EjectMass": 500, "SoftCollision": true}`)
+
+	if err := hub.ReloadBalance(); err != nil {
+		t.Fatalf("reloading: %v", err)
+	}
+	after := hub.Config()
+	if after.EjectMass != 500 || !after.SoftCollision {
+		t.Errorf("eject mass is %f and soft collision %v, want the file's values", after.EjectMass, after.SoftCollision)
+	}
+	if after.EjectSpeed != before.EjectSpeed || after.WorldBound != before.WorldBound {
+		t.Error("settings that weren't in the file changed")
+	}
+	//Anything still holding the old config keeps seeing it as it was
+	if before.EjectMass == 500 {
+		t.Error("the old config was changed in place")
+	}
+}
+
+func TestBadBalanceFilesChangeNothing(t *testing.T) {
+	for name, contents := range map[string]string{
+		"invalid value":   `{"ConsumeMassRatio": 0.5}`,
+		"unknown setting": `{"WorldBound": 100}`,
+		"not json":        `EjectMass = 500`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			hub, write := balanceHub(t)
+			before := hub.Config()
+			write(contents)
+
+			if err := hub.ReloadBalance(); err == nil {
+				t.Error("the file was accepted")
+			}
+			if hub.Config() != before {
+				t.Error("the config was swapped anyway")
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package clients
+
+import (
+	"io"
+	"log"
+	"server/internal/server"
+	"server/internal/server/objects"
+	"server/internal/servertest"
+	"server/pkg/packets"
+	"testing"
+)
+
+// Passes everything it's handed on to the socket, like InGame does with other players' updates
+type forwardingState struct {
+	client server.ClientInterfacer
+}
+
+func (s *forwardingState) Name() string                             { return "Forwarding" }
+func (s *forwardingState) SetClient(client server.ClientInterfacer) { s.client = client }
+func (s *forwardingState) OnEnter()                                 {}
+func (s *forwardingState) OnExit()                                  {}
+
+func (s *forwardingState) HandleMessage(senderId uint64, message packets.Msg) {
+	s.client.SocketSendAs(message, senderId)
+}
+
+// Registers clients that forward every broadcast to their send channel, without a socket
+func forwardingClients(hub *server.Hub, count int) []*WebSocketClient {
+	clients := make([]*WebSocketClient, count)
+	for i := range clients {
+		c := &WebSocketClient{
+			hub:      hub,
+			logger:   log.New(io.Discard, "", 0),
+			sendChan: make(chan []byte, 1),
+			codec:    protoCodec,
+		}
+		state := &forwardingState{client: c}
+		c.state = state
+		c.handler = state.HandleMessage
+		c.id = hub.Clients.Add(c)
+		clients[i] = c
+	}
+	return clients
+}
+
+// One tick at 20Hz with 100 players in game: each of them broadcasts its update and everyone
+// else forwards it to their socket
+func BenchmarkBroadcastPlayerUpdates(b *testing.B) {
+	const players = 100
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	clients := forwardingClients(hub, players)
+	bound := hub.SharedGameObjects.WorldBound
+
+	updates := make([]*packets.Packet, players)
+	for i, c := range clients {
+		player := &objects.Player{Name: "player", X: float64(i), Y: float64(-i), Radius: 20, Direction: 1.5, Speed: 150}
+		updates[i] = &packets.Packet{SenderId: c.id, Msg: packets.NewPlayer(c.id, player, bound)}
+	}
+
+	//Takes what each client was sent, so the send channels don't fill up and start dropping
+	drain := func(sender uint64) {
+		for _, c := range clients {
+			if c.id != sender {
+				<-c.sendChan
+			}
+		}
+	}
+
+	//How the hub used to do it, every recipient marshals the packet on its own
+	b.Run("marshal per client", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for _, packet := range updates {
+				for _, c := range clients {
+					if c.id != packet.SenderId {
+						c.ProcessMessage(packet.SenderId, packet.Msg)
+					}
+				}
+				drain(packet.SenderId)
+			}
+		}
+	})
+
+	//The hub marshals each broadcast once and the recipients share the bytes
+	b.Run("marshal once", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for _, packet := range updates {
+				hub.DeliverBroadcast(packet)
+				drain(packet.SenderId)
+			}
+		}
+	})
+}
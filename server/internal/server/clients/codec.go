@@ -0,0 +1,58 @@
+package clients
+
+import (
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// The WebSocket subprotocols a client can ask for, each picks how packets are encoded
+// Clients that don't ask for one get protobuf, like before subprotocols were a thing
+const (
+	SubprotocolProto = "nodehunger-v1-proto"
+	SubprotocolJson  = "nodehunger-v1-json" //easier to debug, but bigger and slower
+)
+
+// How packets are turned into bytes and back for one client
+type codec struct {
+	messageType int //websocket.BinaryMessage or websocket.TextMessage
+	marshal     func(proto.Message) ([]byte, error)
+	unmarshal   func([]byte, proto.Message) error
+}
+
+var protoCodec = codec{
+	messageType: websocket.BinaryMessage,
+	marshal:     proto.Marshal,
+	unmarshal:   proto.Unmarshal,
+}
+
+var jsonCodec = codec{
+	messageType: websocket.TextMessage,
+	marshal:     protojson.Marshal,
+	unmarshal:   protojson.Unmarshal,
+}
+
+// Subprotocols in the order the server prefers them
+var supportedSubprotocols = []string{SubprotocolProto, SubprotocolJson}
+
+func codecFor(subprotocol string) codec {
+	if subprotocol == SubprotocolJson {
+		return jsonCodec
+	}
+	return protoCodec
+}
+
+// Whether the client asked for no subprotocol at all, or at least one the server supports
+func acceptableSubprotocols(requested []string) bool {
+	if len(requested) == 0 {
+		return true
+	}
+	for _, subprotocol := range requested {
+		for _, supported := range supportedSubprotocols {
+			if subprotocol == supported {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,41 @@
+package clients
+
+import (
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Keeps the last ping sent and when it went out, so the round trip time is measured from our own
+// clock. The client only gets to echo the payload back, a pong that doesn't match the ping we're
+// waiting on (made up, or for an older ping) is ignored, so it can't make its latency look bigger
+type pingTracker struct {
+	mux     sync.Mutex
+	nextSeq uint64
+	payload string //payload of the ping waiting for its pong, empty if there isn't one
+	sentAt  time.Time
+}
+
+// Remembers a ping going out now and returns the payload to send with it
+func (p *pingTracker) next(now time.Time) []byte {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	p.nextSeq++
+	p.payload = strconv.FormatUint(p.nextSeq, 10)
+	p.sentAt = now
+	return []byte(p.payload)
+}
+
+// Matches a pong against the ping waiting for it, returning the round trip time if it's the one
+// Each ping only counts once
+func (p *pingTracker) pong(payload string, now time.Time) (time.Duration, bool) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	if p.payload == "" || payload != p.payload {
+		return 0, false
+	}
+	p.payload = ""
+	return now.Sub(p.sentAt), true
+}
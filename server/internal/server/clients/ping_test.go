@@ -0,0 +1,41 @@
+package clients
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPingTrackerMeasuresFromTheSendTime(t *testing.T) {
+	var pings pingTracker
+	sentAt := time.Unix(1000, 0)
+	payload := pings.next(sentAt)
+
+	rtt, ok := pings.pong(string(payload), sentAt.Add(80*time.Millisecond))
+	if !ok || rtt != 80*time.Millisecond {
+		t.Errorf("got %v, %v for the matching pong, want 80ms, true", rtt, ok)
+	}
+
+	//The same pong again doesn't count a second time
+	if _, ok := pings.pong(string(payload), sentAt.Add(time.Hour)); ok {
+		t.Error("a repeated pong was accepted")
+	}
+}
+
+func TestPingTrackerIgnoresForgedPongs(t *testing.T) {
+	var pings pingTracker
+	sentAt := time.Unix(1000, 0)
+
+	//An old style payload with a made up send time, from before any ping
+	if _, ok := pings.pong("1", sentAt); ok {
+		t.Error("a pong with no ping out was accepted")
+	}
+
+	old := pings.next(sentAt)
+	pings.next(sentAt.Add(5 * time.Second))
+	if _, ok := pings.pong(string(old), sentAt.Add(6*time.Second)); ok {
+		t.Error("a pong for an older ping was accepted")
+	}
+	if _, ok := pings.pong("0", sentAt.Add(6*time.Second)); ok {
+		t.Error("a made up pong was accepted")
+	}
+}
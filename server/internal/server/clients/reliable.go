@@ -0,0 +1,85 @@
+package clients
+
+import (
+	"sync"
+	"time"
+)
+
+// An important packet that was sent but hasn't been acked yet
+type pendingPacket struct {
+	data     []byte
+	sentAt   time.Time
+	attempts int
+}
+
+// Keeps track of important packets until the client acks them, so they can be sent again if the
+// first send got dropped (the send channel drops packets when it's full)
+type reliableTracker struct {
+	nextSeq uint64
+	pending map[uint64]*pendingPacket
+	mux     sync.Mutex
+}
+
+func newReliableTracker() *reliableTracker {
+	return &reliableTracker{
+		nextSeq: 1,
+		pending: make(map[uint64]*pendingPacket),
+	}
+}
+
+// Reserves the sequence number for the next important packet
+func (r *reliableTracker) reserveSeq() uint64 {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	seq := r.nextSeq
+	r.nextSeq++
+	return seq
+}
+
+// Starts waiting for the ack of the packet with this seq
+func (r *reliableTracker) track(seq uint64, data []byte) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	r.pending[seq] = &pendingPacket{data: data, sentAt: time.Now(), attempts: 1}
+}
+
+// The client got the packet, no need to send it again
+// Returns false if we weren't waiting for this seq (already acked or given up on)
+func (r *reliableTracker) ack(seq uint64) bool {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if _, exists := r.pending[seq]; !exists {
+		return false
+	}
+	delete(r.pending, seq)
+	return true
+}
+
+// Returns the packets that haven't been acked within the timeout so they can be sent again
+// Packets that were already sent maxAttempts times are given up on and returned in expired
+func (r *reliableTracker) due(timeout time.Duration, maxAttempts int) (resend [][]byte, expired []uint64) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	now := time.Now()
+	for seq, packet := range r.pending {
+		if now.Sub(packet.sentAt) < timeout {
+			continue
+		}
+
+		if packet.attempts >= maxAttempts {
+			delete(r.pending, seq)
+			expired = append(expired, seq)
+			continue
+		}
+
+		packet.attempts++
+		packet.sentAt = now
+		resend = append(resend, packet.data)
+	}
+
+	return resend, expired
+}
@@ -0,0 +1,50 @@
+package clients
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReliableTrackerResendsUntilAcked(t *testing.T) {
+	tracker := newReliableTracker()
+	first, second := tracker.reserveSeq(), tracker.reserveSeq()
+	if first != 1 || second != 2 {
+		t.Fatalf("reserved seqs %d and %d, want 1 and 2", first, second)
+	}
+	tracker.track(first, []byte("first"))
+	tracker.track(second, []byte("second"))
+
+	if !tracker.ack(first) {
+		t.Error("the ack for a packet being tracked wasn't taken")
+	}
+	if tracker.ack(first) {
+		t.Error("the same ack was taken twice")
+	}
+
+	//Nothing's due until the timeout is up
+	if resend, expired := tracker.due(time.Hour, 3); len(resend) != 0 || len(expired) != 0 {
+		t.Errorf("before the timeout got %q to resend and %v expired", resend, expired)
+	}
+	resend, _ := tracker.due(0, 3)
+	if len(resend) != 1 || string(resend[0]) != "second" {
+		t.Errorf("resending %q, want only the packet that wasn't acked", resend)
+	}
+}
+
+func TestReliableTrackerGivesUpAfterMaxAttempts(t *testing.T) {
+	tracker := newReliableTracker()
+	seq := tracker.reserveSeq()
+	tracker.track(seq, []byte("lost"))
+
+	//Sent once already, so one more try with a max of 2
+	if resend, expired := tracker.due(0, 2); len(resend) != 1 || len(expired) != 0 {
+		t.Fatalf("first time due: %q to resend, %v expired", resend, expired)
+	}
+	resend, expired := tracker.due(0, 2)
+	if len(resend) != 0 || len(expired) != 1 || expired[0] != seq {
+		t.Errorf("second time due: %q to resend, %v expired, want %d to expire", resend, expired, seq)
+	}
+	if tracker.ack(seq) {
+		t.Error("a late ack for a packet given up on was taken")
+	}
+}
@@ -2,15 +2,18 @@ package clients
 
 import (
 	"fmt"
+	"io"
 	"log"
 	"net/http"
+	"sync/atomic"
+	"time"
 
 	"server/internal/server"
+	"server/internal/server/objects"
 	"server/internal/server/states"
 	"server/pkg/packets"
 
 	"github.com/gorilla/websocket"
-	"google.golang.org/protobuf/proto"
 )
 
 // Implementation of the websocket client
@@ -18,12 +21,52 @@ type WebSocketClient struct {
 	id       uint64
 	conn     *websocket.Conn
 	hub      *server.Hub
-	sendChan chan *packets.Packet
+	sendChan chan []byte //already marshaled packets waiting to be written
 	state    server.ClientStateHandler
 	logger   *log.Logger
 	dbTx     *server.DbTx
+	codec    codec //picked from the subprotocol the client asked for
+
+	//The state's HandleMessage wrapped in the hub's middleware
+	handler server.MessageHandler
+
+	//Total bytes written to the socket, atomic since the metrics handler reads it from another goroutine
+	bytesSent atomic.Uint64
+
+	//Packets dropped because the send channel was full
+	dropped atomic.Uint64
+
+	//Round trip time in nanoseconds, measured with websocket pings
+	rtt   atomic.Int64
+	pings pingTracker
+
+	//Version the client reported, stored atomically since metrics read it from another goroutine
+	version atomic.Value
+
+	//Important packets waiting to be acked, only resent if the client said it supports acks
+	reliable     *reliableTracker
+	supportsAcks atomic.Bool
+
+	//Tasks from other goroutines, run by the read pump between packets
+	tasks chan func()
+
+	//Set once Close starts, so the state can tell a dropped connection from a normal state change
+	closing atomic.Bool
+
+	//Closed once Close is done, stops the write pump
+	done chan struct{}
+
+	//Last time the client sent anything but a heartbeat (unix nanos), for the hub to read
+	lastActivity atomic.Int64
+
+	//The current state's name and when it was entered (unix nanos), for the hub to read
+	stateName      atomic.Value
+	stateEnteredAt atomic.Int64
 }
 
+// How often the write pump pings the client to measure the round trip time
+const pingInterval = 5 * time.Second
+
 // Creating a constructor for the websocket client
 // hub is the first function argument, writer is the second argument
 // third argument is the http request
@@ -34,6 +77,14 @@ func NewWebSocketClient(hub *server.Hub, writer http.ResponseWriter, request *ht
 		ReadBufferSize:  1024,
 		WriteBufferSize: 1024,
 		CheckOrigin:     func(_ *http.Request) bool { return true },
+		Subprotocols:    supportedSubprotocols, //the chosen one gets echoed back to the client
+	}
+
+	//A client that only speaks subprotocols we don't know wouldn't understand anything we send
+	requested := websocket.Subprotocols(request)
+	if !acceptableSubprotocols(requested) {
+		http.Error(writer, "Unsupported subprotocol", http.StatusBadRequest)
+		return nil, fmt.Errorf("client asked for unsupported subprotocols %v", requested)
 	}
 
 	conn, err := upgrader.Upgrade(writer, request, nil)
@@ -48,16 +99,35 @@ func NewWebSocketClient(hub *server.Hub, writer http.ResponseWriter, request *ht
 		conn: conn,
 		//Making this channel a buffered one to keep it from clogging if messages are on await
 		//allowing it 256 packets before it starts clogging
-		sendChan: make(chan *packets.Packet, 256),
+		sendChan: make(chan []byte, 256),
 		//Making a custom logger that writes the log with "Client unknown" as the prefix since we don't
 		//have the client id yet, then it prints the standard flags such as date and time
-		logger: log.New(log.Writer(), "Client unknown: ", log.LstdFlags),
-		dbTx:   hub.NewDbTx(),
+		logger:   log.New(hub.LogWriter, "Client unknown: ", log.LstdFlags),
+		dbTx:     hub.NewDbTx(),
+		reliable: newReliableTracker(),
+		codec:    codecFor(conn.Subprotocol()),
+		tasks:    make(chan func(), 64),
+		done:     make(chan struct{}),
 	}
 
+	c.handler = server.ChainMiddleware(c, func(senderId uint64, message packets.Msg) {
+		c.state.HandleMessage(senderId, message)
+	}, hub.Middleware...)
+
+	conn.SetPongHandler(c.handlePong)
+
 	return c, nil
 }
 
+// Every ping remembers when it was sent, so when the pong for it comes back we know how long the
+// round trip took. Pongs that don't answer the last ping are ignored
+func (c *WebSocketClient) handlePong(appData string) error {
+	if rtt, ok := c.pings.pong(appData, time.Now()); ok {
+		c.rtt.Store(int64(rtt))
+	}
+	return nil
+}
+
 // Methods for the WebSocketClient
 // retuns the client ID
 func (c *WebSocketClient) Id() uint64 {
@@ -87,6 +157,8 @@ func (c *WebSocketClient) SetState(state server.ClientStateHandler) {
 	c.logger.Printf("Switching from state %s to %s", prevStateName, newStateName)
 
 	c.state = state
+	c.stateName.Store(newStateName)
+	c.stateEnteredAt.Store(c.hub.Clock.Now().UnixNano())
 
 	if c.state != nil {
 		c.state.SetClient(c)
@@ -97,7 +169,7 @@ func (c *WebSocketClient) SetState(state server.ClientStateHandler) {
 // I'll figure out later how to process the message
 // And I did :D (1/31/26)
 func (c *WebSocketClient) ProcessMessage(senderId uint64, message packets.Msg) {
-	c.state.HandleMessage(senderId, message)
+	c.handler(senderId, message)
 }
 
 // Instead of repeating the logic, simply calling the SendSocketAs function here and will write the logic there
@@ -106,13 +178,64 @@ func (c *WebSocketClient) SocketSend(message packets.Msg) {
 }
 
 func (c *WebSocketClient) SocketSendAs(message packets.Msg, senderId uint64) {
+	//If we're just forwarding the broadcast the hub is delivering, it's already been marshaled
+	//otherwise sort out the senderId and message into a packet and marshal it here
+	//(the hub marshals broadcasts with protobuf, so JSON clients always marshal their own)
+	data, encoded := c.hub.EncodedBroadcast(senderId, message)
+	if !encoded || c.codec.messageType != websocket.BinaryMessage {
+		var err error
+		data, err = c.codec.marshal(&packets.Packet{SenderId: senderId, Msg: message})
+		if err != nil {
+			c.logger.Printf("Error marshaling %T message, dropping it: %v", message, err)
+			return
+		}
+	}
+
+	c.SocketSendRaw(data)
+}
+
+// Sends a packet with a sequence number the client has to ack. Until it does, the packet is sent
+// again every so often (see resendUnacked), so it gets there even if a send is dropped
+func (c *WebSocketClient) SocketSendReliable(message packets.Msg) {
+	seq := c.reliable.reserveSeq()
+	data, err := c.codec.marshal(&packets.Packet{SenderId: c.id, Seq: seq, Msg: message})
+	if err != nil {
+		c.logger.Printf("Error marshaling %T message, dropping it: %v", message, err)
+		return
+	}
+
+	//Clients that don't know about acks would just get duplicates, so only track for the ones that do
+	if c.supportsAcks.Load() {
+		c.reliable.track(seq, data)
+	}
+	c.SocketSendRaw(data)
+}
+
+func (c *WebSocketClient) SetSupportsAcks(supportsAcks bool) {
+	c.supportsAcks.Store(supportsAcks)
+}
+
+// Sends every important packet that hasn't been acked in time again
+func (c *WebSocketClient) resendUnacked() {
+	config := c.Config()
+	resend, expired := c.reliable.due(config.ReliableRetryInterval, config.ReliableMaxAttempts)
+
+	for _, seq := range expired {
+		c.logger.Printf("Giving up on packet %d, it was never acked", seq)
+	}
+	for _, data := range resend {
+		c.SocketSendRaw(data)
+	}
+}
+
+func (c *WebSocketClient) SocketSendRaw(data []byte) {
 	select {
-	//If there's anything to send, sort out the senderId and message, send it to the packet struct
-	//Send that to the send channel
-	case c.sendChan <- &packets.Packet{SenderId: senderId, Msg: message}:
+	//Send the data to the send channel
+	case c.sendChan <- data:
 	//but if the send channel is full(already has 256 packets waiting), drop the message:
 	default:
-		c.logger.Printf("Send channel full, dropping message: %T", message) //%T will print message type
+		c.dropped.Add(1)
+		c.logger.Printf("Send channel full, dropping message (%d bytes)", len(data))
 	}
 }
 
@@ -125,7 +248,23 @@ func (c *WebSocketClient) PassToPeer(message packets.Msg, peerId uint64) {
 
 // Sends the packet to the broadcast channel, which broadcasts to all connected clients
 func (c *WebSocketClient) Broadcast(message packets.Msg) {
-	c.hub.BroadcastChan <- &packets.Packet{SenderId: c.id, Msg: message}
+	packet := &packets.Packet{SenderId: c.id, Msg: message}
+
+	timeout := c.hub.Config().BroadcastTimeout
+	if timeout <= 0 {
+		c.hub.BroadcastChan <- packet
+		return
+	}
+
+	//If the hub is stuck, better to lose the broadcast than to block the tick or read pump forever
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case c.hub.BroadcastChan <- packet:
+	case <-timer.C:
+		c.hub.Counters.BroadcastsDropped.Add(1)
+		c.logger.Printf("Dropped a broadcast (%T), the hub didn't take it within %v", message, timeout)
+	}
 }
 
 // Interfacing with the websocket function, reading messages from that websocket and process them
@@ -137,6 +276,29 @@ func (c *WebSocketClient) ReadPump() {
 		c.Close("Read pump closed")
 	}()
 
+	//The socket is read on its own goroutine, so tasks can run while we wait on the next packet
+	incoming := make(chan *packets.Packet)
+	go c.readSocket(incoming)
+
+	for {
+		select {
+		case packet, ok := <-incoming:
+			if !ok {
+				return
+			}
+			//Finally sending the packet to the client for processing
+			c.processOwn(packet)
+		case task := <-c.tasks:
+			c.runTask(task)
+		}
+	}
+}
+
+// Reads packets off the socket and hands them to the read pump, closing the channel once the
+// connection is gone
+func (c *WebSocketClient) readSocket(incoming chan<- *packets.Packet) {
+	defer close(incoming)
+
 	//infinite loop to read data
 	for {
 		_, data, err := c.conn.ReadMessage()
@@ -145,13 +307,13 @@ func (c *WebSocketClient) ReadPump() {
 			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
 				c.logger.Printf("Error: %v", err)
 			}
-			break //break after logging the error (goes back to defer to cleanup)
+			return //return after logging the error (closes the channel so the read pump cleans up)
 		}
 
 		//else (if there's no error, meaning we have some acceptable data)
 		//create an empty packet
 		packet := &packets.Packet{}
-		err = proto.Unmarshal(data, packet)
+		err = c.codec.unmarshal(data, packet)
 		//^Unmarshal (deserialize the data in that empty packet)
 		//Now checking for errors while unmarshaling:
 		if err != nil {
@@ -159,59 +321,164 @@ func (c *WebSocketClient) ReadPump() {
 			continue //log the error and go to read the next message
 		}
 
-		//Since I'm lazy, allowing the client to not send sender id, gonna assume the client wants to send
-		//as itself (This will help in godot though)
-		if packet.SenderId == 0 {
-			packet.SenderId = c.id
+		//Acks are handled right here, the states never need to see them
+		if ack, ok := packet.Msg.(*packets.Packet_Ack); ok {
+			if !c.reliable.ack(ack.Ack.Seq) {
+				c.logger.Printf("Got an ack for packet %d which wasn't waiting for one", ack.Ack.Seq)
+			}
+			continue
+		}
+
+		//A client can only ever send as itself. Anything with another sender id would look like it
+		//came from the server side of that client (already validated) and get trusted by the states
+		if packet.SenderId != 0 && packet.SenderId != c.id {
+			c.logger.Printf("Client tried to send a %T packet as client %d, overriding", packet.Msg, packet.SenderId)
 		}
+		packet.SenderId = c.id
+
+		incoming <- packet
+	}
+}
+
+// Queues the task for the read pump. Tasks are things like timers changing the state, so if the
+// queue is somehow full the client is far behind and the task is dropped rather than blocking
+func (c *WebSocketClient) RunLater(task func()) {
+	select {
+	case c.tasks <- task:
+	default:
+		c.logger.Println("Task queue full, dropping a task")
+	}
+}
 
-		//Finally sending the packet to the client for processing
-		c.ProcessMessage(packet.SenderId, packet.Msg)
+// Runs a queued task, a panic in it only gets logged like one while processing a packet
+func (c *WebSocketClient) runTask(task func()) {
+	defer c.hub.RecoverPanic(c.id, "running a queued task")
+	task()
+}
+
+// Processes a packet the client sent, if its state panics on it the panic gets logged and the
+// read pump moves on to the next packet
+func (c *WebSocketClient) processOwn(packet *packets.Packet) {
+	defer c.hub.RecoverPanic(c.id, "handling its own %T packet", packet.Msg)
+	//Heartbeats are sent by the client on its own, they don't mean anyone's there
+	if _, heartbeat := packet.Msg.(*packets.Packet_Heartbeat); !heartbeat {
+		c.lastActivity.Store(c.hub.Clock.Now().UnixNano())
 	}
+	c.ProcessMessage(packet.SenderId, packet.Msg)
 }
 
 // This time, we're listening for packets instead of reading them
 func (c *WebSocketClient) WritePump() {
 	defer func() {
 		c.logger.Println("Closing the write pump")
-		c.Close("Write pump closed")
+		//The read pump notices the connection is gone and does the cleanup on its own goroutine
+		c.conn.Close()
 	}()
+	//Runs before the cleanup above, so a panic while writing only closes this client
+	defer c.hub.RecoverPanic(c.id, "writing to the socket")
 
-	for packet := range c.sendChan {
-		//Getting a binary writer because we're working with binary in protobuf:
-		writer, err := c.conn.NextWriter(websocket.BinaryMessage)
+	//Keeping track of how much we've written in the current second for the bandwidth cap
+	windowStart := time.Now()
+	var windowBytes uint64
 
-		if err != nil {
-			c.logger.Printf("Error getting values for %T packet, closing client: %v", packet.Msg, err)
-			return //simply return as we can't do anything now
+	pingTicker := time.NewTicker(pingInterval)
+	defer pingTicker.Stop()
+
+	//Checking on important packets that haven't been acked yet
+	resendTicker := time.NewTicker(c.Config().ReliableRetryInterval)
+	defer resendTicker.Stop()
+
+	for {
+		var data []byte
+		select {
+		case <-pingTicker.C:
+			payload := c.pings.next(time.Now())
+			if err := c.conn.WriteControl(websocket.PingMessage, payload, time.Now().Add(time.Second)); err != nil {
+				c.logger.Printf("Error sending ping, closing client: %v", err)
+				return
+			}
+			continue
+		case <-resendTicker.C:
+			c.resendUnacked()
+			continue
+		case data = <-c.sendChan:
+		case <-c.done:
+			return
 		}
 
-		//Marshaling the packets into databytes for sending:
-		data, err := proto.Marshal(packet)
-		//checkin for any errros while marshaling (serializing):
+		//If we already went over the cap this second, wait for the next one before writing
+		//Packets will pile up in the send channel meanwhile and get dropped once it's full
+		if maxBps := c.hub.Config().MaxClientBytesPerSec; maxBps > 0 && windowBytes >= maxBps {
+			if elapsed := time.Since(windowStart); elapsed < time.Second {
+				c.logger.Printf("Over the bandwidth cap (%d bytes/sec), throttling", maxBps)
+				time.Sleep(time.Second - elapsed)
+			}
+		}
+		if time.Since(windowStart) >= time.Second {
+			windowStart = time.Now()
+			windowBytes = 0
+		}
+
+		//Getting a binary writer for protobuf, or a text one for JSON:
+		writer, err := c.conn.NextWriter(c.codec.messageType)
+
 		if err != nil {
-			c.logger.Printf("Error marshaling %T packet, closing client: %v", packet.Msg, err)
-			continue
+			c.logger.Printf("Error getting writer for packet, closing client: %v", err)
+			return //simply return as we can't do anything now
 		}
 
+		//The packets are already marshaled by the time they get here, so just write the bytes
 		_, err = writer.Write(data) //writing the data
 		if err != nil {
-			c.logger.Printf("Error writing %T packet, closing client: %v", packet.Msg, err)
+			c.logger.Printf("Error writing packet, closing client: %v", err)
 			continue
 		}
 
+		c.bytesSent.Add(uint64(len(data)))
+		windowBytes += uint64(len(data))
+
 		//going to the next line after writing data
 		writer.Write([]byte{'\n'})
 
 		//closing:
 		if err = writer.Close(); err != nil {
-			c.logger.Printf("Error closing writer for %T packet: %v", packet.Msg, err)
+			c.logger.Printf("Error closing writer for packet: %v", err)
 			continue
 		}
 
 	}
 }
 
+func (c *WebSocketClient) BytesSent() uint64 {
+	return c.bytesSent.Load()
+}
+
+func (c *WebSocketClient) DroppedPackets() uint64 {
+	return c.dropped.Load()
+}
+
+func (c *WebSocketClient) Rtt() time.Duration {
+	return time.Duration(c.rtt.Load())
+}
+
+func (c *WebSocketClient) ClientVersion() string {
+	version, _ := c.version.Load().(string)
+	return version
+}
+
+func (c *WebSocketClient) SetClientVersion(version string) {
+	c.version.Store(version)
+	c.logger.Printf("Client version is %s", version)
+}
+
+func (c *WebSocketClient) LogWriter() io.Writer {
+	return c.hub.LogWriter
+}
+
+func (c *WebSocketClient) Config() *server.Config {
+	return c.hub.Config()
+}
+
 // Function for database transactions
 func (c *WebSocketClient) DbTx() *server.DbTx {
 	return c.dbTx
@@ -221,17 +488,101 @@ func (c *WebSocketClient) SharedGameObjects() *server.SharedGameObjects {
 	return c.hub.SharedGameObjects
 }
 
-// Closing function
+func (c *WebSocketClient) AntiCheat() *server.AntiCheat {
+	return c.hub.AntiCheat
+}
+
+func (c *WebSocketClient) Clock() server.Clock {
+	return c.hub.Clock
+}
+
+func (c *WebSocketClient) ChatHistory() *objects.ChatHistory {
+	return c.hub.ChatHistory
+}
+
+func (c *WebSocketClient) Round() *server.Round {
+	return c.hub.Round
+}
+
+func (c *WebSocketClient) Leaderboard() *server.Leaderboard {
+	return c.hub.Leaderboard
+}
+
+func (c *WebSocketClient) Events() *server.Events {
+	return c.hub.Events
+}
+
+func (c *WebSocketClient) EventBus() *server.EventBus {
+	return c.hub.EventBus
+}
+
+func (c *WebSocketClient) ServerInfo() server.ServerInfo {
+	return c.hub.Info()
+}
+
+func (c *WebSocketClient) GameFull() bool {
+	return c.hub.Full()
+}
+
+func (c *WebSocketClient) SporeValue() *server.SporeValue {
+	return c.hub.SporeValue
+}
+
+func (c *WebSocketClient) ReconnectSlots() *server.ReconnectSlots {
+	return c.hub.ReconnectSlots
+}
+
+func (c *WebSocketClient) ReclaimSlot(oldClientId uint64, secret string) (*objects.Player, bool) {
+	return c.hub.ReclaimSlot(oldClientId, secret)
+}
+
+func (c *WebSocketClient) Closing() bool {
+	return c.closing.Load()
+}
+
+func (c *WebSocketClient) StateName() string {
+	name, _ := c.stateName.Load().(string)
+	return name
+}
+
+func (c *WebSocketClient) StateEnteredAt() time.Time {
+	return time.Unix(0, c.stateEnteredAt.Load())
+}
+
+func (c *WebSocketClient) LastActivity() time.Time {
+	if nanos := c.lastActivity.Load(); nanos != 0 {
+		return time.Unix(0, nanos)
+	}
+	return time.Time{}
+}
+
+// Closing the socket makes the read pump fail, which closes the client like any dropped connection
+// The socket is given a moment first so the kick packet can make it out
+func (c *WebSocketClient) Kick(reason string) {
+	c.logger.Printf("Kicking client because: %s", reason)
+	c.SocketSend(packets.NewKick(reason))
+	time.AfterFunc(time.Second, func() { c.conn.Close() })
+}
+
+// Closing function, it changes the state so it only runs on the client's own goroutine (the read
+// pump, or a state handling our own packet), anywhere else should Kick instead
+// Only the first call does anything, the read pump always closes the client once it stops
 func (c *WebSocketClient) Close(reason string) {
+	if !c.closing.CompareAndSwap(false, true) {
+		return
+	}
 	c.logger.Printf("Closing client connection because: %s", reason)
 
-	c.Broadcast(packets.NewDisconnect(reason))
+	//Players in the game say where they were, so only the ones close by get told
+	if player, inGame := c.hub.SharedGameObjects.Players.Get(c.id); inGame {
+		c.Broadcast(packets.NewPlayerDisconnect(reason, c.id, player, c.hub.SharedGameObjects.WorldBound))
+	} else {
+		c.Broadcast(packets.NewDisconnect(reason))
+	}
 
 	c.SetState(nil)
 
 	c.hub.UnregisterChan <- c
+	close(c.done)
 	c.conn.Close()
-	if _, closed := <-c.sendChan; !closed {
-		close(c.sendChan)
-	}
 }
@@ -0,0 +1,268 @@
+package clients
+
+import (
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"server/internal/server"
+	"server/internal/servertest"
+	"server/pkg/packets"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// A client without a socket, enough for anything that only talks to the hub
+func newHubOnlyClient(hub *server.Hub) *WebSocketClient {
+	return &WebSocketClient{
+		id:     1,
+		hub:    hub,
+		logger: log.New(io.Discard, "", 0),
+	}
+}
+
+func TestBroadcastGivesUpOnAStalledHub(t *testing.T) {
+	config := server.DefaultConfig()
+	config.BroadcastTimeout = 20 * time.Millisecond
+	hub, _ := servertest.NewTestHub(config)
+	client := newHubOnlyClient(hub)
+
+	//Nothing reads the broadcast channel, like a hub that's stuck
+	returned := make(chan struct{})
+	go func() {
+		client.Broadcast(packets.NewChat("hello"))
+		close(returned)
+	}()
+
+	select {
+	case <-returned:
+	case <-time.After(time.Second):
+		t.Fatal("broadcast is still waiting on the hub")
+	}
+	if dropped := hub.Counters.BroadcastsDropped.Load(); dropped != 1 {
+		t.Errorf("counted %d dropped broadcasts, want 1", dropped)
+	}
+}
+
+func TestBroadcastReachesAWorkingHub(t *testing.T) {
+	config := server.DefaultConfig()
+	config.BroadcastTimeout = time.Second
+	hub, _ := servertest.NewTestHub(config)
+	client := newHubOnlyClient(hub)
+
+	go client.Broadcast(packets.NewChat("hello"))
+	select {
+	case packet := <-hub.BroadcastChan:
+		if packet.SenderId != client.id {
+			t.Errorf("broadcast came from %d, want %d", packet.SenderId, client.id)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the broadcast never got to the hub")
+	}
+	if dropped := hub.Counters.BroadcastsDropped.Load(); dropped != 0 {
+		t.Errorf("counted %d dropped broadcasts, want none", dropped)
+	}
+}
+
+func TestPanicHandlingOwnPacketIsRecovered(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	client := newHubOnlyClient(hub)
+	handled := 0
+	client.handler = func(senderId uint64, message packets.Msg) {
+		handled++
+		if handled == 1 {
+			panic("handler bug")
+		}
+	}
+
+	//The read pump carries on with the next packet after the first one blew up
+	client.processOwn(&packets.Packet{SenderId: client.id, Msg: packets.NewChat("boom")})
+	client.processOwn(&packets.Packet{SenderId: client.id, Msg: packets.NewChat("hello")})
+	if handled != 2 {
+		t.Errorf("handled %d packets, want 2", handled)
+	}
+	if got := hub.Counters.PanicsRecovered.Load(); got != 1 {
+		t.Errorf("recovered %d panics, want 1", got)
+	}
+}
+
+// Connects a real socket to a client made by NewWebSocketClient, neither pump is running yet
+// Any subprotocols given are asked for when connecting
+func dialClient(t *testing.T, hub *server.Hub, subprotocols ...string) (*WebSocketClient, *websocket.Conn) {
+	t.Helper()
+	connected := make(chan *WebSocketClient, 1)
+	httpServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		client, err := NewWebSocketClient(hub, writer, request)
+		if err != nil {
+			t.Errorf("upgrading the connection: %v", err)
+			return
+		}
+		connected <- client.(*WebSocketClient)
+	}))
+	t.Cleanup(httpServer.Close)
+
+	dialer := websocket.Dialer{Subprotocols: subprotocols}
+	conn, _, err := dialer.Dial("ws"+strings.TrimPrefix(httpServer.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("connecting: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return <-connected, conn
+}
+
+func TestClientsCantSendAsSomeoneElse(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	client, conn := dialClient(t, hub)
+	client.id = 5
+
+	incoming := make(chan *packets.Packet)
+	go client.readSocket(incoming)
+
+	//Claiming to be client 6 telling everyone it ate client 5
+	data, err := proto.Marshal(&packets.Packet{SenderId: 6, Msg: &packets.Packet_PlayerConsumed{
+		PlayerConsumed: &packets.PlayerConsumedMessage{PlayerId: 5},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := conn.WriteMessage(websocket.BinaryMessage, data); err != nil {
+		t.Fatalf("sending: %v", err)
+	}
+
+	select {
+	case packet := <-incoming:
+		if packet.SenderId != client.id {
+			t.Errorf("packet came in from %d, want the client's own id %d", packet.SenderId, client.id)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the packet never came in")
+	}
+}
+
+func TestWritesOverTheBandwidthCapWaitForTheNextSecond(t *testing.T) {
+	config := server.DefaultConfig()
+	config.MaxClientBytesPerSec = 10
+	hub, _ := servertest.NewTestHub(config)
+	client, conn := dialClient(t, hub)
+
+	client.SocketSendRaw([]byte("first packet"))
+	client.SocketSendRaw([]byte("second packet"))
+	start := time.Now()
+	go client.WritePump()
+	t.Cleanup(func() { close(client.done) })
+
+	for _, want := range []string{"first packet", "second packet"} {
+		conn.SetReadDeadline(time.Now().Add(3 * time.Second))
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			t.Fatalf("reading %q: %v", want, err)
+		}
+		if got := strings.TrimSuffix(string(data), "\n"); got != want {
+			t.Fatalf("read %q, want %q", got, want)
+		}
+	}
+
+	//The first packet alone goes over the cap, so the second one has to wait out the second
+	if waited := time.Since(start); waited < 900*time.Millisecond {
+		t.Errorf("second packet came after %v, the cap didn't hold it back", waited)
+	}
+	if sent := client.BytesSent(); sent != uint64(len("first packet")+len("second packet")) {
+		t.Errorf("counted %d bytes sent, want %d", sent, len("first packet")+len("second packet"))
+	}
+}
+
+func TestRawSendsAreQueuedAsIsAndDroppedWhenFull(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	client := newHubOnlyClient(hub)
+	client.sendChan = make(chan []byte, 2)
+
+	data := []byte{1, 2, 3}
+	for i := 0; i < 3; i++ {
+		client.SocketSendRaw(data)
+	}
+
+	if queued := <-client.sendChan; &queued[0] != &data[0] {
+		t.Error("the raw bytes were copied instead of queued as they are")
+	}
+	if len(client.sendChan) != 1 {
+		t.Errorf("%d packets left in the send channel, want 1", len(client.sendChan))
+	}
+	if dropped := client.DroppedPackets(); dropped != 1 {
+		t.Errorf("counted %d dropped packets, want 1", dropped)
+	}
+}
+
+func TestOnlyClientsThatAckGetPacketsResent(t *testing.T) {
+	for _, supportsAcks := range []bool{false, true} {
+		hub, _ := servertest.NewTestHub(server.DefaultConfig())
+		client := newHubOnlyClient(hub)
+		client.sendChan = make(chan []byte, 8)
+		client.reliable = newReliableTracker()
+		client.codec = protoCodec
+		client.SetSupportsAcks(supportsAcks)
+
+		client.SocketSendReliable(packets.NewOkResponse())
+		resend, _ := client.reliable.due(0, 5)
+		if (len(resend) == 1) != supportsAcks {
+			t.Errorf("supports acks %v: %d packets waiting on an ack", supportsAcks, len(resend))
+		}
+
+		var packet packets.Packet
+		if err := proto.Unmarshal(<-client.sendChan, &packet); err != nil {
+			t.Fatal(err)
+		}
+		if packet.Seq != 1 {
+			t.Errorf("supports acks %v: sent with seq %d, want 1", supportsAcks, packet.Seq)
+		}
+	}
+}
+
+func TestJsonClientsGetTextPackets(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	client, conn := dialClient(t, hub, "made-up", SubprotocolJson)
+	if conn.Subprotocol() != SubprotocolJson {
+		t.Fatalf("the server picked %q, want %q", conn.Subprotocol(), SubprotocolJson)
+	}
+
+	client.SocketSend(packets.NewChatError("too fast"))
+	go client.WritePump()
+	t.Cleanup(func() { close(client.done) })
+
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	messageType, data, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatalf("reading: %v", err)
+	}
+	if messageType != websocket.TextMessage {
+		t.Errorf("got message type %d, want text", messageType)
+	}
+	packet := &packets.Packet{}
+	if err := protojson.Unmarshal(data, packet); err != nil {
+		t.Fatalf("%q isn't a JSON packet: %v", data, err)
+	}
+	if packet.GetChatError().GetReason() != "too fast" {
+		t.Errorf("read %v, want the chat error", packet)
+	}
+}
+
+func TestClientsAskingOnlyForUnknownSubprotocolsAreTurnedAway(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	httpServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		NewWebSocketClient(hub, writer, request)
+	}))
+	t.Cleanup(httpServer.Close)
+
+	dialer := websocket.Dialer{Subprotocols: []string{"made-up"}}
+	_, response, err := dialer.Dial("ws"+strings.TrimPrefix(httpServer.URL, "http"), nil)
+	if err == nil {
+		t.Fatal("connected without a subprotocol the server knows")
+	}
+	if response == nil || response.StatusCode != http.StatusBadRequest {
+		t.Errorf("got %v, want a bad request", response)
+	}
+}
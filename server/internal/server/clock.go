@@ -0,0 +1,21 @@
+package server
+
+import "time"
+
+// Where the game logic gets the current time from, so cooldowns and TTLs can be
+// checked against a fake time instead of waiting for the real one
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time //fires once the clock has moved on by d
+}
+
+// The clock the server runs with, just the system time
+type RealClock struct{}
+
+func (RealClock) Now() time.Time {
+	return time.Now()
+}
+
+func (RealClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
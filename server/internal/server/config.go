@@ -0,0 +1,461 @@
+package server
+
+import "time"
+
+// Ways the radius of new spores can be picked
+const (
+	DistributionNormal  = "normal"  //SporeRadiusMean +- SporeRadiusStdDev, never below SporeRadiusMin
+	DistributionUniform = "uniform" //anywhere between SporeRadiusMin and SporeRadiusMax
+)
+
+// Ways spores can be spread around the map
+const (
+	PlacementUniform = "uniform" //anywhere on the map
+	PlacementEdge    = "edge"    //denser towards the edges, sparse in the center
+	PlacementRing    = "ring"    //only in a ring, at least SporeRingInner*bound away from the center
+)
+
+// Ways players can be pulled along on top of their own movement
+const (
+	DriftNone   = "none"   //no drift
+	DriftCenter = "center" //towards the center of the map, like a black hole
+	DriftPoint  = "point"  //towards a point going around the center DriftPointRadius away
+	DriftWind   = "wind"   //everyone the same way, at DriftWindAngle
+)
+
+// Ways the value of spores can follow the player count
+const (
+	SporeValueNone    = "none"    //spores are always worth their size
+	SporeValueLinear  = "linear"  //SporeValueSlope more (or less) per player under (or over) SporeValuePlayers
+	SporeValueInverse = "inverse" //SporeValuePlayers divided by the player count, half as much with twice the players
+)
+
+// Which players the live leaderboard counts
+const (
+	LeaderboardGlobal = "global" //everyone in the game
+	LeaderboardRegion = "region" //only players within LeaderboardRegionRadius of the player receiving it
+)
+
+// All the tunable settings for the server live here so they can be changed
+// in one place (or through command line flags in main.go)
+type Config struct {
+	//Soft cap on how many bytes per second can be written to a single client
+	//once a client goes over it, its write pump is throttled until the next second
+	//0 means no cap
+	MaxClientBytesPerSec uint64
+
+	//Biggest a single spore batch frame can get once encoded, bigger batches are split up so
+	//slow clients and clients with a read limit don't choke on them (0 means no limit)
+	MaxSporeBatchBytes int
+
+	//Extra distance allowed when checking if a player is close enough to consume something
+	//to make up for the delay between the client and the server
+	ConsumeBuffer float64
+
+	//On top of ConsumeBuffer, add the distance the player could travel during half its
+	//round trip time multiplied by this (0 turns it off, 1 is the full one way latency)
+	//Off by default, since the round trip time is up to the client and a slow one gets more room
+	ConsumeBufferRttScale float64
+
+	//The most round trip time ConsumeBufferRttScale will make room for, anything slower than this
+	//gets the same buffer as a client this slow
+	ConsumeBufferMaxRtt time.Duration
+
+	//How much of the target has to be inside the consumer before it can be eaten, as a fraction
+	//of the target's diameter: 0 is as soon as they touch, 0.5 is once the target's center is
+	//inside the consumer and 1 is once it's all the way in. The consume buffer still goes on top
+	ConsumeOverlap float64
+
+	//Only players within this distance of the consumer see consumption events (the players
+	//involved always do), 0 shows them to everyone
+	ConsumeEventRadius float64
+
+	//Only players within this distance of a player that leaves get told about it, everyone
+	//else just gets the player removed quietly. 0 tells everyone
+	DisconnectEventRadius float64
+
+	//Spores dropped by players can't be eaten by anyone until they're this old
+	MinSporeAge time.Duration
+
+	//Fraction (0 to 1) of a consumed player's mass that scatters around as spores
+	//instead of going to the player that ate them
+	DeathScatterFraction float64
+
+	//A player needs more than this many times another player's mass to eat it
+	ConsumeMassRatio float64
+
+	//Players need more than this many times a spore's mass to eat it, 0 lets anyone eat any spore
+	SporeConsumeRatio float64
+
+	//How long a player ignores another PlayerConsumed for a victim it already ate, so a duplicated
+	//message can't get counted twice
+	VictimCooldown time.Duration
+
+	//How many failed validations (eating things too far away etc.) a client can have before
+	//the anti-cheat acts on it, 0 means never
+	SuspicionThreshold int
+
+	//If true clients that reach the suspicion threshold are kicked, otherwise they're only flagged in the log
+	SuspicionKick bool
+
+	//How the radius of newly placed spores is picked, DistributionNormal or DistributionUniform
+	SporeRadiusDistribution string
+	SporeRadiusMean         float64
+	SporeRadiusStdDev       float64
+	SporeRadiusMin          float64
+	SporeRadiusMax          float64 //only used by the uniform distribution
+
+	//Where new spores are placed, PlacementUniform, PlacementEdge or PlacementRing
+	SporePlacement string
+	SporeRingInner float64 //fraction of the world bound the ring starts at
+
+	//Seed for where the starting spores go (and how big they are), the same seed gives the same map
+	//0 picks one at random, either way it's logged so the map can be made again
+	MapSeed int64
+
+	//Ejecting shoots EjectMass of the player's mass out as a spore going EjectSpeed
+	EjectMass  float64
+	EjectSpeed float64
+
+	//Moving spores lose SporeFriction (0 to 1) of their speed every second, and stop
+	//once they're slower than SporeStopSpeed
+	SporeFriction  float64
+	SporeStopSpeed float64
+
+	//Spores players drop as they move disappear after this long if nobody eats them, 0 keeps them forever
+	//expired ones are looked for every SporeReapInterval
+	SporeTrailTTL     time.Duration
+	SporeReapInterval time.Duration
+
+	//Spores can be worth more on an empty server and less on a crowded one, how is picked by
+	//SporeValueScaling (SporeValueNone, SporeValueLinear or SporeValueInverse). They're worth
+	//their size with SporeValuePlayers players, never less than SporeValueMin or more than
+	//SporeValueMax times that, and the value is worked out again every SporeValueInterval
+	SporeValueScaling  string
+	SporeValuePlayers  int
+	SporeValueSlope    float64
+	SporeValueMin      float64
+	SporeValueMax      float64
+	SporeValueInterval time.Duration
+
+	//Half the width of the square world, players can't move past it
+	WorldBound float64
+
+	//New players don't spawn within this distance of the edge of anyone big enough to eat them
+	SpawnDangerRadius float64
+
+	//Spawns (of players or spores) that need more than this many tries to find a free spot get
+	//logged as a warning, 0 turns the warning off. The attempts are on /metrics either way
+	SlowSpawnAttempts int
+
+	//Shrinking arena: every ShrinkInterval the world bound goes down by ShrinkStep
+	//until it reaches ShrinkMinBound
+	ShrinkEnabled  bool
+	ShrinkInterval time.Duration
+	ShrinkStep     float64
+	ShrinkMinBound float64
+
+	//Players caught outside the bound lose this fraction of their mass every second
+	//and get pushed back in at OutOfBoundsPushSpeed
+	OutOfBoundsMassLoss  float64
+	OutOfBoundsPushSpeed float64
+
+	//Players get moved along at DriftStrength units per second on top of their own movement,
+	//which way depends on DriftMode. The point for DriftPoint goes around the center at
+	//DriftPointSpeed radians per second, and DriftWindAngle is in radians
+	DriftMode        string
+	DriftStrength    float64
+	DriftPointRadius float64
+	DriftPointSpeed  float64
+	DriftWindAngle   float64
+
+	//Players that overlap but can't eat each other get pushed apart, CollisionPushStrength is the
+	//fraction of the overlap closed every second (each player moves its own half)
+	SoftCollision         bool
+	CollisionPushStrength float64
+
+	//Players collide (eat and get eaten, push each other) with a circle this many times the radius
+	//they're drawn with, so the blob can look bigger or smaller than it really is. 1 is the same size
+	CollisionRadiusScale float64
+
+	//If true players move by the time that really passed between ticks instead of a fixed 50ms
+	//(up to MaxMoveDelta seconds per tick), otherwise the fixed delta is used
+	RealDeltaMovement bool
+	MaxMoveDelta      float64
+
+	//Max radians per second a player can turn, 0 means players turn instantly
+	//With TurnRateMassScaling on, players bigger than the starting size turn slower the bigger they get
+	MaxTurnRate         float64
+	TurnRateMassScaling bool
+
+	//The live leaderboard shows the top LeaderboardSize players and is sent every LeaderboardInterval
+	LeaderboardSize         int
+	LeaderboardInterval     time.Duration
+	LeaderboardScope        string
+	LeaderboardRegionRadius float64
+
+	//With the global scope only leaderboard changes get sent, except every LeaderboardFullEvery
+	//sends when it's the whole thing again (1 or less always sends the whole thing)
+	LeaderboardFullEvery int
+
+	//Players under LeaderboardMinMass don't show up on the leaderboards, and players growing faster
+	//than LeaderboardMaxGrowth mass per second are reported to the anti cheat and left off them for
+	//the rest of their life. Eating a big player is a big jump, so leave room for that. 0 turns either off
+	LeaderboardMinMass   float64
+	LeaderboardMaxGrowth float64
+
+	//The minimap is sent every MinimapInterval with the biggest MinimapPlayers players, and
+	//the map split into a MinimapGridSize x MinimapGridSize grid
+	MinimapInterval time.Duration
+	MinimapPlayers  int
+	MinimapGridSize int
+
+	//The round waits for RoundMinPlayers players to join, then counts down for CountdownDuration
+	//before the spores are placed and players can move. 0 players starts right away with no countdown
+	RoundMinPlayers   int
+	CountdownDuration time.Duration
+
+	//How long the player of a dropped client is kept around so the client can reconnect to it, 0 turns it off
+	ReconnectGrace time.Duration
+
+	//How many of the latest chat messages players get when they enter the game
+	ChatHistorySize int
+
+	//Longest chat message allowed, counted in characters (not bytes), 0 for no limit
+	ChatMaxLength int
+
+	//Spectators get updates about players within this distance of the player they follow
+	SpectateViewRadius float64
+
+	//Players asking for a resync get every player and spore within this distance of them
+	ResyncRadius float64
+
+	//How often a player's best score is saved to the DB while they're playing
+	BestScoreSyncInterval time.Duration
+
+	//How often players get sent their connection stats (ping, tick rate, dropped packets), 0 turns it off
+	ConnectionStatsInterval time.Duration
+
+	//How long a client waits to hand a broadcast to the hub before dropping it, so a stalled hub
+	//can't wedge the client's goroutines, 0 waits forever
+	BroadcastTimeout time.Duration
+
+	//Players that don't send any input for this long get kicked back to the menu, 0 (the default)
+	//turns it off
+	IdleKickTimeout time.Duration
+
+	//Connections that sit in the menu without sending anything for this long get dropped so they
+	//don't hold on to a socket forever, 0 (the default) turns it off
+	PreGameTimeout time.Duration
+
+	//Players that don't steer or eat anything for AfkThreshold are marked AFK and their updates only
+	//go out every AfkUpdateInterval until they play again, 0 (the default) turns it off
+	AfkThreshold      time.Duration
+	AfkUpdateInterval time.Duration
+
+	//Players that only want their own updates when they're off get one once the server's position is
+	//more than this far from the last position their client reported
+	SelfEchoThreshold float64
+
+	//Player updates forwarded to other clients that are further than this from the server's own
+	//position (or size) for that player get replaced with the server's version
+	PeerPositionTolerance float64
+
+	//Consumptions that gain at least this much mass are tagged as big ones for the client's feedback
+	BigSporeMass  float64
+	BigPlayerMass float64
+
+	//Important packets that aren't acked within ReliableRetryInterval are sent again,
+	//up to ReliableMaxAttempts sends in total
+	ReliableRetryInterval time.Duration
+	ReliableMaxAttempts   int
+
+	//Oldest client version allowed to play (like "1.2.0"), empty allows any client
+	MinClientVersion string
+
+	//What clients and server browsers are told about the server. MaxPlayers is enforced when
+	//players try to get in the game (0 for no limit), GameMode is only a label
+	ServerName string
+	MaxPlayers int
+	GameMode   string
+
+	//Where the logs go: stdout if LogFile is empty, otherwise the file (rotated once it's
+	//LogMaxSize bytes, keeping LogMaxBackups old files), and stdout too if LogToStdout is set
+	LogFile       string
+	LogMaxSize    int64
+	LogMaxBackups int
+	LogToStdout   bool
+
+	//JSON file with the Balance settings, read at startup and again on SIGHUP or /admin/balance/reload
+	//Empty means the balance can't be reloaded
+	BalanceFile string
+
+	//Token needed for the /admin routes, empty turns them off
+	AdminToken string
+
+	//How often the leaderboard is archived and reset automatically, 0 means only through the admin route
+	SeasonInterval time.Duration
+
+	//Whether the server refuses to start without a working database. If it's off the game still runs,
+	//but nobody can log in or register and nothing gets saved until the database works again
+	RequireDb bool
+
+	//File database writes (best scores, match history) are queued in before they're applied, so they
+	//survive the database being slow or down and the server restarting. Empty writes straight to the database
+	WriteQueueFile string
+
+	//Map wide events (double mass, spore rain...) that run on a schedule, main fills these in from
+	//the events file if there is one
+	Events []ScheduledEvent
+
+	//Limits on how many messages of each type (like "Chat" or "Eject") a client can send, on top
+	//of any the states have themselves. Messages over the limit are dropped before the state sees them
+	MessageRateLimits map[string]MessageRateLimit
+}
+
+// Constructor for the config with the default values the game was tuned with
+func DefaultConfig() *Config {
+	return &Config{
+		MaxClientBytesPerSec:  0,
+		MaxSporeBatchBytes:    16 * 1024,
+		ConsumeBuffer:         10,
+		ConsumeBufferRttScale: 0,
+		ConsumeBufferMaxRtt:   300 * time.Millisecond,
+		ConsumeOverlap:        0,
+		ConsumeEventRadius:    0,
+		MinSporeAge:           0,
+		DisconnectEventRadius: 0,
+		DeathScatterFraction:  0.25,
+		ConsumeMassRatio:      1.5,
+		SporeConsumeRatio:     0,
+		VictimCooldown:        2 * time.Second,
+		SuspicionThreshold:    20,
+		SuspicionKick:         false,
+
+		SporeRadiusDistribution: DistributionNormal,
+		SporeRadiusMean:         10,
+		SporeRadiusStdDev:       3,
+		SporeRadiusMin:          5,
+		SporeRadiusMax:          15,
+		SporePlacement:          PlacementUniform,
+		SporeRingInner:          0.5,
+		MapSeed:                 0,
+
+		EjectMass:  300,
+		EjectSpeed: 800,
+
+		SporeFriction:  0.9,
+		SporeStopSpeed: 1,
+
+		SporeTrailTTL:     0,
+		SporeReapInterval: time.Second,
+
+		SporeValueScaling:  SporeValueNone,
+		SporeValuePlayers:  10,
+		SporeValueSlope:    0.05,
+		SporeValueMin:      0.5,
+		SporeValueMax:      2,
+		SporeValueInterval: 5 * time.Second,
+
+		WorldBound:           3000,
+		ShrinkEnabled:        false,
+		ShrinkInterval:       10 * time.Second,
+		ShrinkStep:           100,
+		ShrinkMinBound:       500,
+		OutOfBoundsMassLoss:  0.1,
+		OutOfBoundsPushSpeed: 200,
+
+		SpawnDangerRadius: 300,
+		SlowSpawnAttempts: 50,
+
+		DriftMode:        DriftNone,
+		DriftStrength:    50,
+		DriftPointRadius: 1000,
+		DriftPointSpeed:  0.1,
+		DriftWindAngle:   0,
+
+		SoftCollision:         false,
+		CollisionPushStrength: 4,
+
+		CollisionRadiusScale: 1,
+
+		RealDeltaMovement: false,
+		MaxMoveDelta:      0.2,
+
+		MaxTurnRate:         0,
+		TurnRateMassScaling: false,
+
+		LeaderboardSize:         10,
+		LeaderboardInterval:     time.Second,
+		LeaderboardScope:        LeaderboardGlobal,
+		LeaderboardRegionRadius: 2000,
+
+		LeaderboardFullEvery: 10,
+
+		LeaderboardMinMass:   0,
+		LeaderboardMaxGrowth: 0,
+
+		MinimapInterval: time.Second,
+		MinimapPlayers:  5,
+		MinimapGridSize: 16,
+
+		RoundMinPlayers:   0,
+		CountdownDuration: 5 * time.Second,
+
+		ReconnectGrace: 0,
+
+		ChatHistorySize: 20,
+
+		ChatMaxLength: 200,
+
+		SpectateViewRadius: 2000,
+
+		ResyncRadius: 2000,
+
+		BestScoreSyncInterval: 2 * time.Second,
+
+		ConnectionStatsInterval: 5 * time.Second,
+
+		BroadcastTimeout: 2 * time.Second,
+
+		IdleKickTimeout: 0,
+
+		PreGameTimeout: 0,
+
+		AfkThreshold:      0,
+		AfkUpdateInterval: time.Second,
+
+		SelfEchoThreshold: 20,
+
+		PeerPositionTolerance: 50,
+
+		BigSporeMass:  1000,
+		BigPlayerMass: 5000,
+
+		ReliableRetryInterval: time.Second,
+		ReliableMaxAttempts:   5,
+
+		MinClientVersion: "",
+
+		ServerName: "nodeHunger",
+		MaxPlayers: 0,
+		GameMode:   "ffa",
+
+		LogFile:       "",
+		LogMaxSize:    10 * 1024 * 1024,
+		LogMaxBackups: 3,
+		LogToStdout:   true,
+
+		BalanceFile: "",
+
+		AdminToken:     "",
+		SeasonInterval: 0,
+
+		RequireDb:      true,
+		WriteQueueFile: "",
+
+		Events: nil,
+
+		MessageRateLimits: nil,
+	}
+}
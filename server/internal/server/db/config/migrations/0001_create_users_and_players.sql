@@ -28,4 +28,4 @@ CREATE TABLE IF NOT EXISTS players (
     best_score INTEGER NOT NULL DEFAULT 0,
     color INTEGER NOT NULL,
     FOREIGN KEY (user_id) REFERENCES users(id)
-);
\ No newline at end of file
+);
@@ -0,0 +1,13 @@
+/*
+Every life a player plays gets a row in match_history once it ends
+final_score is the mass the player had when the match ended
+mass_eaten is the total mass the player consumed during that match
+*/
+CREATE TABLE IF NOT EXISTS match_history (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    player_id INTEGER NOT NULL,
+    final_score INTEGER NOT NULL,
+    mass_eaten INTEGER NOT NULL DEFAULT 0,
+    ended_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (player_id) REFERENCES players(id)
+);
@@ -0,0 +1,13 @@
+/*
+When a season ends, every player's best score is copied here with the season's label
+before the live best_score column gets reset
+*/
+CREATE TABLE IF NOT EXISTS leaderboard_archive (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    season TEXT NOT NULL,
+    player_id INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    best_score INTEGER NOT NULL,
+    archived_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (player_id) REFERENCES players(id)
+);
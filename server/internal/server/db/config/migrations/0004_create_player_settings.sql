@@ -0,0 +1,11 @@
+/*
+Preferences a player picked in the game, one row per player
+Players without a row just get the defaults
+*/
+CREATE TABLE IF NOT EXISTS player_settings (
+    player_id INTEGER PRIMARY KEY,
+    chat_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    minimap_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    auto_collect BOOLEAN NOT NULL DEFAULT FALSE,
+    FOREIGN KEY (player_id) REFERENCES players(id)
+);
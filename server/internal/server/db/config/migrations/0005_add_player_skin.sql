@@ -0,0 +1,4 @@
+/*
+Players can pick a skin on top of their color, 0 means no skin
+*/
+ALTER TABLE players ADD COLUMN skin_id INTEGER NOT NULL DEFAULT 0;
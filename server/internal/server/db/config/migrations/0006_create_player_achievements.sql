@@ -0,0 +1,11 @@
+/*
+Achievements a player has unlocked, one row per player and achievement
+achievement_id is the id the server gives the achievement, like "glutton"
+*/
+CREATE TABLE IF NOT EXISTS player_achievements (
+    player_id INTEGER NOT NULL,
+    achievement_id TEXT NOT NULL,
+    unlocked_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (player_id, achievement_id),
+    FOREIGN KEY (player_id) REFERENCES players(id)
+);
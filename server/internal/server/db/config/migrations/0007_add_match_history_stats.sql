@@ -0,0 +1,8 @@
+/*
+More about each match for the post-match summaries: how many spores and players were eaten,
+the most mass the player had and how far it went
+*/
+ALTER TABLE match_history ADD COLUMN spores_eaten INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE match_history ADD COLUMN players_eaten INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE match_history ADD COLUMN max_mass INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE match_history ADD COLUMN distance INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,9 @@
+/*
+How far the write queue got, a single row with the sequence number of the last queued write that
+made it into the database. It's updated in the same transaction as the write itself, so a write
+replayed after a crash can tell it was already applied
+*/
+CREATE TABLE IF NOT EXISTS write_queue (
+    id INTEGER PRIMARY KEY CHECK (id = 1),
+    seq INTEGER NOT NULL
+);
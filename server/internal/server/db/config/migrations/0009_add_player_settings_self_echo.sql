@@ -0,0 +1,4 @@
+/*
+Players keep their self echo setting (SelfEcho in the packets) between sessions, 0 is always
+*/
+ALTER TABLE player_settings ADD COLUMN self_echo INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,4 @@
+/*
+Players keep their haptics setting between sessions, it's on until they turn it off
+*/
+ALTER TABLE player_settings ADD COLUMN haptics_enabled BOOLEAN NOT NULL DEFAULT TRUE;
@@ -48,7 +48,7 @@ OFFSET ?;
 /*Query to fetch the username and his score, present them in order (top 10)*/
 -- name: GetPlayerByName :one
 SELECT * FROM players
-WHERE name LIKE ?
+WHERE name = ? COLLATE NOCASE
 LIMIT 1;
 
 -- name: GetPlayerRank :one
@@ -56,4 +56,91 @@ SELECT COUNT(*) + 1 AS "rank" FROM players
 WHERE best_score >= (
     SELECT best_score FROM players p2
     WHERE p2.id = ?
-);
\ No newline at end of file
+);
+
+/*Query to store a finished match for a player*/
+-- name: CreateMatchHistory :exec
+INSERT INTO match_history (
+    player_id, final_score, mass_eaten, spores_eaten, players_eaten, max_mass, distance
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?
+);
+
+/*Query to get the lifetime stats of a player by name, any case but otherwise exact*/
+-- name: GetPlayerStats :one
+SELECT p.best_score,
+    COUNT(m.id) AS matches_played,
+    CAST(COALESCE(SUM(m.mass_eaten), 0) AS INTEGER) AS total_mass_eaten
+FROM players p
+LEFT JOIN match_history m ON m.player_id = p.id
+WHERE p.name = ? COLLATE NOCASE
+GROUP BY p.id
+LIMIT 1;
+
+/*Query to copy every player's best score into the archive under a season label*/
+-- name: ArchiveBestScores :exec
+INSERT INTO leaderboard_archive (
+    season, player_id, name, best_score
+)
+SELECT CAST(sqlc.arg(season) AS TEXT), id, name, best_score
+FROM players
+WHERE best_score > 0;
+
+/*Query to zero out every player's best score for a new season*/
+-- name: ResetBestScores :exec
+UPDATE players
+SET best_score = 0;
+
+/*Query to fetch the preferences a player saved*/
+-- name: GetPlayerSettings :one
+SELECT * FROM player_settings
+WHERE player_id = ? LIMIT 1;
+
+/*Query to save a player's preferences, replacing the ones saved before*/
+-- name: UpsertPlayerSettings :exec
+INSERT INTO player_settings (
+    player_id, chat_enabled, minimap_enabled, auto_collect, self_echo, haptics_enabled
+) VALUES (
+    ?, ?, ?, ?, ?, ?
+)
+ON CONFLICT (player_id) DO UPDATE SET
+    chat_enabled = excluded.chat_enabled,
+    minimap_enabled = excluded.minimap_enabled,
+    auto_collect = excluded.auto_collect,
+    self_echo = excluded.self_echo,
+    haptics_enabled = excluded.haptics_enabled;
+
+/*Query to change the color and skin of a player's blob*/
+-- name: UpdatePlayerLook :exec
+UPDATE players
+SET color = ?, skin_id = ?
+WHERE id = ?;
+
+/*Query to save an achievement a player unlocked, unlocking it again does nothing*/
+-- name: CreatePlayerAchievement :exec
+INSERT INTO player_achievements (
+    player_id, achievement_id
+) VALUES (
+    ?, ?
+)
+ON CONFLICT (player_id, achievement_id) DO NOTHING;
+
+/*Query to fetch the ids of every achievement a player unlocked*/
+-- name: GetPlayerAchievements :many
+SELECT achievement_id FROM player_achievements
+WHERE player_id = ?;
+
+/*Query to get the sequence number of the last queued write that was applied*/
+-- name: GetWriteQueueSeq :one
+SELECT seq FROM write_queue
+WHERE id = 1;
+
+/*Query to record the sequence number of the last queued write that was applied*/
+-- name: SetWriteQueueSeq :exec
+INSERT INTO write_queue (
+    id, seq
+) VALUES (
+    1, ?
+)
+ON CONFLICT (id) DO UPDATE SET
+    seq = excluded.seq;
@@ -0,0 +1,125 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// All the migrations, named like 0001_description.sql and applied in order of their number
+//
+//go:embed config/migrations/*.sql
+var migrationFiles embed.FS
+
+// A single migration file
+type migration struct {
+	version int64
+	name    string
+	sql     string
+}
+
+// Runs every migration that hasn't been applied to the database yet
+// The applied versions are stored in the schema_version table, so running this again is a no-op
+// Each migration runs in its own transaction, so a failing one doesn't leave the schema half changed
+func Migrate(ctx context.Context, dbPool *sql.DB) error {
+	return migrate(ctx, dbPool, migrationFiles)
+}
+
+func migrate(ctx context.Context, dbPool *sql.DB, files fs.FS) error {
+	_, err := dbPool.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_version (
+    version INTEGER PRIMARY KEY,
+    applied_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+)`)
+	if err != nil {
+		return fmt.Errorf("creating schema_version table: %w", err)
+	}
+
+	var currentVersion int64
+	err = dbPool.QueryRowContext(ctx, "SELECT COALESCE(MAX(version), 0) FROM schema_version").Scan(&currentVersion)
+	if err != nil {
+		return fmt.Errorf("getting the current schema version: %w", err)
+	}
+
+	migrations, err := loadMigrations(files)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range migrations {
+		if m.version <= currentVersion {
+			continue
+		}
+
+		log.Printf("Applying migration %s", m.name)
+		if err := applyMigration(ctx, dbPool, m); err != nil {
+			return fmt.Errorf("applying migration %s: %w", m.name, err)
+		}
+	}
+
+	return nil
+}
+
+// Reads all the .sql files and sorts them by their version number
+func loadMigrations(files fs.FS) ([]migration, error) {
+	paths, err := fs.Glob(files, "config/migrations/*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	migrations := make([]migration, 0, len(paths))
+	for _, path := range paths {
+		name := path[strings.LastIndex(path, "/")+1:]
+		versionStr, _, found := strings.Cut(name, "_")
+		if !found {
+			return nil, fmt.Errorf("migration %s is not named like 0001_description.sql", name)
+		}
+
+		version, err := strconv.ParseInt(versionStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migration %s has an invalid version: %w", name, err)
+		}
+
+		contents, err := fs.ReadFile(files, path)
+		if err != nil {
+			return nil, err
+		}
+
+		migrations = append(migrations, migration{version: version, name: name, sql: string(contents)})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].version < migrations[j].version
+	})
+
+	for i := 1; i < len(migrations); i++ {
+		if migrations[i].version == migrations[i-1].version {
+			return nil, fmt.Errorf("migrations %s and %s have the same version", migrations[i-1].name, migrations[i].name)
+		}
+	}
+
+	return migrations, nil
+}
+
+func applyMigration(ctx context.Context, dbPool *sql.DB, m migration) error {
+	tx, err := dbPool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //does nothing once the transaction is committed
+
+	if _, err := tx.ExecContext(ctx, m.sql); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, "INSERT INTO schema_version (version) VALUES (?)", m.version); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,76 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	_ "modernc.org/sqlite"
+)
+
+// Everything that makes up the schema, plus the migrations recorded as applied
+func schemaSnapshot(t *testing.T, dbPool *sql.DB) []string {
+	t.Helper()
+	rows, err := dbPool.Query("SELECT type, name, COALESCE(sql, '') FROM sqlite_master ORDER BY type, name")
+	if err != nil {
+		t.Fatalf("reading the schema: %v", err)
+	}
+	defer rows.Close()
+
+	var snapshot []string
+	for rows.Next() {
+		var kind, name, definition string
+		if err := rows.Scan(&kind, &name, &definition); err != nil {
+			t.Fatalf("reading the schema: %v", err)
+		}
+		snapshot = append(snapshot, fmt.Sprintf("%s %s: %s", kind, name, definition))
+	}
+
+	versions, err := dbPool.Query("SELECT version FROM schema_version ORDER BY version")
+	if err != nil {
+		t.Fatalf("reading the applied migrations: %v", err)
+	}
+	defer versions.Close()
+	for versions.Next() {
+		var version int64
+		if err := versions.Scan(&version); err != nil {
+			t.Fatalf("reading the applied migrations: %v", err)
+		}
+		snapshot = append(snapshot, fmt.Sprintf("version %d", version))
+	}
+	return snapshot
+}
+
+func TestMigratingTwiceChangesNothing(t *testing.T) {
+	ctx := context.Background()
+	dbPool, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatalf("opening the database: %v", err)
+	}
+	defer dbPool.Close()
+	//Every connection to :memory: gets its own empty database, so there can only be one
+	dbPool.SetMaxOpenConns(1)
+
+	if err := Migrate(ctx, dbPool); err != nil {
+		t.Fatalf("first migration: %v", err)
+	}
+	first := schemaSnapshot(t, dbPool)
+	if len(first) == 0 {
+		t.Fatal("the first migration didn't create anything")
+	}
+
+	if err := Migrate(ctx, dbPool); err != nil {
+		t.Fatalf("second migration: %v", err)
+	}
+	second := schemaSnapshot(t, dbPool)
+
+	if len(first) != len(second) {
+		t.Fatalf("schema went from %d entries to %d", len(first), len(second))
+	}
+	for i := range first {
+		if first[i] != second[i] {
+			t.Errorf("schema changed:\n%s\nbecame\n%s", first[i], second[i])
+		}
+	}
+}
@@ -4,12 +4,53 @@
 
 package db
 
+import (
+	"time"
+)
+
+type LeaderboardArchive struct {
+	ID         int64
+	Season     string
+	PlayerID   int64
+	Name       string
+	BestScore  int64
+	ArchivedAt time.Time
+}
+
+type MatchHistory struct {
+	ID           int64
+	PlayerID     int64
+	FinalScore   int64
+	MassEaten    int64
+	EndedAt      time.Time
+	SporesEaten  int64
+	PlayersEaten int64
+	MaxMass      int64
+	Distance     int64
+}
+
 type Player struct {
 	ID        int64
 	UserID    int64
 	Name      string
 	BestScore int64
 	Color     int64
+	SkinID    int64
+}
+
+type PlayerAchievement struct {
+	PlayerID      int64
+	AchievementID string
+	UnlockedAt    time.Time
+}
+
+type PlayerSetting struct {
+	PlayerID       int64
+	ChatEnabled    bool
+	MinimapEnabled bool
+	AutoCollect    bool
+	SelfEcho       int64
+	HapticsEnabled bool
 }
 
 type User struct {
@@ -17,3 +58,8 @@ type User struct {
 	Username     string
 	PasswordHash string
 }
+
+type WriteQueue struct {
+	ID  int64
+	Seq int64
+}
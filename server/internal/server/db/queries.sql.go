@@ -9,13 +9,60 @@ import (
 	"context"
 )
 
+const archiveBestScores = `-- name: ArchiveBestScores :exec
+INSERT INTO leaderboard_archive (
+    season, player_id, name, best_score
+)
+SELECT CAST(? AS TEXT), id, name, best_score
+FROM players
+WHERE best_score > 0
+`
+
+// Query to copy every player's best score into the archive under a season label
+func (q *Queries) ArchiveBestScores(ctx context.Context, season string) error {
+	_, err := q.db.ExecContext(ctx, archiveBestScores, season)
+	return err
+}
+
+const createMatchHistory = `-- name: CreateMatchHistory :exec
+INSERT INTO match_history (
+    player_id, final_score, mass_eaten, spores_eaten, players_eaten, max_mass, distance
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?
+)
+`
+
+type CreateMatchHistoryParams struct {
+	PlayerID     int64
+	FinalScore   int64
+	MassEaten    int64
+	SporesEaten  int64
+	PlayersEaten int64
+	MaxMass      int64
+	Distance     int64
+}
+
+// Query to store a finished match for a player
+func (q *Queries) CreateMatchHistory(ctx context.Context, arg CreateMatchHistoryParams) error {
+	_, err := q.db.ExecContext(ctx, createMatchHistory,
+		arg.PlayerID,
+		arg.FinalScore,
+		arg.MassEaten,
+		arg.SporesEaten,
+		arg.PlayersEaten,
+		arg.MaxMass,
+		arg.Distance,
+	)
+	return err
+}
+
 const createPlayer = `-- name: CreatePlayer :one
 INSERT INTO players (
     user_id, name, color
 ) VALUES (
     ?, ?, ?
 )
-RETURNING id, user_id, name, best_score, color
+RETURNING id, user_id, name, best_score, color, skin_id
 `
 
 type CreatePlayerParams struct {
@@ -34,10 +81,31 @@ func (q *Queries) CreatePlayer(ctx context.Context, arg CreatePlayerParams) (Pla
 		&i.Name,
 		&i.BestScore,
 		&i.Color,
+		&i.SkinID,
 	)
 	return i, err
 }
 
+const createPlayerAchievement = `-- name: CreatePlayerAchievement :exec
+INSERT INTO player_achievements (
+    player_id, achievement_id
+) VALUES (
+    ?, ?
+)
+ON CONFLICT (player_id, achievement_id) DO NOTHING
+`
+
+type CreatePlayerAchievementParams struct {
+	PlayerID      int64
+	AchievementID string
+}
+
+// Query to save an achievement a player unlocked, unlocking it again does nothing
+func (q *Queries) CreatePlayerAchievement(ctx context.Context, arg CreatePlayerAchievementParams) error {
+	_, err := q.db.ExecContext(ctx, createPlayerAchievement, arg.PlayerID, arg.AchievementID)
+	return err
+}
+
 const createUser = `-- name: CreateUser :one
 
 /*
@@ -66,9 +134,38 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 	return i, err
 }
 
+const getPlayerAchievements = `-- name: GetPlayerAchievements :many
+SELECT achievement_id FROM player_achievements
+WHERE player_id = ?
+`
+
+// Query to fetch the ids of every achievement a player unlocked
+func (q *Queries) GetPlayerAchievements(ctx context.Context, playerID int64) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, getPlayerAchievements, playerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var achievement_id string
+		if err := rows.Scan(&achievement_id); err != nil {
+			return nil, err
+		}
+		items = append(items, achievement_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getPlayerByName = `-- name: GetPlayerByName :one
-SELECT id, user_id, name, best_score, color FROM players
-WHERE name LIKE ?
+SELECT id, user_id, name, best_score, color, skin_id FROM players
+WHERE name = ? COLLATE NOCASE
 LIMIT 1
 `
 
@@ -82,12 +179,13 @@ func (q *Queries) GetPlayerByName(ctx context.Context, name string) (Player, err
 		&i.Name,
 		&i.BestScore,
 		&i.Color,
+		&i.SkinID,
 	)
 	return i, err
 }
 
 const getPlayerByUserId = `-- name: GetPlayerByUserId :one
-SELECT id, user_id, name, best_score, color FROM players
+SELECT id, user_id, name, best_score, color, skin_id FROM players
 WHERE user_id = ? LIMIT 1
 `
 
@@ -101,6 +199,7 @@ func (q *Queries) GetPlayerByUserId(ctx context.Context, userID int64) (Player,
 		&i.Name,
 		&i.BestScore,
 		&i.Color,
+		&i.SkinID,
 	)
 	return i, err
 }
@@ -120,6 +219,51 @@ func (q *Queries) GetPlayerRank(ctx context.Context, id int64) (int64, error) {
 	return rank, err
 }
 
+const getPlayerSettings = `-- name: GetPlayerSettings :one
+SELECT player_id, chat_enabled, minimap_enabled, auto_collect, self_echo, haptics_enabled FROM player_settings
+WHERE player_id = ? LIMIT 1
+`
+
+// Query to fetch the preferences a player saved
+func (q *Queries) GetPlayerSettings(ctx context.Context, playerID int64) (PlayerSetting, error) {
+	row := q.db.QueryRowContext(ctx, getPlayerSettings, playerID)
+	var i PlayerSetting
+	err := row.Scan(
+		&i.PlayerID,
+		&i.ChatEnabled,
+		&i.MinimapEnabled,
+		&i.AutoCollect,
+		&i.SelfEcho,
+		&i.HapticsEnabled,
+	)
+	return i, err
+}
+
+const getPlayerStats = `-- name: GetPlayerStats :one
+SELECT p.best_score,
+    COUNT(m.id) AS matches_played,
+    CAST(COALESCE(SUM(m.mass_eaten), 0) AS INTEGER) AS total_mass_eaten
+FROM players p
+LEFT JOIN match_history m ON m.player_id = p.id
+WHERE p.name = ? COLLATE NOCASE
+GROUP BY p.id
+LIMIT 1
+`
+
+type GetPlayerStatsRow struct {
+	BestScore      int64
+	MatchesPlayed  int64
+	TotalMassEaten int64
+}
+
+// Query to get the lifetime stats of a player by name, any case but otherwise exact
+func (q *Queries) GetPlayerStats(ctx context.Context, name string) (GetPlayerStatsRow, error) {
+	row := q.db.QueryRowContext(ctx, getPlayerStats, name)
+	var i GetPlayerStatsRow
+	err := row.Scan(&i.BestScore, &i.MatchesPlayed, &i.TotalMassEaten)
+	return i, err
+}
+
 const getTopScores = `-- name: GetTopScores :many
 SELECT name, best_score
 FROM players
@@ -176,6 +320,46 @@ func (q *Queries) GetUserByUsername(ctx context.Context, username string) (User,
 	return i, err
 }
 
+const getWriteQueueSeq = `-- name: GetWriteQueueSeq :one
+SELECT seq FROM write_queue
+WHERE id = 1
+`
+
+// Query to get the sequence number of the last queued write that was applied
+func (q *Queries) GetWriteQueueSeq(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getWriteQueueSeq)
+	var seq int64
+	err := row.Scan(&seq)
+	return seq, err
+}
+
+const resetBestScores = `-- name: ResetBestScores :exec
+UPDATE players
+SET best_score = 0
+`
+
+// Query to zero out every player's best score for a new season
+func (q *Queries) ResetBestScores(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, resetBestScores)
+	return err
+}
+
+const setWriteQueueSeq = `-- name: SetWriteQueueSeq :exec
+INSERT INTO write_queue (
+    id, seq
+) VALUES (
+    1, ?
+)
+ON CONFLICT (id) DO UPDATE SET
+    seq = excluded.seq
+`
+
+// Query to record the sequence number of the last queued write that was applied
+func (q *Queries) SetWriteQueueSeq(ctx context.Context, seq int64) error {
+	_, err := q.db.ExecContext(ctx, setWriteQueueSeq, seq)
+	return err
+}
+
 const updatePlayerBestScore = `-- name: UpdatePlayerBestScore :exec
 UPDATE players
 SET best_score = ?
@@ -192,3 +376,57 @@ func (q *Queries) UpdatePlayerBestScore(ctx context.Context, arg UpdatePlayerBes
 	_, err := q.db.ExecContext(ctx, updatePlayerBestScore, arg.BestScore, arg.ID)
 	return err
 }
+
+const updatePlayerLook = `-- name: UpdatePlayerLook :exec
+UPDATE players
+SET color = ?, skin_id = ?
+WHERE id = ?
+`
+
+type UpdatePlayerLookParams struct {
+	Color  int64
+	SkinID int64
+	ID     int64
+}
+
+// Query to change the color and skin of a player's blob
+func (q *Queries) UpdatePlayerLook(ctx context.Context, arg UpdatePlayerLookParams) error {
+	_, err := q.db.ExecContext(ctx, updatePlayerLook, arg.Color, arg.SkinID, arg.ID)
+	return err
+}
+
+const upsertPlayerSettings = `-- name: UpsertPlayerSettings :exec
+INSERT INTO player_settings (
+    player_id, chat_enabled, minimap_enabled, auto_collect, self_echo, haptics_enabled
+) VALUES (
+    ?, ?, ?, ?, ?, ?
+)
+ON CONFLICT (player_id) DO UPDATE SET
+    chat_enabled = excluded.chat_enabled,
+    minimap_enabled = excluded.minimap_enabled,
+    auto_collect = excluded.auto_collect,
+    self_echo = excluded.self_echo,
+    haptics_enabled = excluded.haptics_enabled
+`
+
+type UpsertPlayerSettingsParams struct {
+	PlayerID       int64
+	ChatEnabled    bool
+	MinimapEnabled bool
+	AutoCollect    bool
+	SelfEcho       int64
+	HapticsEnabled bool
+}
+
+// Query to save a player's preferences, replacing the ones saved before
+func (q *Queries) UpsertPlayerSettings(ctx context.Context, arg UpsertPlayerSettingsParams) error {
+	_, err := q.db.ExecContext(ctx, upsertPlayerSettings,
+		arg.PlayerID,
+		arg.ChatEnabled,
+		arg.MinimapEnabled,
+		arg.AutoCollect,
+		arg.SelfEcho,
+		arg.HapticsEnabled,
+	)
+	return err
+}
@@ -0,0 +1,143 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	_ "modernc.org/sqlite"
+)
+
+// A migrated database in a temporary file, gone once the test is over
+func openTestDb(t *testing.T) *sql.DB {
+	t.Helper()
+	dbPool, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "test.sqlite"))
+	if err != nil {
+		t.Fatalf("opening the database: %v", err)
+	}
+	t.Cleanup(func() { dbPool.Close() })
+
+	if err := Migrate(context.Background(), dbPool); err != nil {
+		t.Fatalf("migrating the database: %v", err)
+	}
+	return dbPool
+}
+
+// A registered player to hang rows off of
+func createTestPlayer(t *testing.T, queries *Queries, name string) Player {
+	t.Helper()
+	ctx := context.Background()
+	user, err := queries.CreateUser(ctx, CreateUserParams{Username: name, PasswordHash: "hash"})
+	if err != nil {
+		t.Fatalf("creating the user: %v", err)
+	}
+	player, err := queries.CreatePlayer(ctx, CreatePlayerParams{UserID: user.ID, Name: name})
+	if err != nil {
+		t.Fatalf("creating the player: %v", err)
+	}
+	return player
+}
+
+func TestPlayerSettingsRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	queries := New(openTestDb(t))
+	player := createTestPlayer(t, queries, "saver")
+
+	saved := UpsertPlayerSettingsParams{
+		PlayerID:       player.ID,
+		ChatEnabled:    false,
+		MinimapEnabled: true,
+		AutoCollect:    true,
+		SelfEcho:       2,
+		HapticsEnabled: false,
+	}
+	if err := queries.UpsertPlayerSettings(ctx, saved); err != nil {
+		t.Fatalf("saving the settings: %v", err)
+	}
+
+	settings, err := queries.GetPlayerSettings(ctx, player.ID)
+	if err != nil {
+		t.Fatalf("loading the settings: %v", err)
+	}
+	loaded := UpsertPlayerSettingsParams{
+		PlayerID:       settings.PlayerID,
+		ChatEnabled:    settings.ChatEnabled,
+		MinimapEnabled: settings.MinimapEnabled,
+		AutoCollect:    settings.AutoCollect,
+		SelfEcho:       settings.SelfEcho,
+		HapticsEnabled: settings.HapticsEnabled,
+	}
+	if loaded != saved {
+		t.Errorf("loaded %+v, saved %+v", loaded, saved)
+	}
+}
+
+// Names are matched whole, a % or _ in the one asked for is just a character
+func TestPlayerStatsMatchTheWholeName(t *testing.T) {
+	ctx := context.Background()
+	queries := New(openTestDb(t))
+	for name, score := range map[string]int64{"bob": 10, "bobby": 500, "b_b": 30} {
+		player := createTestPlayer(t, queries, name)
+		if err := queries.UpdatePlayerBestScore(ctx, UpdatePlayerBestScoreParams{ID: player.ID, BestScore: score}); err != nil {
+			t.Fatalf("saving %s's best score: %v", name, err)
+		}
+	}
+
+	stats, err := queries.GetPlayerStats(ctx, "BOB")
+	if err != nil {
+		t.Fatalf("loading the stats: %v", err)
+	}
+	if stats.BestScore != 10 {
+		t.Errorf("stats for BOB have a best score of %d, want bob's 10", stats.BestScore)
+	}
+
+	for _, pattern := range []string{"bob%", "b%", "%"} {
+		if _, err := queries.GetPlayerStats(ctx, pattern); !errors.Is(err, sql.ErrNoRows) {
+			t.Errorf("stats for %q came back with %v, want no rows", pattern, err)
+		}
+	}
+	stats, err = queries.GetPlayerStats(ctx, "b_b")
+	if err != nil || stats.BestScore != 30 {
+		t.Errorf("stats for b_b are %+v (%v), want its own 30", stats, err)
+	}
+}
+
+func TestPlayerByNameIgnoresCaseButNotWildcards(t *testing.T) {
+	ctx := context.Background()
+	queries := New(openTestDb(t))
+	alice := createTestPlayer(t, queries, "Alice")
+	createTestPlayer(t, queries, "alice_2")
+
+	found, err := queries.GetPlayerByName(ctx, "ALICE")
+	if err != nil || found.ID != alice.ID {
+		t.Errorf("looking up ALICE found %+v (%v), want Alice", found, err)
+	}
+
+	//With LIKE these would have found somebody
+	for _, name := range []string{"Ali%", "alic_", "alice%2"} {
+		if found, err := queries.GetPlayerByName(ctx, name); !errors.Is(err, sql.ErrNoRows) {
+			t.Errorf("looking up %q found %+v (%v), want nobody", name, found, err)
+		}
+	}
+}
+
+func TestPlayerLookIsSavedTogether(t *testing.T) {
+	ctx := context.Background()
+	queries := New(openTestDb(t))
+	player := createTestPlayer(t, queries, "dresser")
+
+	err := queries.UpdatePlayerLook(ctx, UpdatePlayerLookParams{ID: player.ID, Color: 0x3cb44bff, SkinID: 4})
+	if err != nil {
+		t.Fatalf("saving the look: %v", err)
+	}
+
+	saved, err := queries.GetPlayerByUserId(ctx, player.UserID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.Color != 0x3cb44bff || saved.SkinID != 4 {
+		t.Errorf("saved color %x and skin %d, want 3cb44bff and 4", saved.Color, saved.SkinID)
+	}
+}
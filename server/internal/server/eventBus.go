@@ -0,0 +1,104 @@
+package server
+
+import (
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"sync"
+	"sync/atomic"
+)
+
+// Something that happened in the game, published by the states so anything interested (metrics,
+// the kill feed...) can react without the game logic having to know about it
+type GameEvent interface {
+	isGameEvent()
+}
+
+type PlayerJoined struct {
+	PlayerId uint64
+	Player   *objects.Player
+}
+
+// The player is out of the game, after being eaten or going back to the menu or disconnecting
+type PlayerLeft struct {
+	PlayerId uint64
+}
+
+type PlayerEaten struct {
+	EaterId    uint64
+	EaterName  string
+	VictimId   uint64
+	VictimName string
+	Mass       float64 //what the eater got out of it
+}
+
+type SporeEaten struct {
+	PlayerId uint64
+	SporeId  uint64
+	Mass     float64
+}
+
+func (PlayerJoined) isGameEvent() {}
+func (PlayerLeft) isGameEvent()   {}
+func (PlayerEaten) isGameEvent()  {}
+func (SporeEaten) isGameEvent()   {}
+
+// Hands game events to everyone subscribed. Delivery is synchronous: Publish returns once every
+// subscriber has seen the event, so subscribers should be quick and hand anything slow off
+type EventBus struct {
+	mu          sync.RWMutex
+	subscribers []func(GameEvent)
+}
+
+func NewEventBus() *EventBus {
+	return &EventBus{}
+}
+
+// Calls the handler with every event published from now on
+func (b *EventBus) Subscribe(handler func(GameEvent)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscribers = append(b.subscribers, handler)
+}
+
+func (b *EventBus) Publish(event GameEvent) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, handler := range b.subscribers {
+		handler(event)
+	}
+}
+
+// Counts of what's happened in the game since the server started, for the metrics route
+type GameCounters struct {
+	PlayersJoined atomic.Uint64
+	PlayersEaten  atomic.Uint64
+	SporesEaten   atomic.Uint64
+
+	//Not a game event, broadcasts clients gave up on because the hub didn't take them in time
+	BroadcastsDropped atomic.Uint64
+	PanicsRecovered   atomic.Uint64
+}
+
+func (c *GameCounters) count(event GameEvent) {
+	switch event.(type) {
+	case PlayerJoined:
+		c.PlayersJoined.Add(1)
+	case PlayerEaten:
+		c.PlayersEaten.Add(1)
+	case SporeEaten:
+		c.SporesEaten.Add(1)
+	}
+}
+
+// Tells everyone who ate who
+// Events get published from the hub's goroutine too (a client's state handling a broadcast), where
+// waiting on the broadcast channel would wait on ourselves, so the send is handed off
+func (h *Hub) announceKill(event GameEvent) {
+	if eaten, ok := event.(PlayerEaten); ok {
+		packet := &packets.Packet{
+			SenderId: 0,
+			Msg:      packets.NewKillFeed(eaten.EaterId, eaten.EaterName, eaten.VictimId, eaten.VictimName),
+		}
+		go func() { h.BroadcastChan <- packet }()
+	}
+}
@@ -0,0 +1,36 @@
+package server_test
+
+import (
+	"server/internal/server"
+	"server/internal/servertest"
+	"server/pkg/packets"
+	"testing"
+	"time"
+)
+
+func TestPublishDoesntWaitOnTheHub(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+
+	//Nothing reads the broadcast channel yet, like when the hub itself is the one publishing
+	published := make(chan struct{})
+	go func() {
+		hub.EventBus.Publish(server.PlayerEaten{EaterId: 1, EaterName: "eater", VictimId: 2, VictimName: "victim"})
+		close(published)
+	}()
+
+	select {
+	case <-published:
+	case <-time.After(time.Second):
+		t.Fatal("publishing a kill waited on the hub")
+	}
+
+	//The kill still makes it to the broadcast once the hub gets to it
+	select {
+	case packet := <-hub.BroadcastChan:
+		if _, ok := packet.Msg.(*packets.Packet_KillFeed); !ok {
+			t.Errorf("broadcast %T, want the kill feed", packet.Msg)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the kill was never broadcast")
+	}
+}
@@ -0,0 +1,204 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"server/pkg/packets"
+	"sync"
+	"time"
+)
+
+// A map wide event that runs every so often, like a double mass hour or a spore rain
+// While it's on, its multipliers stack with any other event running at the same time
+type ScheduledEvent struct {
+	Name     string        //shown to the players
+	Start    time.Duration //how long after the server starts it first runs
+	Every    time.Duration //time between the starts of two runs, 0 only runs it once
+	Duration time.Duration //how long each run lasts
+
+	MassMultiplier      float64 //mass players get from eating anything, 0 leaves it alone
+	SporeRateMultiplier float64 //how fast eaten spores come back, 0 leaves it alone
+}
+
+// An event that's running, and when it's over
+type ActiveEvent struct {
+	ScheduledEvent
+	EndsAt time.Time
+}
+
+// The events running right now, the multipliers are worked out from these every time so
+// once an event ends everything goes back to how it was no matter what else is running
+type Events struct {
+	mu     sync.RWMutex
+	active map[string]ActiveEvent
+}
+
+func NewEvents() *Events {
+	return &Events{
+		active: make(map[string]ActiveEvent),
+	}
+}
+
+func (e *Events) start(event ScheduledEvent, now time.Time) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.active[event.Name] = ActiveEvent{event, now.Add(event.Duration)}
+}
+
+func (e *Events) end(event ScheduledEvent) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.active, event.Name)
+}
+
+// The events running right now
+func (e *Events) Active() []ActiveEvent {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	active := make([]ActiveEvent, 0, len(e.active))
+	for _, event := range e.active {
+		active = append(active, event)
+	}
+	return active
+}
+
+// How much the mass players gain is multiplied by right now
+func (e *Events) MassMultiplier() float64 {
+	return e.multiplier(func(event ScheduledEvent) float64 { return event.MassMultiplier })
+}
+
+// How much faster spores come back right now
+func (e *Events) SporeRateMultiplier() float64 {
+	return e.multiplier(func(event ScheduledEvent) float64 { return event.SporeRateMultiplier })
+}
+
+func (e *Events) multiplier(get func(ScheduledEvent) float64) float64 {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	multiplier := 1.0
+	for _, event := range e.active {
+		if m := get(event.ScheduledEvent); m > 0 {
+			multiplier *= m
+		}
+	}
+	return multiplier
+}
+
+// Runs the event on its schedule, telling every client when it starts and ends
+// The waits go through the hub's clock, so tests can run a schedule without waiting on it
+func (h *Hub) eventLoop(event ScheduledEvent) {
+	<-h.Clock.After(event.Start)
+
+	for {
+		log.Printf("Event %s started, it lasts %v", event.Name, event.Duration)
+		h.Events.start(event, h.Clock.Now())
+		h.BroadcastChan <- &packets.Packet{
+			SenderId: 0,
+			Msg:      packets.NewEvent(event.Name, true, event.Duration, event.MassMultiplier, event.SporeRateMultiplier),
+		}
+
+		<-h.Clock.After(event.Duration)
+
+		log.Printf("Event %s ended", event.Name)
+		h.Events.end(event)
+		h.BroadcastChan <- &packets.Packet{
+			SenderId: 0,
+			Msg:      packets.NewEvent(event.Name, false, 0, event.MassMultiplier, event.SporeRateMultiplier),
+		}
+
+		if event.Every <= 0 {
+			return
+		}
+		<-h.Clock.After(max(event.Every-event.Duration, 0))
+	}
+}
+
+// How an event is written in the events file, the same as ScheduledEvent but with the times as
+// strings like "90m" or "1h30m"
+type eventFileEntry struct {
+	Name                string
+	Start               string
+	Every               string
+	Duration            string
+	MassMultiplier      float64
+	SporeRateMultiplier float64
+}
+
+// Reads the events from a JSON file with a list of them, like
+// [{"Name": "Double mass hour", "Start": "10m", "Every": "6h", "Duration": "1h", "MassMultiplier": 2}]
+// Start and Every can be left out, anything that isn't one of the fields is an error
+func LoadEvents(path string) ([]ScheduledEvent, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the events file: %w", err)
+	}
+
+	var entries []eventFileEntry
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&entries); err != nil {
+		return nil, fmt.Errorf("error parsing the events file: %w", err)
+	}
+
+	events := make([]ScheduledEvent, 0, len(entries))
+	names := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		event, err := entry.toEvent()
+		if err != nil {
+			return nil, fmt.Errorf("invalid event %q: %w", entry.Name, err)
+		}
+		//Active events are kept by name, two with the same one would end each other
+		if names[event.Name] {
+			return nil, fmt.Errorf("event %q is in the file twice", event.Name)
+		}
+		names[event.Name] = true
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func (e eventFileEntry) toEvent() (ScheduledEvent, error) {
+	event := ScheduledEvent{
+		Name:                e.Name,
+		MassMultiplier:      e.MassMultiplier,
+		SporeRateMultiplier: e.SporeRateMultiplier,
+	}
+
+	var err error
+	if event.Start, err = parseEventDuration(e.Start); err != nil {
+		return event, fmt.Errorf("bad Start: %w", err)
+	}
+	if event.Every, err = parseEventDuration(e.Every); err != nil {
+		return event, fmt.Errorf("bad Every: %w", err)
+	}
+	if event.Duration, err = parseEventDuration(e.Duration); err != nil {
+		return event, fmt.Errorf("bad Duration: %w", err)
+	}
+
+	switch {
+	case event.Name == "":
+		return event, errors.New("it needs a Name")
+	case event.Start < 0:
+		return event, errors.New("Start can't be negative")
+	case event.Duration <= 0:
+		return event, errors.New("Duration has to be positive")
+	case event.Every < 0 || (event.Every > 0 && event.Every < event.Duration):
+		return event, errors.New("Every has to be 0 or at least as long as Duration")
+	case event.MassMultiplier < 0 || event.SporeRateMultiplier < 0:
+		return event, errors.New("the multipliers can't be negative")
+	}
+	return event, nil
+}
+
+func parseEventDuration(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return time.ParseDuration(value)
+}
@@ -0,0 +1,108 @@
+package server_test
+
+import (
+	"os"
+	"path/filepath"
+	"server/internal/server"
+	"server/internal/servertest"
+	"server/pkg/packets"
+	"testing"
+	"time"
+)
+
+// Waits for the event loop to get to its next wait on the clock, then moves the clock past it
+func advanceEventLoop(t *testing.T, clock *servertest.FakeClock, d time.Duration) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for clock.Waiters() == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("the event loop never waited on the clock")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	clock.Advance(d)
+}
+
+func nextEventPacket(t *testing.T, hub *server.Hub) *packets.Packet_Event {
+	t.Helper()
+	select {
+	case packet := <-hub.BroadcastChan:
+		event, ok := packet.Msg.(*packets.Packet_Event)
+		if !ok {
+			t.Fatalf("broadcast %T, want an event", packet.Msg)
+		}
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("the event was never broadcast")
+		return nil
+	}
+}
+
+func TestEventsRunOnTheHubClock(t *testing.T) {
+	hub, clock := servertest.NewTestHub(server.DefaultConfig())
+	event := server.ScheduledEvent{Name: "double mass", Start: time.Minute, Every: time.Hour, Duration: 10 * time.Minute, MassMultiplier: 2}
+	go hub.EventLoop(event)
+
+	advanceEventLoop(t, clock, time.Minute)
+	if started := nextEventPacket(t, hub); !started.Event.Active {
+		t.Fatal("the first event broadcast should be the start")
+	}
+	if got := hub.Events.MassMultiplier(); got != 2 {
+		t.Errorf("mass multiplier is %f while the event runs, want 2", got)
+	}
+
+	advanceEventLoop(t, clock, 10*time.Minute)
+	if ended := nextEventPacket(t, hub); ended.Event.Active {
+		t.Fatal("the second event broadcast should be the end")
+	}
+	if got := hub.Events.MassMultiplier(); got != 1 {
+		t.Errorf("mass multiplier is %f after the event, want 1", got)
+	}
+
+	//It comes back an hour after it first started
+	advanceEventLoop(t, clock, 50*time.Minute)
+	if started := nextEventPacket(t, hub); !started.Event.Active {
+		t.Fatal("the event didn't start again")
+	}
+}
+
+func TestLoadEvents(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.json")
+	write := func(content string) {
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write(`[{"Name": "Double mass hour", "Start": "10m", "Every": "6h", "Duration": "1h", "MassMultiplier": 2},
+		{"Name": "Spore rain", "Duration": "5m", "SporeRateMultiplier": 3}]`)
+	events, err := server.LoadEvents(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []server.ScheduledEvent{
+		{Name: "Double mass hour", Start: 10 * time.Minute, Every: 6 * time.Hour, Duration: time.Hour, MassMultiplier: 2},
+		{Name: "Spore rain", Duration: 5 * time.Minute, SporeRateMultiplier: 3},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("loaded %d events, want %d", len(events), len(want))
+	}
+	for i := range want {
+		if events[i] != want[i] {
+			t.Errorf("event %d is %+v, want %+v", i, events[i], want[i])
+		}
+	}
+
+	for _, bad := range []string{
+		`[{"Name": "no duration"}]`,
+		`[{"Name": "typo", "Duration": "1h", "MassMultiplyer": 2}]`,
+		`[{"Name": "overlaps itself", "Every": "30m", "Duration": "1h"}]`,
+		`[{"Name": "twice", "Duration": "1h"}, {"Name": "twice", "Duration": "1h"}]`,
+		`[{"Name": "bad time", "Duration": "an hour"}]`,
+	} {
+		write(bad)
+		if _, err := server.LoadEvents(path); err == nil {
+			t.Errorf("loaded %s without an error", bad)
+		}
+	}
+}
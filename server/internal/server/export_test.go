@@ -0,0 +1,70 @@
+package server
+
+import (
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"time"
+)
+
+// The unexported parts of the hub that the tests in server_test drive directly
+
+func (h *Hub) MoveSpores(delta float64) {
+	h.moveSpores(delta)
+}
+
+func (h *Hub) EventLoop(event ScheduledEvent) {
+	h.eventLoop(event)
+}
+
+func (h *Hub) ReapPreGame() {
+	h.reapPreGame()
+}
+
+func (h *Hub) NewSporeRadius(rng objects.Rand) float64 {
+	return h.newSporeRadius(rng)
+}
+
+func (h *Hub) ShrinkWorldLoop() {
+	h.shrinkWorldLoop()
+}
+
+func (h *Hub) SendRegionLeaderboards() {
+	h.sendRegionLeaderboards(func(uint64, *objects.Player) bool { return true })
+}
+
+func (h *Hub) BuildMinimap() packets.Msg {
+	return h.buildMinimap()
+}
+
+func (h *Hub) ReapTrailSpores(now time.Time) []uint64 {
+	return h.reapTrailSpores(now)
+}
+
+func (h *Hub) SendGlobalLeaderboard(full bool) {
+	h.sendGlobalLeaderboard(objects.RankPlayers(h.SharedGameObjects.Players, nil), full)
+}
+
+func (h *Hub) SendPlayerRanks() {
+	h.sendPlayerRanks(objects.RankPlayers(h.SharedGameObjects.Players, nil))
+}
+
+func (h *Hub) PlaceSpores() {
+	h.placeSpores()
+}
+
+type GrowthWatch = growthWatch
+
+func NewGrowthWatch() *GrowthWatch {
+	return &growthWatch{lastMass: make(map[uint64]float64), flagged: make(map[uint64]bool)}
+}
+
+// What one round of the leaderboard loop would rank, after checking everyone's growth
+func (h *Hub) LeaderboardEligible(growth *GrowthWatch, elapsed time.Duration) []objects.LeaderboardEntry {
+	h.checkGrowth(growth, elapsed)
+	ranked := objects.RankPlayers(h.SharedGameObjects.Players, nil)
+	return eligibleEntries(ranked, growth, h.Config().LeaderboardMinMass)
+}
+
+func SporeValueFor(config *Config, players int) float64 {
+	return sporeValueFor(config, players)
+}
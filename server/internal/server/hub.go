@@ -3,37 +3,65 @@ package server
 import (
 	"context"
 	"database/sql"
-	_ "embed"
+	"fmt"
+	"io"
 	"log"
+	"math"
 	"math/rand"
 	"net/http"
+	"runtime/debug"
 	"server/internal/server/db"
 	"server/internal/server/objects"
 	"server/pkg/packets"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"google.golang.org/protobuf/proto"
 	_ "modernc.org/sqlite"
 )
 
 // max number of spores allowed on the map
 const MaxSpores = 1000
 
-//go:embed db/config/schema.sql
-var schemaGenSql string
-
-//The schemaGenSql string will hold all the sql that we'll run
-
 // Structure for database transactions
 type DbTx struct {
-	Ctx     context.Context
-	Queries *db.Queries
+	Ctx       context.Context
+	Queries   *db.Queries
+	Queue     *WriteQueue //nil if the writes go straight to the database
+	available *atomic.Bool
 }
 
 // Constructor for the DbTx struct (which will also be methods for the Hub)
 func (h *Hub) NewDbTx() *DbTx {
 	return &DbTx{
-		Ctx:     context.Background(),
-		Queries: db.New(h.dbPool),
+		Ctx:       context.Background(),
+		Queries:   db.New(h.dbPool),
+		Queue:     h.WriteQueue,
+		available: &h.dbAvailable,
+	}
+}
+
+// False when the server is running without a database, nothing can be loaded or saved then
+func (d *DbTx) Available() bool {
+	return d.available.Load()
+}
+
+// Adds a spore to the map, keeping track of it separately while it's moving
+func (s *SharedGameObjects) AddSpore(spore *objects.Spore) uint64 {
+	sporeId := s.Spores.Add(spore)
+	if spore.Moving() {
+		s.MovingSpores.Add(spore, sporeId)
+	}
+	return sporeId
+}
+
+func NewSharedGameObjects(worldBound *objects.WorldBound) *SharedGameObjects {
+	return &SharedGameObjects{
+		Players:      objects.NewSharedCollection[*objects.Player](),
+		Spores:       objects.NewSharedCollection[*objects.Spore](),
+		MovingSpores: objects.NewSharedCollection[*objects.Spore](),
+		WorldBound:   worldBound,
 	}
 }
 
@@ -41,6 +69,15 @@ type SharedGameObjects struct {
 	//The player ID is same as client ID
 	Players *objects.SharedCollection[*objects.Player]
 	Spores  *objects.SharedCollection[*objects.Spore]
+
+	//The spores that are moving right now (also in Spores), so the ones sitting still cost nothing
+	MovingSpores *objects.SharedCollection[*objects.Spore]
+
+	//The current edge of the world
+	WorldBound *objects.WorldBound
+
+	//Goes up with every season reset, players' best scores loaded in an older season start over
+	BestScoreSeason atomic.Uint64
 }
 
 // A structure for the state machine to process client side messages
@@ -68,12 +105,26 @@ type ClientInterfacer interface {
 	//Setting states
 	SetState(newState ClientStateHandler)
 
+	//Name of the current state and when the client switched to it, safe to call from any goroutine
+	StateName() string
+	StateEnteredAt() time.Time
+
+	//Last time the client sent anything other than a heartbeat, zero if it never has
+	LastActivity() time.Time
+
 	//Puts data from the current client to the WritePump
 	SocketSend(message packets.Msg)
 
 	//Puts data from another client to the WritePump
 	SocketSendAs(message packets.Msg, senderId uint64)
 
+	//Puts an already marshaled packet to the WritePump, so the same bytes can go to many clients
+	SocketSendRaw(data []byte)
+
+	//Sends an important packet that's resent until the client acks it (if the client supports acks)
+	SocketSendReliable(message packets.Msg)
+	SetSupportsAcks(supportsAcks bool)
+
 	//Forward message to another client for processing
 	PassToPeer(message packets.Msg, peerId uint64)
 
@@ -86,13 +137,77 @@ type ClientInterfacer interface {
 	//Pumps data from the connected socket to the client
 	WritePump()
 
+	//Total number of bytes written to this client's socket so far
+	BytesSent() uint64
+
+	//Packets dropped because the client couldn't keep up (its send channel was full)
+	DroppedPackets() uint64
+
+	//Last measured round trip time to the client (0 if not measured yet)
+	Rtt() time.Duration
+
+	//Tunable server settings
+	Config() *Config
+
+	//Where the client and its states write their logs
+	LogWriter() io.Writer
+
+	//The version the client said it is (empty if it never said)
+	ClientVersion() string
+	SetClientVersion(version string)
+
 	//A reference to the database transaction context for this client
 	DbTx() *DbTx
 
 	SharedGameObjects() *SharedGameObjects
 
-	//Closing client connection + cleanup
+	//Where the states report failed validations to
+	AntiCheat() *AntiCheat
+
+	//Time source for cooldowns, TTLs and the like
+	Clock() Clock
+
+	//Recent chat messages, for players that join later
+	ChatHistory() *objects.ChatHistory
+
+	//Whether the round has started, or how long until it does
+	Round() *Round
+
+	//The global leaderboard as last sent to everyone
+	Leaderboard() *Leaderboard
+
+	//Map wide events running right now, and what they change
+	Events() *Events
+
+	//Where the states publish what happens in the game
+	EventBus() *EventBus
+
+	//How much spores are worth for the current player count
+	SporeValue() *SporeValue
+
+	//Name, player count and the like, for clients that haven't joined yet
+	ServerInfo() ServerInfo
+
+	//Whether the game has room for another player
+	GameFull() bool
+
+	//Slots that let a client get its player back after losing the connection
+	ReconnectSlots() *ReconnectSlots
+	ReclaimSlot(oldClientId uint64, secret string) (*objects.Player, bool)
+
+	//Runs the task on the client's own goroutine, between the packets it reads, so timers and the
+	//hub can change the client's state without racing it. Safe to call from any goroutine
+	RunLater(task func())
+
+	//True once the connection is being closed (as opposed to just changing states)
+	Closing() bool
+
+	//Closing client connection + cleanup, only from the client's own goroutine since it changes the state
 	Close(reason string) //passing in this parameter to know the reason behind closing
+
+	//Tells the client why it's being kicked and drops the connection, the pumps do the cleanup
+	//Unlike Close it's safe to call from outside the client's own goroutines
+	Kick(reason string)
 }
 
 // The centerl communication b/w client and server:
@@ -111,28 +226,161 @@ type Hub struct {
 	//Database connection pool
 	dbPool *sql.DB
 
+	//Without RequireDb the server keeps going if the database can't be used, just without saving anything
+	dbAvailable atomic.Bool
+
+	//Where the writes that can wait go before the database, nil without a WriteQueueFile
+	WriteQueue *WriteQueue
+
 	//
 	SharedGameObjects *SharedGameObjects
+
+	//Tunable server settings, swapped out as a whole when the balance gets reloaded
+	config    atomic.Pointer[Config]
+	reloadMux sync.Mutex //one reload at a time, so two can't both start from the same config
+
+	//Keeps track of suspicious behaviour per client
+	AntiCheat *AntiCheat
+
+	//Shared by every logger in the server
+	LogWriter io.Writer
+
+	//Players can't move until the round starts
+	Round *Round
+
+	//Last global leaderboard sent, what the next one gets diffed against
+	Leaderboard *Leaderboard
+
+	//Scheduled events like double mass hours, and which of them are on
+	Events *Events
+
+	//Spore value multiplier that follows the player count
+	SporeValue *SporeValue
+
+	//Game events (who ate what, who joined) go out to the subscribers through here
+	EventBus *EventBus
+
+	//Totals of the game events, for the metrics
+	Counters *GameCounters
+
+	//Every client's messages go through these before its state gets them, in order
+	Middleware    []MessageMiddleware
+	MessageCounts *MessageCounts
+
+	//Players' progress towards the achievements
+	Achievements *AchievementTracker
+
+	//Where the game logic gets the time from
+	Clock Clock
+
+	//The last few chat messages
+	ChatHistory *objects.ChatHistory
+
+	//Players of dropped clients wait here for a while in case the client comes back
+	ReconnectSlots *ReconnectSlots
+
+	//The broadcast currently being delivered, already marshaled
+	currentBroadcast atomic.Pointer[encodedBroadcast]
+
+	//When the server started, for the uptime
+	startedAt time.Time
+
+	//Seed for the starting spores, from the config or picked at random
+	mapSeed int64
+}
+
+// A broadcast packet marshaled once, so every client that forwards it as is can reuse the same bytes
+// instead of each of them marshaling their own copy
+type encodedBroadcast struct {
+	senderId uint64
+	msg      packets.Msg
+	data     []byte
 }
 
 // Constructor for the Hub:
-func NewHub() *Hub {
+func NewHub(config *Config) *Hub {
+	logWriter, err := NewLogWriter(config)
+	if err != nil {
+		log.Fatalf("Error setting up logging: %v", err)
+	}
+	log.SetOutput(logWriter) //the standard logger (used by the hub) goes there too
+
 	dbPool, err := sql.Open("sqlite", "db.sqlite")
 	if err != nil {
-		log.Fatalf("Error opening database: %v", err)
+		if config.RequireDb {
+			log.Fatalf("Error opening database: %v", err)
+		}
+		log.Printf("WARNING: error opening database, running without saving anything: %v", err)
+	}
+
+	hub := NewHubWithClock(config, logWriter, RealClock{})
+	if err == nil {
+		hub.UseDb(dbPool) //Now each client interface will have its own db transaction
 	}
 
-	return &Hub{
+	if config.WriteQueueFile != "" {
+		hub.WriteQueue, err = OpenWriteQueue(config.WriteQueueFile)
+		if err != nil {
+			log.Fatalf("Error opening the write queue: %v", err)
+		}
+		hub.WriteQueue.OnSeasonReset = hub.seasonReset
+	}
+
+	return hub
+}
+
+// Gives the hub a database to load and save with, clients made before this don't see it
+// It's assumed to be working, Run finds out if it isn't
+func (h *Hub) UseDb(dbPool *sql.DB) {
+	h.dbPool = dbPool
+	h.dbAvailable.Store(true)
+}
+
+// Everything the hub needs that doesn't touch the outside world (the database, log files...),
+// NewHub adds those on top. Tests use it on its own with a fake clock
+func NewHubWithClock(config *Config, logWriter io.Writer, clock Clock) *Hub {
+	worldBound := objects.NewWorldBound(config.WorldBound)
+
+	hub := &Hub{
 		Clients:        objects.NewSharedCollection[ClientInterfacer](),
 		BroadcastChan:  make(chan *packets.Packet),
 		RegisterChan:   make(chan ClientInterfacer),
 		UnregisterChan: make(chan ClientInterfacer),
-		dbPool:         dbPool, //Now each client interface will have its own db transaction
-		SharedGameObjects: &SharedGameObjects{
-			Players: objects.NewSharedCollection[*objects.Player](),
-			Spores:  objects.NewSharedCollection[*objects.Spore](),
-		},
+		AntiCheat:      NewAntiCheat(NewLogSuspicionSink(logWriter), config.SuspicionThreshold, clock),
+		LogWriter:      logWriter,
+		Round:          NewRound(config.RoundMinPlayers <= 0), //without a player minimum there's no countdown
+		Leaderboard:    NewLeaderboard(),
+		Events:         NewEvents(),
+		SporeValue:     NewSporeValue(),
+		EventBus:       NewEventBus(),
+		Counters:       &GameCounters{},
+		MessageCounts:  NewMessageCounts(),
+		ReconnectSlots: NewReconnectSlots(),
+		Clock:          clock,
+		ChatHistory:    objects.NewChatHistory(config.ChatHistorySize),
+		startedAt:      clock.Now(),
+	}
+	hub.SharedGameObjects = NewSharedGameObjects(worldBound)
+	hub.config.Store(config)
+	objects.SpawnStats.SlowAttempts.Store(int64(config.SlowSpawnAttempts))
+
+	hub.mapSeed = config.MapSeed
+	if hub.mapSeed == 0 {
+		hub.mapSeed = rand.Int63()
+	}
+	log.Printf("Map seed: %d", hub.mapSeed)
+
+	hub.Achievements = NewAchievementTracker(hub)
+
+	hub.Middleware = []MessageMiddleware{
+		CountMessages(hub.MessageCounts),
+		RateLimitMessages(config.MessageRateLimits, hub.MessageCounts),
 	}
+
+	hub.EventBus.Subscribe(hub.Counters.count)
+	hub.EventBus.Subscribe(hub.announceKill)
+	hub.EventBus.Subscribe(hub.Achievements.handle)
+	return hub
 }
 
 // Creating a run method for Hub
@@ -145,17 +393,55 @@ func NewHub() *Hub {
 // (Also, the reason for using a select loop: if the Hub gets two requests, it'll select one,
 // process it and then move to the other)
 func (h *Hub) Run() {
-	log.Println("Initializing database...")
-	if _, err := h.dbPool.ExecContext(context.Background(), schemaGenSql); err != nil {
-		log.Fatalf("Error initializing database: %v", err)
+	if h.dbAvailable.Load() {
+		log.Println("Initializing database...")
+		if err := h.connectDb(); err != nil {
+			if h.Config().RequireDb {
+				log.Fatalf("Error initializing database: %v", err)
+			}
+			log.Printf("WARNING: error initializing database, running without saving anything until it works: %v", err)
+			h.dbAvailable.Store(false)
+			go h.retryDbLoop(dbRetryInterval)
+		}
 	}
 
-	log.Println("Placing spores...")
-	for i := 0; i < MaxSpores; i++ {
-		h.SharedGameObjects.Spores.Add(h.newSpore())
+	if h.Round.Started() {
+		log.Println("Placing spores...")
+		h.placeSpores()
+		go h.replenishSporesLoop(2 * time.Second)
+	} else {
+		//The spores get placed once the countdown is over
+		go h.roundLoop()
+	}
+
+	go h.moveSporesLoop(50 * time.Millisecond)
+	go h.leaderboardLoop(h.Config().LeaderboardInterval)
+	go h.minimapLoop(h.Config().MinimapInterval)
+	go h.Achievements.loop(time.Second)
+
+	if h.Config().PreGameTimeout > 0 {
+		go h.reapPreGameLoop(10 * time.Second)
+	}
+
+	if h.Config().ShrinkEnabled {
+		go h.shrinkWorldLoop()
+	}
+
+	if h.Config().SporeTrailTTL > 0 {
+		go h.reapTrailSporesLoop(h.Config().SporeReapInterval)
+	}
+
+	if h.Config().SeasonInterval > 0 {
+		go h.seasonResetLoop(h.Config().SeasonInterval)
+	}
+
+	if h.Config().SporeValueScaling != SporeValueNone {
+		go h.sporeValueLoop(h.Config().SporeValueInterval)
 	}
 
-	go h.replenishSporesLoop(2 * time.Second)
+	for _, event := range h.Config().Events {
+		go h.eventLoop(event)
+	}
 
 	log.Println("Awaiting client registeration!")
 	for {
@@ -165,27 +451,109 @@ func (h *Hub) Run() {
 			//index number in the map (for now)
 
 		case client := <-h.UnregisterChan:
-			h.Clients.Remove(client.Id())
+			//Nothing to do for a client that was already removed
+			if !h.Clients.Remove(client.Id()) {
+				continue
+			}
+			h.AntiCheat.Forget(client.Id())
 
 		case packet := <-h.BroadcastChan:
-			// for id, client := range h.Clients {
-			// 	if id != packet.SenderId {
-			// 		client.ProcessMessage(packet.SenderId, packet.Msg)
-			// 	}
-			// }
-			//This last case takes any packet sent to the broadcast channel, then it
-			//loops through each client in our map (named Clients)
-			//As long as the client ID is not same as the packet sender ID
-			//the message is processed by the client
-			//^Instead of the for in range loop, using a for each loop now after making the sharedCollection
-			h.Clients.ForEach(func(clientId uint64, client ClientInterfacer) {
-				if clientId != packet.SenderId {
-					client.ProcessMessage(packet.SenderId, packet.Msg)
-				}
-			})
+			h.DeliverBroadcast(packet)
+		}
+	}
+}
+
+// Hands a packet to every client but its sender, it's what the hub does with everything sent to
+// BroadcastChan. Has to run on the hub goroutine, clients can't take another broadcast meanwhile
+func (h *Hub) DeliverBroadcast(packet *packets.Packet) {
+	//Most clients just forward broadcasts to their socket unchanged, so marshaling here once
+	//saves every one of them from doing it again (see EncodedBroadcast)
+	if data, err := proto.Marshal(packet); err == nil {
+		h.currentBroadcast.Store(&encodedBroadcast{senderId: packet.SenderId, msg: packet.Msg, data: data})
+	}
+
+	// for id, client := range h.Clients {
+	// 	if id != packet.SenderId {
+	// 		client.ProcessMessage(packet.SenderId, packet.Msg)
+	// 	}
+	// }
+	//This takes any packet sent to the broadcast channel, then it
+	//loops through each client in our map (named Clients)
+	//As long as the client ID is not same as the packet sender ID
+	//the message is processed by the client
+	//^Instead of the for in range loop, using a for each loop now after making the sharedCollection
+	h.Clients.ForEach(func(clientId uint64, client ClientInterfacer) {
+		if clientId != packet.SenderId {
+			h.deliver(client, packet)
+		}
+	})
+	h.currentBroadcast.Store(nil)
+}
+
+// How often the database is tried again when it didn't work at the start
+const dbRetryInterval = 30 * time.Second
 
+// Gets the database ready to use and starts applying the write queue, whatever was left in it
+// from last time gets replayed first
+func (h *Hub) connectDb() error {
+	if err := db.Migrate(context.Background(), h.dbPool); err != nil {
+		return err
+	}
+	if h.WriteQueue != nil {
+		if err := h.WriteQueue.Start(h.dbPool); err != nil {
+			return err
 		}
 	}
+	return nil
+}
+
+// Keeps trying the database until it works, then everything that saves starts saving again and
+// the writes queued up in the meantime get applied
+func (h *Hub) retryDbLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if err := h.connectDb(); err != nil {
+			log.Printf("Database still not working, trying again in %v: %v", interval, err)
+			continue
+		}
+		log.Println("Database is working again")
+		h.dbAvailable.Store(true)
+		return
+	}
+}
+
+// The config right now. Callers shouldn't hold on to it across ticks, or they'll miss reloads
+func (h *Hub) Config() *Config {
+	return h.config.Load()
+}
+
+// Hands a broadcast to one client, a panic in its state is logged instead of taking the hub
+// (and every other client) down with it
+func (h *Hub) deliver(client ClientInterfacer, packet *packets.Packet) {
+	defer h.RecoverPanic(client.Id(), "handling a %T broadcast from %d", packet.Msg, packet.SenderId)
+	client.ProcessMessage(packet.SenderId, packet.Msg)
+}
+
+// Has to be deferred directly. Stops a panic from going any further, logging it with the stack
+// trace so the bug still gets noticed, and counting it for the metrics
+// The format and args say what the client was doing, they're only formatted if it panicked
+func (h *Hub) RecoverPanic(clientId uint64, format string, args ...any) {
+	if r := recover(); r != nil {
+		h.Counters.PanicsRecovered.Add(1)
+		log.Printf("Recovered from a panic in client %d while %s: %v\n%s", clientId, fmt.Sprintf(format, args...), r, debug.Stack())
+	}
+}
+
+// Returns the already marshaled bytes of the broadcast being delivered right now, if it's the
+// same message from the same sender. Clients use this to skip marshaling when forwarding a broadcast
+func (h *Hub) EncodedBroadcast(senderId uint64, message packets.Msg) ([]byte, bool) {
+	broadcast := h.currentBroadcast.Load()
+	if broadcast == nil || broadcast.senderId != senderId || broadcast.msg != message {
+		return nil, false
+	}
+	return broadcast.data, true
 }
 
 // Another Hub method, that has a function as its first argument
@@ -216,12 +584,43 @@ func (h *Hub) Serve(getNewClient func(*Hub, http.ResponseWriter, *http.Request)
 	//These two methods will be loops that will continuously read and write.
 }
 
-func (h *Hub) newSpore() *objects.Spore {
-	sporeRadius := max(10+rand.NormFloat64()*3, 5)
-	x, y := objects.SpawnCoords(sporeRadius, h.SharedGameObjects.Players, h.SharedGameObjects.Spores)
+// The starting spores come from their own RNG seeded with the map seed, so the same seed gives
+// the same layout (as long as nobody is in the game yet for the spores to avoid)
+func (h *Hub) placeSpores() {
+	rng := rand.New(rand.NewSource(h.mapSeed))
+	for i := 0; i < MaxSpores; i++ {
+		h.SharedGameObjects.Spores.Add(h.newSpore(rng))
+	}
+}
+
+func (h *Hub) newSpore(rng objects.Rand) *objects.Spore {
+	sporeRadius := h.newSporeRadius(rng)
+	x, y := objects.SpawnCoordsWith(h.sporeSampler(rng), sporeRadius, h.SharedGameObjects.WorldBound.Get(), h.SharedGameObjects.Players, h.SharedGameObjects.Spores)
 	return &objects.Spore{X: x, Y: y, Radius: sporeRadius}
 }
 
+// Picks a radius for a new spore using the distribution from the config
+func (h *Hub) newSporeRadius(rng objects.Rand) float64 {
+	switch h.Config().SporeRadiusDistribution {
+	case DistributionUniform:
+		return h.Config().SporeRadiusMin + rng.Float64()*(h.Config().SporeRadiusMax-h.Config().SporeRadiusMin)
+	default:
+		return max(h.Config().SporeRadiusMean+rng.NormFloat64()*h.Config().SporeRadiusStdDev, h.Config().SporeRadiusMin)
+	}
+}
+
+// Picks how spores are spread around the map based on the config
+func (h *Hub) sporeSampler(rng objects.Rand) objects.Sampler {
+	switch h.Config().SporePlacement {
+	case PlacementEdge:
+		return objects.EdgeWeightedSampler(rng)
+	case PlacementRing:
+		return objects.RingSampler(rng, h.Config().SporeRingInner)
+	default:
+		return objects.UniformSampler(rng)
+	}
+}
+
 func (h *Hub) replenishSporesLoop(rate time.Duration) {
 	ticker := time.NewTicker(rate)
 	defer ticker.Stop()
@@ -236,14 +635,15 @@ func (h *Hub) replenishSporesLoop(rate time.Duration) {
 
 		log.Printf("%d spores remaining. Going to replenish %d spores", sporesRemaining, diff)
 
-		//Replenishing 10 spores at max at a time to avoid lag
-		for i := 0; i < min(diff, 10); i++ {
-			spore := h.newSpore()
+		//Replenishing 10 spores at max at a time to avoid lag, more during events like a spore rain
+		batch := int(10 * h.Events.SporeRateMultiplier())
+		for i := 0; i < min(diff, batch); i++ {
+			spore := h.newSpore(objects.GlobalRand)
 			sporeId := h.SharedGameObjects.Spores.Add(spore)
 
 			h.BroadcastChan <- &packets.Packet{
 				SenderId: 0,
-				Msg:      packets.NewSpore(sporeId, spore),
+				Msg:      packets.NewSpore(sporeId, spore, h.SharedGameObjects.WorldBound),
 			}
 
 			//Sleeping to avoid lag
@@ -251,3 +651,142 @@ func (h *Hub) replenishSporesLoop(rate time.Duration) {
 		}
 	}
 }
+
+// Moves the spores that have a velocity (ejected mass) and slows them down, telling everyone
+// where they are while they're moving. Spores sitting still aren't sent again
+func (h *Hub) moveSporesLoop(rate time.Duration) {
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		h.moveSpores(rate.Seconds())
+	}
+}
+
+// One tick of moveSporesLoop, delta seconds long
+func (h *Hub) moveSpores(delta float64) {
+	//Losing SporeFriction of the speed every second, spread over the ticks
+	slowdown := math.Pow(1-min(max(h.Config().SporeFriction, 0), 1), delta)
+
+	updates := make([]*packets.Packet, 0)
+	h.SharedGameObjects.MovingSpores.ForEach(func(sporeId uint64, spore *objects.Spore) {
+		//Clients read the spore we have from their own goroutines, so it's never changed
+		//The moved one is a copy that takes its place
+		moved := *spore
+		stillMoving := moved.Step(delta, slowdown, h.Config().SporeStopSpeed)
+		moved.X, moved.Y = h.SharedGameObjects.WorldBound.Clamp(moved.X, moved.Y)
+
+		//Eaten or reaped while it was moving
+		if !h.SharedGameObjects.Spores.Set(sporeId, &moved) {
+			h.SharedGameObjects.MovingSpores.Remove(sporeId)
+			return
+		}
+		if stillMoving {
+			h.SharedGameObjects.MovingSpores.Set(sporeId, &moved)
+		} else {
+			h.SharedGameObjects.MovingSpores.Remove(sporeId)
+		}
+
+		//Sending where it is every tick while moving, including the one it stops on
+		updates = append(updates, &packets.Packet{
+			SenderId: 0,
+			Msg:      packets.NewSpore(sporeId, &moved, h.SharedGameObjects.WorldBound),
+		})
+	})
+
+	//Sent once we're done going through them, a full channel holds up the sending but not the moving
+	for _, update := range updates {
+		h.BroadcastChan <- update
+	}
+}
+
+// Removes the spores players dropped that nobody ate in time, so trails don't pile up on the map
+func (h *Hub) reapTrailSporesLoop(rate time.Duration) {
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		despawned := h.reapTrailSpores(h.Clock.Now())
+		if len(despawned) > 0 {
+			h.BroadcastChan <- &packets.Packet{
+				SenderId: 0,
+				Msg:      packets.NewSporesDespawned(despawned),
+			}
+		}
+	}
+}
+
+// Removes the dropped spores that are older than the TTL as of now, and returns their ids
+// Spores placed by the server (no DroppedBy) stay until they're eaten
+func (h *Hub) reapTrailSpores(now time.Time) []uint64 {
+	expired := make([]uint64, 0)
+	h.SharedGameObjects.Spores.ForEach(func(sporeId uint64, spore *objects.Spore) {
+		if spore.DroppedBy != nil && now.Sub(spore.DroppedAt) > h.Config().SporeTrailTTL {
+			expired = append(expired, sporeId)
+		}
+	})
+
+	//Only the ones we actually removed count, someone could have eaten one in the meantime
+	despawned := make([]uint64, 0, len(expired))
+	for _, sporeId := range expired {
+		if h.SharedGameObjects.Spores.Remove(sporeId) {
+			despawned = append(despawned, sporeId)
+		}
+	}
+	return despawned
+}
+
+// Shrinks the world bound on a schedule until it reaches the minimum, telling every client
+// about the new bound so they can draw the shrinking zone
+func (h *Hub) shrinkWorldLoop() {
+	ticker := time.NewTicker(h.Config().ShrinkInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		bound := h.SharedGameObjects.WorldBound.Get()
+		if bound <= h.Config().ShrinkMinBound {
+			log.Printf("World reached its minimum bound of %f, done shrinking", bound)
+			return
+		}
+
+		bound = max(bound-h.Config().ShrinkStep, h.Config().ShrinkMinBound)
+		h.SharedGameObjects.WorldBound.Set(bound)
+		log.Printf("World shrunk to a bound of %f", bound)
+
+		h.BroadcastChan <- &packets.Packet{
+			SenderId: 0,
+			Msg:      packets.NewWorldBounds(bound),
+		}
+	}
+}
+
+// Drops connections that have been in the menu for longer than PreGameTimeout without doing anything
+// Players only end up there by connecting or leaving the game, so that's how long they've been idle
+func (h *Hub) reapPreGameLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		h.reapPreGame()
+	}
+}
+
+func (h *Hub) reapPreGame() {
+	timeout := h.Config().PreGameTimeout
+	now := h.Clock.Now()
+	h.Clients.ForEach(func(clientId uint64, client ClientInterfacer) {
+		if client.StateName() != "Connected" {
+			return
+		}
+		//Anything the client sends (logging in, chatting in the menu...) starts the wait over
+		lastSeen := client.StateEnteredAt()
+		if activity := client.LastActivity(); activity.After(lastSeen) {
+			lastSeen = activity
+		}
+		if now.Sub(lastSeen) < timeout {
+			return
+		}
+		log.Printf("Client %d has been in the menu without doing anything for more than %v, dropping it", clientId, timeout)
+		client.Kick("idle")
+	})
+}
@@ -0,0 +1,284 @@
+package server_test
+
+import (
+	"server/internal/server"
+	"server/internal/server/objects"
+	"server/internal/servertest"
+	"server/pkg/packets"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestMovingSporesMoveEachTick(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	spore := &objects.Spore{Radius: 10, VX: 800}
+	sporeId := hub.SharedGameObjects.AddSpore(spore)
+
+	//Nothing runs the hub, so the position updates are taken here
+	go func() {
+		for range hub.BroadcastChan {
+		}
+	}()
+
+	hub.MoveSpores(0.05)
+	moved, _ := hub.SharedGameObjects.Spores.Get(sporeId)
+	if moved.X <= 0 {
+		t.Errorf("spore is at x %f after a tick, it should have moved forward", moved.X)
+	}
+	if spore.X != 0 {
+		t.Errorf("the spore from before the tick was changed to x %f, it should have been replaced", spore.X)
+	}
+
+	//Friction brings it to a stop eventually, then it's not moving anymore
+	for i := 0; i < 1000 && moved.Moving(); i++ {
+		hub.MoveSpores(0.05)
+		moved, _ = hub.SharedGameObjects.Spores.Get(sporeId)
+	}
+	if _, moving := hub.SharedGameObjects.MovingSpores.Get(sporeId); moving {
+		t.Error("the spore is still tracked as moving after it stopped")
+	}
+}
+
+// Clients look at spores on their own goroutines while they move, run with -race
+func TestMovingSporesCanBeReadWhileTheyMove(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	for i := 0; i < 20; i++ {
+		hub.SharedGameObjects.AddSpore(&objects.Spore{Radius: 10, VX: 800, VY: 300})
+	}
+
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			hub.SharedGameObjects.Spores.ForEach(func(_ uint64, spore *objects.Spore) {
+				_ = spore.X + spore.Y + spore.VX + spore.VY
+			})
+		}
+	}()
+
+	//The tick sends a position for each spore once it's moved them all
+	ticked := make(chan struct{})
+	go func() {
+		hub.MoveSpores(0.05)
+		close(ticked)
+	}()
+	for updates := 0; updates < 20; updates++ {
+		<-hub.BroadcastChan
+	}
+	<-ticked
+	close(done)
+	wg.Wait()
+
+	hub.SharedGameObjects.Spores.ForEach(func(sporeId uint64, spore *objects.Spore) {
+		if spore.X <= 0 {
+			t.Errorf("spore %d didn't move", sporeId)
+		}
+	})
+}
+
+// A state that keeps whatever it's handed, or panics on it
+type recordingState struct {
+	panics   bool
+	mux      sync.Mutex
+	messages []packets.Msg
+}
+
+func (s *recordingState) Name() string                             { return "Recording" }
+func (s *recordingState) SetClient(client server.ClientInterfacer) {}
+func (s *recordingState) OnEnter()                                 {}
+func (s *recordingState) OnExit()                                  {}
+
+func (s *recordingState) HandleMessage(senderId uint64, message packets.Msg) {
+	if s.panics {
+		panic("handler bug")
+	}
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.messages = append(s.messages, message)
+}
+
+func (s *recordingState) received() int {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return len(s.messages)
+}
+
+func TestBroadcastSurvivesAPanickingClient(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	//Working clients on both sides of the broken one, whichever order the hub gets to them in
+	//they all have to get the broadcast
+	var states []*recordingState
+	for _, panics := range []bool{false, true, false} {
+		state := &recordingState{panics: panics}
+		servertest.NewTestClient(hub).SetState(state)
+		states = append(states, state)
+	}
+
+	hub.DeliverBroadcast(&packets.Packet{SenderId: 0, Msg: packets.NewChat("hello")})
+	hub.DeliverBroadcast(&packets.Packet{SenderId: 0, Msg: packets.NewChat("still here?")})
+
+	if got := hub.Counters.PanicsRecovered.Load(); got != 2 {
+		t.Errorf("recovered %d panics, want 2", got)
+	}
+	for i, state := range states {
+		if !state.panics && state.received() != 2 {
+			t.Errorf("client %d got %d broadcasts, want 2", i, state.received())
+		}
+	}
+}
+
+// A state that only has a name, for the hub checks that go by the state a client is in
+type namedState struct {
+	name string
+}
+
+func (s *namedState) Name() string                                       { return s.name }
+func (s *namedState) SetClient(client server.ClientInterfacer)           {}
+func (s *namedState) OnEnter()                                           {}
+func (s *namedState) OnExit()                                            {}
+func (s *namedState) HandleMessage(senderId uint64, message packets.Msg) {}
+
+func TestMenuTimeoutOnlyDropsSilentConnections(t *testing.T) {
+	config := server.DefaultConfig()
+	config.PreGameTimeout = 10 * time.Minute
+	hub, clock := servertest.NewTestHub(config)
+	join := func(stateName string) *servertest.TestClient {
+		client := servertest.NewTestClient(hub)
+		client.SetState(&namedState{name: stateName})
+		return client
+	}
+	silent := join("Connected")
+	chatting := join("Connected")
+	heartbeating := join("Connected")
+	browsing := join("BrowsingHiscores")
+
+	clock.Advance(8 * time.Minute)
+	chatting.ProcessMessage(chatting.Id(), packets.NewChat("anyone here?"))
+	heartbeating.ProcessMessage(heartbeating.Id(), &packets.Packet_Heartbeat{Heartbeat: &packets.HeartbeatMessage{}})
+	clock.Advance(3 * time.Minute)
+	hub.ReapPreGame()
+
+	for _, c := range []struct {
+		name   string
+		client *servertest.TestClient
+		kicked bool
+	}{
+		{"silent", silent, true},
+		{"chatting", chatting, false},
+		{"heartbeating", heartbeating, true},
+		{"browsing the hiscores", browsing, false},
+	} {
+		if kicked := c.client.Kicked() != ""; kicked != c.kicked {
+			t.Errorf("%s client kicked: %v, want %v", c.name, kicked, c.kicked)
+		}
+	}
+}
+
+func TestWorldShrinksInStepsDownToTheMinimum(t *testing.T) {
+	config := server.DefaultConfig()
+	config.WorldBound = 1000
+	config.ShrinkInterval = time.Millisecond
+	config.ShrinkStep = 400
+	config.ShrinkMinBound = 250
+	hub, _ := servertest.NewTestHub(config)
+
+	done := make(chan struct{})
+	go func() {
+		hub.ShrinkWorldLoop()
+		close(done)
+	}()
+
+	var bounds []float64
+	for finished := false; !finished; {
+		select {
+		case packet := <-hub.BroadcastChan:
+			bounds = append(bounds, packet.Msg.(*packets.Packet_WorldBounds).WorldBounds.Bound)
+		case <-done:
+			finished = true
+		case <-time.After(time.Second):
+			t.Fatalf("the world is still shrinking, told clients about %v", bounds)
+		}
+	}
+
+	if len(bounds) != 2 || bounds[0] != 600 || bounds[1] != 250 {
+		t.Errorf("clients were told about the bounds %v, want [600 250]", bounds)
+	}
+	if got := hub.SharedGameObjects.WorldBound.Get(); got != 250 {
+		t.Errorf("world bound is %f, want the minimum 250", got)
+	}
+}
+
+func TestOnlyOldTrailSporesAreReaped(t *testing.T) {
+	config := server.DefaultConfig()
+	config.SporeTrailTTL = time.Minute
+	hub, clock := servertest.NewTestHub(config)
+	start := clock.Now()
+	dropper := &objects.Player{Name: "dropper"}
+
+	spores := hub.SharedGameObjects.Spores
+	old := spores.Add(&objects.Spore{DroppedBy: dropper, DroppedAt: start})
+	fresh := spores.Add(&objects.Spore{DroppedBy: dropper, DroppedAt: start.Add(30 * time.Second)})
+	placed := spores.Add(&objects.Spore{})
+
+	if reaped := hub.ReapTrailSpores(start.Add(time.Minute)); len(reaped) != 0 {
+		t.Errorf("reaped %v right at the TTL", reaped)
+	}
+
+	reaped := hub.ReapTrailSpores(start.Add(61 * time.Second))
+	if len(reaped) != 1 || reaped[0] != old {
+		t.Errorf("reaped %v, want only the old trail spore %d", reaped, old)
+	}
+	for _, id := range []uint64{fresh, placed} {
+		if _, exists := spores.Get(id); !exists {
+			t.Errorf("spore %d was removed", id)
+		}
+	}
+
+	//The server's own spores stay no matter how old they get
+	if reaped := hub.ReapTrailSpores(start.Add(time.Hour)); len(reaped) != 1 || reaped[0] != fresh {
+		t.Errorf("an hour later reaped %v, want only %d", reaped, fresh)
+	}
+}
+
+func TestTheSameMapSeedGivesTheSameSpores(t *testing.T) {
+	layout := func(seed int64) map[uint64]objects.Spore {
+		config := server.DefaultConfig()
+		config.MapSeed = seed
+		hub, _ := servertest.NewTestHub(config)
+		hub.PlaceSpores()
+		spores := make(map[uint64]objects.Spore)
+		hub.SharedGameObjects.Spores.ForEach(func(id uint64, spore *objects.Spore) {
+			spores[id] = *spore
+		})
+		return spores
+	}
+
+	first, second := layout(42), layout(42)
+	if len(first) != server.MaxSpores || len(second) != server.MaxSpores {
+		t.Fatalf("placed %d and %d spores, want %d", len(first), len(second), server.MaxSpores)
+	}
+	for id, spore := range first {
+		if second[id] != spore {
+			t.Fatalf("spore %d is %+v one time and %+v the other", id, spore, second[id])
+		}
+	}
+
+	other := layout(43)
+	same := 0
+	for id, spore := range first {
+		if other[id] == spore {
+			same++
+		}
+	}
+	if same == len(first) {
+		t.Error("a different seed gave the same map")
+	}
+}
@@ -0,0 +1,46 @@
+package server
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+)
+
+// Version of the server, shown in the server info
+const ServerVersion = "1.0.0"
+
+// What a client or a server browser can find out about the server before joining
+type ServerInfo struct {
+	Name          string `json:"name"`
+	Version       string `json:"version"`
+	Clients       int    `json:"clients"`     //everyone connected, in game or not
+	Players       int    `json:"players"`     //just the ones in game
+	MaxPlayers    int    `json:"max_players"` //0 for no limit
+	GameMode      string `json:"game_mode"`
+	UptimeSeconds int64  `json:"uptime_seconds"`
+}
+
+func (h *Hub) Info() ServerInfo {
+	return ServerInfo{
+		Name:          h.Config().ServerName,
+		Version:       ServerVersion,
+		Clients:       h.Clients.Len(),
+		Players:       h.SharedGameObjects.Players.Len(),
+		MaxPlayers:    h.Config().MaxPlayers,
+		GameMode:      h.Config().GameMode,
+		UptimeSeconds: int64(h.Clock.Now().Sub(h.startedAt).Seconds()),
+	}
+}
+
+// Whether there's no room left in the game for another player
+func (h *Hub) Full() bool {
+	return h.Config().MaxPlayers > 0 && h.SharedGameObjects.Players.Len() >= h.Config().MaxPlayers
+}
+
+// Handler for the /info route, the server info as JSON for server browsers
+func (h *Hub) ServeInfo(writer http.ResponseWriter, _ *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(writer).Encode(h.Info()); err != nil {
+		log.Printf("Error writing the server info: %v", err)
+	}
+}
@@ -0,0 +1,45 @@
+package server_test
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"server/internal/server"
+	"server/internal/server/objects"
+	"server/internal/servertest"
+	"testing"
+	"time"
+)
+
+func TestInfoRouteDescribesTheServer(t *testing.T) {
+	config := server.DefaultConfig()
+	config.ServerName = "test server"
+	config.MaxPlayers = 10
+	hub, clock := servertest.NewTestHub(config)
+	servertest.NewTestClient(hub)
+	playing := servertest.NewTestClient(hub)
+	hub.SharedGameObjects.Players.Add(&objects.Player{Name: "playing"}, playing.Id())
+	clock.Advance(90 * time.Second)
+
+	recorder := httptest.NewRecorder()
+	hub.ServeInfo(recorder, httptest.NewRequest("GET", "/info", nil))
+	if contentType := recorder.Header().Get("Content-Type"); contentType != "application/json" {
+		t.Errorf("content type is %q, want JSON", contentType)
+	}
+
+	var info server.ServerInfo
+	if err := json.NewDecoder(recorder.Body).Decode(&info); err != nil {
+		t.Fatalf("decoding the info: %v", err)
+	}
+	want := server.ServerInfo{
+		Name:          "test server",
+		Version:       server.ServerVersion,
+		Clients:       2,
+		Players:       1,
+		MaxPlayers:    10,
+		GameMode:      config.GameMode,
+		UptimeSeconds: 90,
+	}
+	if info != want {
+		t.Errorf("info is %+v, want %+v", info, want)
+	}
+}
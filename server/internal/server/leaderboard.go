@@ -0,0 +1,186 @@
+package server
+
+import (
+	"fmt"
+	"math"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"sync"
+	"time"
+)
+
+// The global leaderboard as it was last sent to everyone, so the next one can be sent as just
+// the changes, and players joining in between can be given the whole thing
+type Leaderboard struct {
+	mu      sync.RWMutex
+	entries []objects.LeaderboardEntry
+}
+
+func NewLeaderboard() *Leaderboard {
+	return &Leaderboard{}
+}
+
+func (l *Leaderboard) Entries() []objects.LeaderboardEntry {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.entries
+}
+
+func (l *Leaderboard) set(entries []objects.LeaderboardEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = entries
+}
+
+// Sends the live leaderboard of players in game every interval
+// With the global scope everyone gets the same leaderboard, built once and broadcast. After the
+// first one only the changes get sent, with the whole leaderboard again every LeaderboardFullEvery
+// sends in case a client missed something
+// With the region scope each player gets a leaderboard of just the players around them
+// Either way every player is also told where they are in the whole ranking, since most aren't on it
+func (h *Hub) leaderboardLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	sends := 0
+	growth := &growthWatch{
+		lastMass: make(map[uint64]float64),
+		flagged:  make(map[uint64]bool),
+	}
+	for range ticker.C {
+		h.checkGrowth(growth, interval)
+		eligible := func(id uint64, player *objects.Player) bool {
+			return !growth.flagged[id] && math.Pi*player.Radius*player.Radius >= h.Config().LeaderboardMinMass
+		}
+
+		//Everyone gets ranked once, the global leaderboard and everyone's own rank come out of it
+		ranked := objects.RankPlayers(h.SharedGameObjects.Players, nil)
+
+		switch h.Config().LeaderboardScope {
+		case LeaderboardRegion:
+			h.sendRegionLeaderboards(eligible)
+		default:
+			full := h.Config().LeaderboardFullEvery <= 1 || sends%h.Config().LeaderboardFullEvery == 0
+			h.sendGlobalLeaderboard(eligibleEntries(ranked, growth, h.Config().LeaderboardMinMass), full)
+			sends++
+		}
+
+		//Players left off the leaderboards still get told where they'd be
+		h.sendPlayerRanks(ranked)
+	}
+}
+
+// The mass every player had the last time the leaderboard was sent, and who's been caught growing
+// faster than they could. Only the leaderboard loop touches it
+type growthWatch struct {
+	lastMass map[uint64]float64
+	flagged  map[uint64]bool
+}
+
+// Flags and reports anyone whose mass went up faster than LeaderboardMaxGrowth since last time
+// Players that aren't in game anymore are forgotten, so the flag only lasts a life
+func (h *Hub) checkGrowth(growth *growthWatch, elapsed time.Duration) {
+	maxGrowth := h.Config().LeaderboardMaxGrowth
+	seen := make(map[uint64]bool, len(growth.lastMass))
+
+	h.SharedGameObjects.Players.ForEach(func(id uint64, player *objects.Player) {
+		seen[id] = true
+		mass := math.Pi * player.Radius * player.Radius
+		last, known := growth.lastMass[id]
+		growth.lastMass[id] = mass
+		if !known || maxGrowth <= 0 || growth.flagged[id] {
+			return
+		}
+
+		if rate := (mass - last) / elapsed.Seconds(); rate > maxGrowth {
+			growth.flagged[id] = true
+			h.AntiCheat.Report(id, SuspicionFastGrowth, fmt.Sprintf("grew %.0f mass per second (max %.0f), left off the leaderboard", rate, maxGrowth))
+		}
+	})
+
+	for id := range growth.lastMass {
+		if !seen[id] {
+			delete(growth.lastMass, id)
+			delete(growth.flagged, id)
+		}
+	}
+}
+
+// The ranked entries that can be on the leaderboard, still in order
+func eligibleEntries(ranked []objects.LeaderboardEntry, growth *growthWatch, minMass float64) []objects.LeaderboardEntry {
+	eligible := make([]objects.LeaderboardEntry, 0, len(ranked))
+	for _, entry := range ranked {
+		if !growth.flagged[entry.Id] && entry.Mass >= minMass {
+			eligible = append(eligible, entry)
+		}
+	}
+	return eligible
+}
+
+// Tells every player in game their own rank out of everyone, and their mass
+func (h *Hub) sendPlayerRanks(ranked []objects.LeaderboardEntry) {
+	ranks := make(map[uint64]int, len(ranked))
+	for i, entry := range ranked {
+		ranks[entry.Id] = i
+	}
+
+	h.Clients.ForEach(func(clientId uint64, client ClientInterfacer) {
+		i, inGame := ranks[clientId]
+		if !inGame {
+			return
+		}
+		client.SocketSend(packets.NewMyRank(uint32(i+1), uint32(len(ranked)), ranked[i].Mass))
+	})
+}
+
+func (h *Hub) sendGlobalLeaderboard(ranked []objects.LeaderboardEntry, full bool) {
+	entries := topEntries(ranked, h.Config().LeaderboardSize)
+	previous := h.Leaderboard.Entries()
+	h.Leaderboard.set(entries)
+
+	if full {
+		h.BroadcastChan <- &packets.Packet{
+			SenderId: 0,
+			Msg:      packets.NewLeaderboard(entries),
+		}
+		return
+	}
+
+	changed, removedIds := objects.DiffLeaderboards(previous, entries)
+	if len(changed) == 0 && len(removedIds) == 0 {
+		return
+	}
+	h.BroadcastChan <- &packets.Packet{
+		SenderId: 0,
+		Msg:      packets.NewLeaderboardDelta(changed, removedIds),
+	}
+}
+
+func (h *Hub) sendRegionLeaderboards(eligible func(id uint64, player *objects.Player) bool) {
+	radiusSq := h.Config().LeaderboardRegionRadius * h.Config().LeaderboardRegionRadius
+
+	h.Clients.ForEach(func(clientId uint64, client ClientInterfacer) {
+		//Only players in game get a leaderboard
+		player, inGame := h.SharedGameObjects.Players.Get(clientId)
+		if !inGame {
+			return
+		}
+
+		entries := objects.RankPlayers(h.SharedGameObjects.Players, func(otherId uint64, other *objects.Player) bool {
+			if !eligible(otherId, other) {
+				return false
+			}
+			dx := other.X - player.X
+			dy := other.Y - player.Y
+			return dx*dx+dy*dy <= radiusSq
+		})
+		client.SocketSend(packets.NewLeaderboard(topEntries(entries, h.Config().LeaderboardSize)))
+	})
+}
+
+func topEntries(entries []objects.LeaderboardEntry, n int) []objects.LeaderboardEntry {
+	if len(entries) > n {
+		return entries[:n]
+	}
+	return entries
+}
@@ -0,0 +1,167 @@
+package server_test
+
+import (
+	"math"
+	"server/internal/server"
+	"server/internal/server/objects"
+	"server/internal/servertest"
+	"server/pkg/packets"
+	"testing"
+	"time"
+)
+
+// Registers a client with its player in the game at x, without any state
+func playerAt(hub *server.Hub, name string, x float64) *servertest.TestClient {
+	client := servertest.NewTestClient(hub)
+	hub.SharedGameObjects.Players.Add(&objects.Player{Name: name, X: x, Radius: 20}, client.Id())
+	return client
+}
+
+func TestRegionLeaderboardOnlyRanksPlayersAround(t *testing.T) {
+	config := server.DefaultConfig()
+	config.LeaderboardScope = server.LeaderboardRegion
+	config.LeaderboardRegionRadius = 1600
+	hub, _ := servertest.NewTestHub(config)
+	west := playerAt(hub, "west", -1500)
+	middle := playerAt(hub, "middle", 0)
+	east := playerAt(hub, "east", 1500)
+	//Connected but not in game, nothing to rank around
+	menu := servertest.NewTestClient(hub)
+
+	hub.SendRegionLeaderboards()
+
+	want := map[*servertest.TestClient][]string{
+		west:   {"west", "middle"},
+		middle: {"west", "middle", "east"},
+		east:   {"middle", "east"},
+	}
+	for client, names := range want {
+		boards := servertest.MessagesOf[*packets.Packet_Leaderboard](client.SentMessages())
+		if len(boards) != 1 {
+			t.Fatalf("client %d got %d leaderboards, want 1", client.Id(), len(boards))
+		}
+		got := map[string]bool{}
+		for _, entry := range boards[0].Leaderboard.Entries {
+			got[entry.Name] = true
+		}
+		if len(got) != len(names) {
+			t.Errorf("client %d was shown %v, want %v", client.Id(), got, names)
+		}
+		for _, name := range names {
+			if !got[name] {
+				t.Errorf("client %d wasn't shown %s", client.Id(), name)
+			}
+		}
+	}
+	if len(menu.Sent()) != 0 {
+		t.Error("a client in the menu got a leaderboard")
+	}
+}
+
+func TestGlobalLeaderboardSendsChangesBetweenFullOnes(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	playerAt(hub, "first", 0)
+	second := &objects.Player{Name: "second", Radius: 10}
+	hub.SharedGameObjects.Players.Add(second, 1000)
+	//What gets broadcast for one send, nil if nothing was
+	send := func(full bool) packets.Msg {
+		done := make(chan struct{})
+		go func() {
+			hub.SendGlobalLeaderboard(full)
+			close(done)
+		}()
+		select {
+		case packet := <-hub.BroadcastChan:
+			<-done
+			return packet.Msg
+		case <-done:
+			return nil
+		}
+	}
+
+	if _, isFull := send(true).(*packets.Packet_Leaderboard); !isFull {
+		t.Fatal("the first leaderboard wasn't a full one")
+	}
+	if message := send(false); message != nil {
+		t.Errorf("sent %v when nothing changed", message)
+	}
+
+	second.Radius = 50
+	delta, isDelta := send(false).(*packets.Packet_LeaderboardDelta)
+	if !isDelta {
+		t.Fatal("no delta was sent after the ranks swapped")
+	}
+	if changed := delta.LeaderboardDelta.Changed; len(changed) != 2 || changed[0].Name != "second" || changed[0].Rank != 1 {
+		t.Errorf("delta is %v, want both players with second on top", changed)
+	}
+	if entries := hub.Leaderboard.Entries(); entries[0].Name != "second" {
+		t.Error("the leaderboard kept for new players wasn't updated")
+	}
+}
+
+func TestEveryPlayerIsToldTheirOwnRank(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	small := playerAt(hub, "small", 0)
+	big := playerAt(hub, "big", 0)
+	player, _ := hub.SharedGameObjects.Players.Get(big.Id())
+	player.Radius = 40
+	menu := servertest.NewTestClient(hub)
+
+	hub.SendPlayerRanks()
+
+	for client, want := range map[*servertest.TestClient]uint32{big: 1, small: 2} {
+		ranks := servertest.MessagesOf[*packets.Packet_MyRank](client.SentMessages())
+		if len(ranks) != 1 {
+			t.Fatalf("client %d got %d ranks, want 1", client.Id(), len(ranks))
+		}
+		if ranks[0].MyRank.Rank != want || ranks[0].MyRank.TotalPlayers != 2 {
+			t.Errorf("client %d is %d of %d, want %d of 2", client.Id(), ranks[0].MyRank.Rank, ranks[0].MyRank.TotalPlayers, want)
+		}
+	}
+	if len(menu.Sent()) != 0 {
+		t.Error("a client that isn't playing got a rank")
+	}
+}
+
+func TestLeaderboardLeavesOutTheSmallAndTheSuspiciouslyFast(t *testing.T) {
+	config := server.DefaultConfig()
+	config.LeaderboardMinMass = math.Pi * 15 * 15
+	config.LeaderboardMaxGrowth = 1000
+	hub, clock := servertest.NewTestHub(config)
+	sink := &recordingSink{}
+	hub.AntiCheat = server.NewAntiCheat(sink, 0, clock)
+
+	tiny := playerAt(hub, "tiny", 0)
+	steady := playerAt(hub, "steady", 0)
+	cheater := playerAt(hub, "cheater", 0)
+	player := func(client *servertest.TestClient) *objects.Player {
+		player, _ := hub.SharedGameObjects.Players.Get(client.Id())
+		return player
+	}
+	player(tiny).Radius = 10
+
+	growth := server.NewGrowthWatch()
+	hub.LeaderboardEligible(growth, time.Second)
+
+	//Growing a bit is fine, going from 20 to 100 radius in a second isn't
+	player(steady).Radius = 21
+	player(cheater).Radius = 100
+	names := []string{}
+	for _, entry := range hub.LeaderboardEligible(growth, time.Second) {
+		names = append(names, entry.Name)
+	}
+	if len(names) != 1 || names[0] != "steady" {
+		t.Errorf("leaderboard has %v, want only steady", names)
+	}
+	if len(sink.events) != 1 || sink.events[0].ClientId != cheater.Id() || sink.events[0].Type != server.SuspicionFastGrowth {
+		t.Errorf("anti cheat got %v, want the cheater's growth", sink.events)
+	}
+
+	//Still left off while they stay this size, without being reported again
+	if entries := hub.LeaderboardEligible(growth, time.Second); len(entries) != 1 {
+		t.Errorf("leaderboard has %d players the next time, want the cheater still left off", len(entries))
+	}
+	if len(sink.events) != 1 {
+		t.Errorf("the cheater was reported %d times, want once", len(sink.events))
+	}
+}
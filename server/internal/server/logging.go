@@ -0,0 +1,111 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// Builds the writer every logger in the server writes to, based on the config
+// It's stdout, a rotating file, or both
+func NewLogWriter(config *Config) (io.Writer, error) {
+	if config.LogFile == "" {
+		return os.Stdout, nil
+	}
+
+	file, err := NewRotatingFile(config.LogFile, config.LogMaxSize, config.LogMaxBackups)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.LogToStdout {
+		return io.MultiWriter(os.Stdout, file), nil
+	}
+	return file, nil
+}
+
+// A log file that gets rotated once it grows past maxSize bytes
+// The full file is renamed to path.1 (and path.1 to path.2 etc, keeping maxBackups of them)
+// and a fresh file is started at path
+type RotatingFile struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	file *os.File
+	size int64
+	mux  sync.Mutex //loggers from every client write to the same file
+}
+
+func NewRotatingFile(path string, maxSize int64, maxBackups int) (*RotatingFile, error) {
+	r := &RotatingFile{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *RotatingFile) Write(data []byte) (int, error) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if r.maxSize > 0 && r.size+int64(len(data)) > r.maxSize && r.size > 0 {
+		if err := r.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := r.file.Write(data)
+	r.size += int64(n)
+	return n, err
+}
+
+func (r *RotatingFile) Close() error {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	return r.file.Close()
+}
+
+// Opens (or creates) the log file, picking up its current size so we know when to rotate
+func (r *RotatingFile) open() error {
+	file, err := os.OpenFile(r.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("opening log file: %w", err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("getting log file size: %w", err)
+	}
+
+	r.file = file
+	r.size = info.Size()
+	return nil
+}
+
+func (r *RotatingFile) rotate() error {
+	if err := r.file.Close(); err != nil {
+		return err
+	}
+
+	//Shifting the backups up by one, the oldest one gets overwritten
+	for i := r.maxBackups - 1; i >= 1; i-- {
+		os.Rename(fmt.Sprintf("%s.%d", r.path, i), fmt.Sprintf("%s.%d", r.path, i+1))
+	}
+	if r.maxBackups > 0 {
+		if err := os.Rename(r.path, r.path+".1"); err != nil {
+			return fmt.Errorf("rotating log file: %w", err)
+		}
+	} else if err := os.Remove(r.path); err != nil {
+		return fmt.Errorf("rotating log file: %w", err)
+	}
+
+	return r.open()
+}
@@ -0,0 +1,74 @@
+package server_test
+
+import (
+	"os"
+	"path/filepath"
+	"server/internal/server"
+	"testing"
+)
+
+// The contents of the file, or "" if it isn't there
+func readLog(t *testing.T, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return ""
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestRotatingFileKeepsItsBackups(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "server.log")
+	file, err := server.NewRotatingFile(path, 10, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	for _, line := range []string{"line one\n", "line two\n", "line 3\n", "line 4\n"} {
+		if _, err := file.Write([]byte(line)); err != nil {
+			t.Fatalf("writing %q: %v", line, err)
+		}
+	}
+
+	//Every line goes over the 10 bytes with the one before it, the first one is the oldest and gone
+	want := map[string]string{
+		path:        "line 4\n",
+		path + ".1": "line 3\n",
+		path + ".2": "line two\n",
+		path + ".3": "",
+	}
+	for file, contents := range want {
+		if got := readLog(t, file); got != contents {
+			t.Errorf("%s has %q, want %q", filepath.Base(file), got, contents)
+		}
+	}
+}
+
+func TestRotatingFileCarriesOnFromTheExistingSize(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "server.log")
+	if err := os.WriteFile(path, []byte("from before\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	//No backups, the full file is just started over
+	file, err := server.NewRotatingFile(path, 20, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	file.Write([]byte("new line\n"))
+	if got := readLog(t, path); got != "new line\n" {
+		t.Errorf("log has %q, the file from before should have counted towards the size", got)
+	}
+}
+
+func TestLogWriterIsStdoutWithoutAFile(t *testing.T) {
+	writer, err := server.NewLogWriter(server.DefaultConfig())
+	if err != nil || writer != os.Stdout {
+		t.Errorf("got %v, %v, want stdout", writer, err)
+	}
+}
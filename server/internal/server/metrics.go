@@ -0,0 +1,37 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"server/internal/server/objects"
+)
+
+// Handler for the /metrics route, writes some basic stats about the server in plain text
+// so they can be checked with curl or scraped by something else
+func (h *Hub) ServeMetrics(writer http.ResponseWriter, _ *http.Request) {
+	writer.Header().Set("Content-Type", "text/plain; charset=utf-8")
+
+	var totalBytes uint64
+	h.Clients.ForEach(func(clientId uint64, client ClientInterfacer) {
+		bytesSent := client.BytesSent()
+		totalBytes += bytesSent
+		fmt.Fprintf(writer, "client_bytes_sent{client=\"%d\",version=%q} %d\n", clientId, client.ClientVersion(), bytesSent)
+		fmt.Fprintf(writer, "client_packets_dropped{client=\"%d\"} %d\n", clientId, client.DroppedPackets())
+	})
+
+	fmt.Fprintf(writer, "clients_connected %d\n", h.Clients.Len())
+	fmt.Fprintf(writer, "bytes_sent_total %d\n", totalBytes)
+	fmt.Fprintf(writer, "players_joined_total %d\n", h.Counters.PlayersJoined.Load())
+	fmt.Fprintf(writer, "players_eaten_total %d\n", h.Counters.PlayersEaten.Load())
+	fmt.Fprintf(writer, "spores_eaten_total %d\n", h.Counters.SporesEaten.Load())
+	fmt.Fprintf(writer, "broadcasts_dropped_total %d\n", h.Counters.BroadcastsDropped.Load())
+	fmt.Fprintf(writer, "panics_recovered_total %d\n", h.Counters.PanicsRecovered.Load())
+	h.MessageCounts.writeMetrics(writer)
+	fmt.Fprintf(writer, "spawns_total %d\n", objects.SpawnStats.Spawns.Load())
+	fmt.Fprintf(writer, "spawn_attempts_total %d\n", objects.SpawnStats.Attempts.Load())
+	fmt.Fprintf(writer, "spawn_bound_doublings_total %d\n", objects.SpawnStats.BoundDoublings.Load())
+	fmt.Fprintf(writer, "spore_value_multiplier %f\n", h.SporeValue.Multiplier())
+	if h.WriteQueue != nil {
+		fmt.Fprintf(writer, "write_queue_pending %d\n", h.WriteQueue.Pending())
+	}
+}
@@ -0,0 +1,112 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"server/pkg/packets"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Handles one message for a client, it's what the middleware chain wraps
+type MessageHandler func(senderId uint64, message packets.Msg)
+
+// Something that runs around every message a client handles (counting, limiting, logging...)
+// It gets the client the messages are for and the next handler in the chain, and can call it or not
+// Anything it keeps per client goes in the closure it returns, since it's called once per client
+type MessageMiddleware func(client ClientInterfacer, next MessageHandler) MessageHandler
+
+// Wraps the handler in the middleware, the first one in the list sees each message first
+func ChainMiddleware(client ClientInterfacer, handler MessageHandler, middleware ...MessageMiddleware) MessageHandler {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		handler = middleware[i](client, handler)
+	}
+	return handler
+}
+
+// The name of a message's type without the package and Packet_ prefix, like "Chat" or "Eject"
+func MessageType(message packets.Msg) string {
+	return strings.TrimPrefix(fmt.Sprintf("%T", message), "*packets.Packet_")
+}
+
+// How many of each type of message clients sent, and how many of those the rate limits dropped
+type MessageCounts struct {
+	mu      sync.Mutex
+	handled map[string]uint64
+	limited map[string]uint64
+}
+
+func NewMessageCounts() *MessageCounts {
+	return &MessageCounts{
+		handled: make(map[string]uint64),
+		limited: make(map[string]uint64),
+	}
+}
+
+func (m *MessageCounts) add(counts map[string]uint64, messageType string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	counts[messageType]++
+}
+
+// Writes the counts in the metrics format, sorted so the output doesn't jump around
+func (m *MessageCounts) writeMetrics(writer io.Writer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, metric := range []struct {
+		name   string
+		counts map[string]uint64
+	}{{"messages_received_total", m.handled}, {"messages_rate_limited_total", m.limited}} {
+		types := make([]string, 0, len(metric.counts))
+		for messageType := range metric.counts {
+			types = append(types, messageType)
+		}
+		sort.Strings(types)
+		for _, messageType := range types {
+			fmt.Fprintf(writer, "%s{type=%q} %d\n", metric.name, messageType, metric.counts[messageType])
+		}
+	}
+}
+
+// Counts every message a client sends itself, broadcasts from other clients aren't counted again
+func CountMessages(counts *MessageCounts) MessageMiddleware {
+	return func(client ClientInterfacer, next MessageHandler) MessageHandler {
+		return func(senderId uint64, message packets.Msg) {
+			if senderId == client.Id() {
+				counts.add(counts.handled, MessageType(message))
+			}
+			next(senderId, message)
+		}
+	}
+}
+
+// How many messages of a type a client can send in a burst, and how many a second after that
+type MessageRateLimit struct {
+	Burst     float64
+	PerSecond float64
+}
+
+// Drops messages a client sends itself once it goes over the limit for their type (keyed like
+// MessageType), every client gets its own limits. Types without a limit always go through
+func RateLimitMessages(limits map[string]MessageRateLimit, counts *MessageCounts) MessageMiddleware {
+	return func(client ClientInterfacer, next MessageHandler) MessageHandler {
+		//Only the read pump handles the client's own messages, so these don't need a lock
+		limiters := make(map[string]*RateLimiter, len(limits))
+		for messageType, limit := range limits {
+			limiters[messageType] = NewRateLimiter(limit.Burst, limit.PerSecond, client.Clock())
+		}
+
+		return func(senderId uint64, message packets.Msg) {
+			if senderId == client.Id() {
+				messageType := MessageType(message)
+				if limiter, limited := limiters[messageType]; limited && !limiter.Allow() {
+					counts.add(counts.limited, messageType)
+					return
+				}
+			}
+			next(senderId, message)
+		}
+	}
+}
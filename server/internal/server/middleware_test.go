@@ -0,0 +1,74 @@
+package server_test
+
+import (
+	"net/http/httptest"
+	"server/internal/server"
+	"server/internal/servertest"
+	"server/pkg/packets"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMiddlewareRunsInTheOrderItsGiven(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	client := servertest.NewTestClient(hub)
+	var order []string
+	named := func(name string) server.MessageMiddleware {
+		return func(_ server.ClientInterfacer, next server.MessageHandler) server.MessageHandler {
+			return func(senderId uint64, message packets.Msg) {
+				order = append(order, name)
+				next(senderId, message)
+			}
+		}
+	}
+
+	handler := server.ChainMiddleware(client, func(uint64, packets.Msg) {
+		order = append(order, "state")
+	}, named("first"), named("second"))
+	handler(client.Id(), &packets.Packet_Eject{})
+
+	if strings.Join(order, " ") != "first second state" {
+		t.Errorf("ran %v, want first, second then the state", order)
+	}
+}
+
+func TestMessagesOverTheirTypesLimitAreDroppedAndCounted(t *testing.T) {
+	hub, clock := servertest.NewTestHub(server.DefaultConfig())
+	client := servertest.NewTestClient(hub)
+	counts := server.NewMessageCounts()
+	handled := map[string]int{}
+	handler := server.ChainMiddleware(client, func(_ uint64, message packets.Msg) {
+		handled[server.MessageType(message)]++
+	}, server.CountMessages(counts), server.RateLimitMessages(map[string]server.MessageRateLimit{
+		"Eject": {Burst: 2, PerSecond: 1},
+	}, counts))
+
+	for range 3 {
+		handler(client.Id(), &packets.Packet_Eject{})
+		handler(client.Id(), &packets.Packet_Chat{})
+	}
+	//Other clients' messages coming through the hub aren't ours to limit
+	handler(client.Id()+1, &packets.Packet_Eject{})
+	if handled["Eject"] != 3 || handled["Chat"] != 3 {
+		t.Errorf("handled %v, want 2 of our ejects, the other client's and all the chats", handled)
+	}
+	clock.Advance(time.Second)
+	handler(client.Id(), &packets.Packet_Eject{})
+	if handled["Eject"] != 4 {
+		t.Error("an eject a second later was still dropped")
+	}
+
+	hub.MessageCounts = counts
+	recorder := httptest.NewRecorder()
+	hub.ServeMetrics(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	for _, line := range []string{
+		`messages_received_total{type="Eject"} 4`,
+		`messages_received_total{type="Chat"} 3`,
+		`messages_rate_limited_total{type="Eject"} 1`,
+	} {
+		if !strings.Contains(recorder.Body.String(), line+"\n") {
+			t.Errorf("metrics are missing %s", line)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"math"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"time"
+)
+
+// Broadcasts a rough overview of the whole map every interval: where the biggest players are
+// and how many spores are in each area. Positions are only given as grid cells on purpose,
+// so the minimap can't be used as a precise radar
+func (h *Hub) minimapLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		h.BroadcastChan <- &packets.Packet{
+			SenderId: 0,
+			Msg:      h.buildMinimap(),
+		}
+	}
+}
+
+func (h *Hub) buildMinimap() packets.Msg {
+	gridSize := h.Config().MinimapGridSize
+	bound := h.SharedGameObjects.WorldBound.Get()
+
+	ranked := topEntries(objects.RankPlayers(h.SharedGameObjects.Players, nil), h.Config().MinimapPlayers)
+	rankedIds := make([]uint64, len(ranked))
+	for i, entry := range ranked {
+		rankedIds[i] = entry.Id
+	}
+	rankedPlayers := h.SharedGameObjects.Players.GetMany(rankedIds)
+
+	players := make([]packets.MinimapPlayer, 0, len(ranked))
+	for _, entry := range ranked {
+		player, exists := rankedPlayers[entry.Id]
+		if !exists {
+			continue
+		}
+		cellX, cellY := minimapCell(player.X, player.Y, bound, gridSize)
+		players = append(players, packets.MinimapPlayer{Id: entry.Id, CellX: cellX, CellY: cellY, Mass: entry.Mass})
+	}
+
+	sporeDensity := make([]uint32, gridSize*gridSize)
+	h.SharedGameObjects.Spores.ForEach(func(_ uint64, spore *objects.Spore) {
+		cellX, cellY := minimapCell(spore.X, spore.Y, bound, gridSize)
+		sporeDensity[cellY*gridSize+cellX]++
+	})
+
+	return packets.NewMinimap(uint32(gridSize), players, sporeDensity)
+}
+
+// Turns world coords into the cell of a gridSize x gridSize grid covering the world
+// Anything outside the bound goes into the closest edge cell
+func minimapCell(x, y, bound float64, gridSize int) (int, int) {
+	toCell := func(coord float64) int {
+		cell := int(math.Floor((coord + bound) / (2 * bound) * float64(gridSize)))
+		return max(0, min(cell, gridSize-1))
+	}
+	return toCell(x), toCell(y)
+}
@@ -0,0 +1,50 @@
+package server_test
+
+import (
+	"server/internal/server"
+	"server/internal/server/objects"
+	"server/internal/servertest"
+	"server/pkg/packets"
+	"testing"
+)
+
+func TestMinimapOnlyGivesCells(t *testing.T) {
+	config := server.DefaultConfig()
+	config.WorldBound = 1000
+	config.MinimapGridSize = 4
+	config.MinimapPlayers = 2
+	hub, _ := servertest.NewTestHub(config)
+
+	players := hub.SharedGameObjects.Players
+	players.Add(&objects.Player{Name: "top left", X: -900, Y: -900, Radius: 60}, 1)
+	players.Add(&objects.Player{Name: "bottom right", X: 900, Y: 600, Radius: 50}, 2)
+	players.Add(&objects.Player{Name: "too small to show", X: 0, Y: 0, Radius: 10}, 3)
+	spores := hub.SharedGameObjects.Spores
+	spores.Add(&objects.Spore{X: 10, Y: 10})
+	spores.Add(&objects.Spore{X: 20, Y: 499})
+	//Past the edge, counted in the closest cell
+	spores.Add(&objects.Spore{X: -5000, Y: 5000})
+
+	minimap := hub.BuildMinimap().(*packets.Packet_Minimap).Minimap
+
+	if len(minimap.Players) != 2 {
+		t.Fatalf("minimap shows %d players, want the top 2", len(minimap.Players))
+	}
+	cells := map[uint64][2]uint32{}
+	for _, player := range minimap.Players {
+		cells[player.Id] = [2]uint32{player.CellX, player.CellY}
+	}
+	if cells[1] != [2]uint32{0, 0} || cells[2] != [2]uint32{3, 3} {
+		t.Errorf("players are in the cells %v, want 1 in (0, 0) and 2 in (3, 3)", cells)
+	}
+
+	want := make([]uint32, 16)
+	want[2*4+2] = 2 //both spores just past the center
+	want[3*4+0] = 1 //bottom left corner
+	for i := range want {
+		if minimap.SporeDensity[i] != want[i] {
+			t.Errorf("spore density is %v, want %v", minimap.SporeDensity, want)
+			break
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package objects
+
+import (
+	"sync"
+	"time"
+)
+
+// A chat message as it's kept in the history
+type ChatEntry struct {
+	SenderId   uint64
+	SenderName string
+	Message    string
+	SentAt     time.Time
+}
+
+// Keeps the last few chat messages so players joining mid game can catch up
+// It's a ring buffer, once it's full every new message replaces the oldest one
+type ChatHistory struct {
+	entries []ChatEntry
+	next    int  //where the next message goes
+	full    bool //whether next has wrapped around at least once
+	mux     sync.Mutex
+}
+
+func NewChatHistory(size int) *ChatHistory {
+	return &ChatHistory{
+		entries: make([]ChatEntry, max(size, 0)),
+	}
+}
+
+func (c *ChatHistory) Add(entry ChatEntry) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if len(c.entries) == 0 {
+		return
+	}
+
+	c.entries[c.next] = entry
+	c.next = (c.next + 1) % len(c.entries)
+	if c.next == 0 {
+		c.full = true
+	}
+}
+
+// Returns a copy of the messages in the history, oldest first
+func (c *ChatHistory) Snapshot() []ChatEntry {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if !c.full {
+		return append([]ChatEntry(nil), c.entries[:c.next]...)
+	}
+
+	snapshot := make([]ChatEntry, 0, len(c.entries))
+	snapshot = append(snapshot, c.entries[c.next:]...)
+	return append(snapshot, c.entries[:c.next]...)
+}
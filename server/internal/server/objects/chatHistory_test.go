@@ -0,0 +1,45 @@
+package objects
+
+import "testing"
+
+func messagesIn(history *ChatHistory) []string {
+	messages := []string{}
+	for _, entry := range history.Snapshot() {
+		messages = append(messages, entry.Message)
+	}
+	return messages
+}
+
+func TestChatHistoryKeepsTheLatestOldestFirst(t *testing.T) {
+	history := NewChatHistory(3)
+	for _, message := range []string{"a", "b"} {
+		history.Add(ChatEntry{Message: message})
+	}
+	if got := messagesIn(history); len(got) != 2 || got[0] != "a" || got[1] != "b" {
+		t.Errorf("history before filling up is %v, want [a b]", got)
+	}
+
+	//Two more wrap around and push out the oldest one
+	for _, message := range []string{"c", "d"} {
+		history.Add(ChatEntry{Message: message})
+	}
+	want := []string{"b", "c", "d"}
+	got := messagesIn(history)
+	if len(got) != len(want) {
+		t.Fatalf("history is %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("history is %v, want %v", got, want)
+			break
+		}
+	}
+}
+
+func TestChatHistoryOfSizeZeroKeepsNothing(t *testing.T) {
+	history := NewChatHistory(0)
+	history.Add(ChatEntry{Message: "hello"})
+	if got := messagesIn(history); len(got) != 0 {
+		t.Errorf("history is %v, want it empty", got)
+	}
+}
@@ -1,6 +1,9 @@
 package objects
 
-import "time"
+import (
+	"sync/atomic"
+	"time"
+)
 
 type Player struct {
 	Name      string
@@ -12,6 +15,72 @@ type Player struct {
 	BestScore int64
 	DbId      int64
 	Color     int32
+	SkinId    uint32
+
+	TargetDirection float64 //the direction the client asked for, Direction turns towards it when turning is rate limited
+	BestScoreSeason uint64  //the season BestScore was loaded in, see SharedGameObjects.BestScoreSeason
+	Settings        PlayerSettings
+	Achievements    map[string]bool //ids of the achievements unlocked, only the achievement tracker touches it once in game
+	Session         SessionStats    //every life since the player came in from the menu
+	Frozen          atomic.Bool     //set by an admin, the player stays put (but can still be eaten) until it's cleared
+	Mutes           MuteList        //players whose chat this player doesn't get, until it goes back to the menu
+
+	//Set by an admin, the player jumps there on its next tick. It's left to the player's own
+	//client to move it so the jump can't get lost in the middle of a normal move
+	PendingTeleport atomic.Pointer[Position]
+}
+
+type Position struct {
+	X float64
+	Y float64
+}
+
+// The radius the player collides with, the Radius sent to clients is only for drawing it
+func (p *Player) CollisionRadius(scale float64) float64 {
+	return p.Radius * scale
+}
+
+// Totals over a session (all the lives a player plays before going back to the menu), for the
+// summary the player gets after each life
+type SessionStats struct {
+	StartedAt    time.Time //when the player came in from the menu
+	SporesEaten  int
+	PlayersEaten int
+	TimesEaten   int
+	MaxMass      float64
+	Distance     float64
+}
+
+// Preferences the player picked, saved in the DB for registered players
+type PlayerSettings struct {
+	ChatEnabled    bool
+	MinimapEnabled bool
+	AutoCollect    bool //accessibility option, the client collects spores it's touching on its own
+	SelfEcho       SelfEcho
+	HapticsEnabled bool //only does anything on clients that can vibrate
+}
+
+// When players get sent their own position updates, same values as the SelfEcho enum in the packets
+type SelfEcho int32
+
+const (
+	SelfEchoAlways SelfEcho = iota
+	SelfEchoOff
+	SelfEchoOnDivergence //only when the server's position is too far from the one the client reported
+)
+
+func ValidSelfEcho(selfEcho SelfEcho) bool {
+	return selfEcho >= SelfEchoAlways && selfEcho <= SelfEchoOnDivergence
+}
+
+// What players get before they change anything
+func DefaultPlayerSettings() PlayerSettings {
+	return PlayerSettings{
+		ChatEnabled:    true,
+		MinimapEnabled: true,
+		AutoCollect:    false,
+		HapticsEnabled: true,
+	}
 }
 
 type Spore struct {
@@ -20,4 +89,27 @@ type Spore struct {
 	Radius    float64
 	DroppedBy *Player
 	DroppedAt time.Time
+
+	//Velocity, most spores sit still (0, 0) and only things like ejected mass move
+	VX float64
+	VY float64
+}
+
+// Whether the spore has any velocity at all
+func (s *Spore) Moving() bool {
+	return s.VX != 0 || s.VY != 0
+}
+
+// Moves the spore by its velocity over delta seconds, then slows it down by the slowdown factor
+// Once it's slower than stopSpeed it stops completely. Returns whether it's still moving
+func (s *Spore) Step(delta, slowdown, stopSpeed float64) bool {
+	s.X += s.VX * delta
+	s.Y += s.VY * delta
+	s.VX *= slowdown
+	s.VY *= slowdown
+
+	if s.VX*s.VX+s.VY*s.VY < stopSpeed*stopSpeed {
+		s.VX, s.VY = 0, 0
+	}
+	return s.Moving()
 }
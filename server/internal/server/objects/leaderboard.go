@@ -0,0 +1,73 @@
+package objects
+
+import (
+	"math"
+	"sort"
+)
+
+// A player's spot on the live leaderboard
+type LeaderboardEntry struct {
+	Id   uint64
+	Name string
+	Mass float64
+}
+
+// Ranks the players in the given collection by mass, biggest first
+// include can be used to leave players out (nil includes everyone)
+func RankPlayers(players *SharedCollection[*Player], include func(id uint64, player *Player) bool) []LeaderboardEntry {
+	entries := make([]LeaderboardEntry, 0, players.Len())
+	players.ForEach(func(id uint64, player *Player) {
+		if include != nil && !include(id, player) {
+			return
+		}
+		entries = append(entries, LeaderboardEntry{
+			Id:   id,
+			Name: player.Name,
+			Mass: math.Pi * player.Radius * player.Radius,
+		})
+	})
+
+	//Sorting by id too when the mass is the same, so the order doesn't jump around
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Mass != entries[j].Mass {
+			return entries[i].Mass > entries[j].Mass
+		}
+		return entries[i].Id < entries[j].Id
+	})
+
+	return entries
+}
+
+// An entry along with where it is on the leaderboard (starting at 1)
+type RankedEntry struct {
+	LeaderboardEntry
+	Rank uint32
+}
+
+// Compares two leaderboards, giving back the entries that are new or whose rank or (rounded) mass
+// changed, and the ids of the players that aren't on the current one anymore
+func DiffLeaderboards(previous, current []LeaderboardEntry) ([]RankedEntry, []uint64) {
+	previousRanks := make(map[uint64]RankedEntry, len(previous))
+	for i, entry := range previous {
+		previousRanks[entry.Id] = RankedEntry{entry, uint32(i + 1)}
+	}
+
+	changed := make([]RankedEntry, 0)
+	for i, entry := range current {
+		ranked := RankedEntry{entry, uint32(i + 1)}
+		old, existed := previousRanks[entry.Id]
+		delete(previousRanks, entry.Id)
+		if existed && old.Rank == ranked.Rank && math.Round(old.Mass) == math.Round(entry.Mass) {
+			continue
+		}
+		changed = append(changed, ranked)
+	}
+
+	//Whatever's left wasn't on the current leaderboard
+	removedIds := make([]uint64, 0, len(previousRanks))
+	for id := range previousRanks {
+		removedIds = append(removedIds, id)
+	}
+
+	return changed, removedIds
+}
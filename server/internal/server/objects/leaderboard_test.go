@@ -0,0 +1,72 @@
+package objects
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRankPlayersBiggestFirst(t *testing.T) {
+	players := NewSharedCollection[*Player]()
+	players.Add(&Player{Name: "small", Radius: 10}, 1)
+	players.Add(&Player{Name: "big", Radius: 50}, 2)
+	players.Add(&Player{Name: "tied later", Radius: 30}, 4)
+	players.Add(&Player{Name: "tied first", Radius: 30}, 3)
+
+	entries := RankPlayers(players, nil)
+	want := []string{"big", "tied first", "tied later", "small"}
+	if len(entries) != len(want) {
+		t.Fatalf("ranked %d players, want %d", len(entries), len(want))
+	}
+	for i, name := range want {
+		if entries[i].Name != name {
+			t.Errorf("rank %d is %s, want %s", i+1, entries[i].Name, name)
+		}
+	}
+	if entries[0].Mass != math.Pi*50*50 {
+		t.Errorf("the top mass is %f, want the area of its circle", entries[0].Mass)
+	}
+}
+
+func TestRankPlayersLeavesOutWhoItsTold(t *testing.T) {
+	players := NewSharedCollection[*Player]()
+	players.Add(&Player{Name: "near", X: 10, Radius: 10}, 1)
+	players.Add(&Player{Name: "far", X: 5000, Radius: 90}, 2)
+
+	entries := RankPlayers(players, func(id uint64, player *Player) bool { return player.X < 1000 })
+	if len(entries) != 1 || entries[0].Id != 1 {
+		t.Errorf("ranked %v, want only the player that's near", entries)
+	}
+}
+
+func TestDiffLeaderboardsOnlyGivesWhatChanged(t *testing.T) {
+	previous := []LeaderboardEntry{{Id: 1, Mass: 900}, {Id: 2, Mass: 500}, {Id: 3, Mass: 300}}
+	current := []LeaderboardEntry{
+		{Id: 1, Mass: 900.2}, //same rank, same mass once rounded
+		{Id: 3, Mass: 600},   //overtook 2
+		{Id: 2, Mass: 500},   //same mass, lower rank
+		{Id: 4, Mass: 100},   //new
+	}
+
+	changed, removedIds := DiffLeaderboards(previous, current)
+	ranks := map[uint64]uint32{}
+	for _, entry := range changed {
+		ranks[entry.Id] = entry.Rank
+	}
+	want := map[uint64]uint32{3: 2, 2: 3, 4: 4}
+	if len(ranks) != len(want) {
+		t.Errorf("changed entries are %v, want %v", ranks, want)
+	}
+	for id, rank := range want {
+		if ranks[id] != rank {
+			t.Errorf("player %d changed to rank %d, want %d", id, ranks[id], rank)
+		}
+	}
+	if len(removedIds) != 0 {
+		t.Errorf("removed %v, nobody left", removedIds)
+	}
+
+	_, removedIds = DiffLeaderboards(current, current[:2])
+	if len(removedIds) != 2 {
+		t.Errorf("removed %v, want the last two players", removedIds)
+	}
+}
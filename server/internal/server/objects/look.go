@@ -0,0 +1,25 @@
+package objects
+
+import "math/rand"
+
+// Colors handed out to players that didn't pick one (rgba, like the client sends them)
+var colorPalette = []uint32{
+	0xe6194bff, 0x3cb44bff, 0xffe119ff, 0x4363d8ff,
+	0xf58231ff, 0x911eb4ff, 0x46f0f0ff, 0xf032e6ff,
+}
+
+// Skins go from 1 to MaxSkinId, 0 means no skin
+const MaxSkinId uint32 = 8
+
+func RandomColor() int32 {
+	return int32(colorPalette[rand.Intn(len(colorPalette))])
+}
+
+// Any rgba color goes as long as it's fully opaque, otherwise players could make themselves invisible
+func ValidColor(color int32) bool {
+	return color&0xFF == 0xFF
+}
+
+func ValidSkinId(skinId uint32) bool {
+	return skinId <= MaxSkinId
+}
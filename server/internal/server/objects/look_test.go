@@ -0,0 +1,32 @@
+package objects
+
+import "testing"
+
+func TestOnlyOpaqueColorsAreValid(t *testing.T) {
+	tests := map[uint32]bool{
+		0xff0000ff: true,
+		0x000000ff: true,
+		0xff000080: false,
+		0xffffff00: false,
+	}
+	for color, valid := range tests {
+		if ValidColor(int32(color)) != valid {
+			t.Errorf("ValidColor(%08x) = %v, want %v", color, !valid, valid)
+		}
+	}
+
+	for i := 0; i < 100; i++ {
+		if color := RandomColor(); !ValidColor(color) {
+			t.Fatalf("handed out the see through color %08x", uint32(color))
+		}
+	}
+}
+
+func TestSkinIdsGoUpToTheMax(t *testing.T) {
+	if !ValidSkinId(0) || !ValidSkinId(MaxSkinId) {
+		t.Error("no skin or the last skin was refused")
+	}
+	if ValidSkinId(MaxSkinId + 1) {
+		t.Error("a skin past the last one was allowed")
+	}
+}
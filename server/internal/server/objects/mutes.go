@@ -0,0 +1,46 @@
+package objects
+
+import "sync"
+
+// The players someone doesn't want to hear from, their chat isn't passed on to them
+// The zero value is an empty list ready to use
+type MuteList struct {
+	muted map[uint64]bool
+	mux   sync.RWMutex
+}
+
+func (m *MuteList) Mute(playerId uint64) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	if m.muted == nil {
+		m.muted = make(map[uint64]bool)
+	}
+	m.muted[playerId] = true
+}
+
+func (m *MuteList) Unmute(playerId uint64) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	delete(m.muted, playerId)
+}
+
+func (m *MuteList) Muted(playerId uint64) bool {
+	m.mux.RLock()
+	defer m.mux.RUnlock()
+	return m.muted[playerId]
+}
+
+// Leaves out the messages from muted players
+func (m *MuteList) Filter(entries []ChatEntry) []ChatEntry {
+	m.mux.RLock()
+	defer m.mux.RUnlock()
+
+	filtered := entries[:0]
+	for _, entry := range entries {
+		if !m.muted[entry.SenderId] {
+			filtered = append(filtered, entry)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,22 @@
+package objects
+
+import "testing"
+
+func TestFilterLeavesOutMutedSenders(t *testing.T) {
+	var mutes MuteList //the zero value has to work
+	if mutes.Muted(1) {
+		t.Fatal("an empty list has someone muted")
+	}
+	mutes.Mute(2)
+
+	entries := []ChatEntry{{SenderId: 1, Message: "a"}, {SenderId: 2, Message: "b"}, {SenderId: 3, Message: "c"}, {SenderId: 2, Message: "d"}}
+	filtered := mutes.Filter(entries)
+	if len(filtered) != 2 || filtered[0].Message != "a" || filtered[1].Message != "c" {
+		t.Errorf("filtered to %v, want only a and c", filtered)
+	}
+
+	mutes.Unmute(2)
+	if mutes.Muted(2) {
+		t.Error("still muted after unmuting")
+	}
+}
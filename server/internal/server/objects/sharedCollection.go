@@ -29,6 +29,15 @@ func NewSharedCollection[T any](capacity ...int) *SharedCollection[T] {
 	}
 }
 
+// Same as NewSharedCollection, but the ids handed out start at firstId instead of 1
+// So if ids were saved somewhere, a collection made after a restart can start past them and not reuse any
+// 0 is never handed out (it's the sender id of the server), so it counts as 1
+func NewSeededSharedCollection[T any](firstId uint64, capacity ...int) *SharedCollection[T] {
+	s := NewSharedCollection[T](capacity...)
+	s.nextId = max(firstId, 1)
+	return s
+}
+
 // A method to add objects to the shared collection
 // It'll add an object with its ID (if given), otherwise it'll give the next available ID
 // Returns the ID of the obj
@@ -49,11 +58,18 @@ func (s *SharedCollection[T]) Add(obj T, id ...uint64) uint64 {
 
 // Method for removing objects from shared collection
 // takes the ID of the obj to be removed
-func (s *SharedCollection[T]) Remove(id uint64) {
+// returns true only if the obj was there and this call removed it, so when two goroutines race
+// to remove the same obj exactly one of them wins
+func (s *SharedCollection[T]) Remove(id uint64) bool {
 	s.mapMux.Lock()         //locking again to avoid multithreading issues
 	defer s.mapMux.Unlock() //unlock once the function is done running
 
+	if _, exists := s.objectsMap[id]; !exists {
+		return false
+	}
+
 	delete(s.objectsMap, id)
+	return true
 }
 
 // Mehtod to loop through every obj in the map
@@ -77,6 +93,21 @@ func (s *SharedCollection[T]) ForEach(callback func(uint64, T)) {
 	}
 }
 
+// Method to swap the obj stored under an ID for a new one
+// only if the ID is still there, so an obj removed in the meantime doesn't come back
+// returns whether it was swapped
+func (s *SharedCollection[T]) Set(id uint64, obj T) bool {
+	s.mapMux.Lock()
+	defer s.mapMux.Unlock()
+
+	if _, exists := s.objectsMap[id]; !exists {
+		return false
+	}
+
+	s.objectsMap[id] = obj
+	return true
+}
+
 // Method to get an obj from the map
 // takes an ID and returns the obj if it exists otherwise ret nil
 // also returns t/f based on the obj existing in the map or not
@@ -88,6 +119,21 @@ func (s *SharedCollection[T]) Get(id uint64) (T, bool) {
 	return obj, found
 }
 
+// Method to get several objs at once, the map is only locked the one time
+// returns the objs that exist keyed by their ID, missing IDs are just left out
+func (s *SharedCollection[T]) GetMany(ids []uint64) map[uint64]T {
+	s.mapMux.Lock()
+	defer s.mapMux.Unlock()
+
+	found := make(map[uint64]T, len(ids))
+	for _, id := range ids {
+		if obj, exists := s.objectsMap[id]; exists {
+			found[id] = obj
+		}
+	}
+	return found
+}
+
 // Method to get the number of objects in the collection
 // not locking because I don't think it's worth it to lock and slow down
 // an approximate should do just fine
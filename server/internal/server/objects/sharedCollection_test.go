@@ -0,0 +1,103 @@
+package objects
+
+import "testing"
+
+func TestSeededCollectionStartsAtTheSeed(t *testing.T) {
+	spores := NewSeededSharedCollection[*Spore](1000)
+
+	first := spores.Add(&Spore{})
+	second := spores.Add(&Spore{})
+	if first != 1000 || second != 1001 {
+		t.Errorf("ids handed out were %d and %d, want 1000 and 1001", first, second)
+	}
+	if _, exists := spores.Get(1000); !exists {
+		t.Error("the first spore isn't under the seeded id")
+	}
+}
+
+func TestSeededCollectionNeverHandsOutZero(t *testing.T) {
+	spores := NewSeededSharedCollection[*Spore](0)
+	if id := spores.Add(&Spore{}); id != 1 {
+		t.Errorf("first id with a seed of 0 was %d, want 1", id)
+	}
+}
+
+func TestRemoveSaysWhetherItRemovedSomething(t *testing.T) {
+	spores := NewSharedCollection[*Spore]()
+	id := spores.Add(&Spore{})
+
+	if !spores.Remove(id) {
+		t.Error("removing a spore that's there returned false")
+	}
+	if _, exists := spores.Get(id); exists {
+		t.Error("the spore is still there after removing it")
+	}
+
+	//Gone now, and this one was never there
+	if spores.Remove(id) {
+		t.Error("removing the same spore again returned true")
+	}
+	if spores.Remove(id + 100) {
+		t.Error("removing an id that was never added returned true")
+	}
+}
+
+func TestOnlyOneRemoveWinsARace(t *testing.T) {
+	players := NewSharedCollection[*Player]()
+	id := players.Add(&Player{})
+
+	const racers = 8
+	results := make(chan bool, racers)
+	for i := 0; i < racers; i++ {
+		go func() { results <- players.Remove(id) }()
+	}
+
+	won := 0
+	for i := 0; i < racers; i++ {
+		if <-results {
+			won++
+		}
+	}
+	if won != 1 {
+		t.Errorf("%d removes of the same player returned true, want 1", won)
+	}
+}
+
+func TestSetDoesntBringBackWhatWasRemoved(t *testing.T) {
+	spores := NewSharedCollection[*Spore]()
+	id := spores.Add(&Spore{X: 1})
+
+	moved := &Spore{X: 2}
+	if !spores.Set(id, moved) {
+		t.Error("setting a spore that's there returned false")
+	}
+	if got, _ := spores.Get(id); got != moved {
+		t.Error("the spore wasn't swapped for the new one")
+	}
+
+	spores.Remove(id)
+	if spores.Set(id, &Spore{X: 3}) {
+		t.Error("setting a removed spore returned true")
+	}
+	if _, exists := spores.Get(id); exists {
+		t.Error("setting a removed spore put it back")
+	}
+}
+
+func TestGetManyLeavesOutMissingIds(t *testing.T) {
+	players := NewSharedCollection[*Player]()
+	players.Add(&Player{Name: "here"}, 1)
+	players.Add(&Player{Name: "also here"}, 2)
+	players.Add(&Player{Name: "not asked for"}, 3)
+
+	found := players.GetMany([]uint64{1, 2, 4})
+	if len(found) != 2 {
+		t.Fatalf("found %d players, want the 2 that exist", len(found))
+	}
+	if found[1].Name != "here" || found[2].Name != "also here" {
+		t.Errorf("found %v under the wrong ids", found)
+	}
+	if _, exists := found[4]; exists {
+		t.Error("a missing id was given back")
+	}
+}
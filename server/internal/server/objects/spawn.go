@@ -1,6 +1,11 @@
 package objects
 
-import "math/rand"
+import (
+	"log"
+	"math"
+	"math/rand"
+	"sync/atomic"
+)
 
 var getPlayerPosition = func(p *Player) (float64, float64) { return p.X, p.Y }
 var getPlayerRadius = func(p *Player) float64 { return p.Radius }
@@ -37,27 +42,121 @@ func isTooClose[T any](x float64, y float64, radius float64, objects *SharedColl
 	return tooClose
 }
 
-func SpawnCoords(radius float64, playersToAvoid *SharedCollection[*Player], sporesToAvoid *SharedCollection[*Spore]) (float64, float64) {
-	var bound float64 = 3000. //max coords limit
+// Where the randomness for spawning comes from, a *rand.Rand works so a seeded one can make
+// the same map every time, GlobalRand is the one from math/rand
+type Rand interface {
+	Float64() float64
+	NormFloat64() float64
+	Intn(n int) int
+}
+
+type globalRand struct{}
+
+func (globalRand) Float64() float64     { return rand.Float64() }
+func (globalRand) NormFloat64() float64 { return rand.NormFloat64() }
+func (globalRand) Intn(n int) int       { return rand.Intn(n) }
+
+// Safe to use from any goroutine, unlike a *rand.Rand
+var GlobalRand Rand = globalRand{}
+
+// A function that picks random coords within the given bound, different samplers spread
+// objects around the map differently
+type Sampler func(bound float64) (float64, float64)
+
+// Every point of the map is equally likely
+func UniformSampler(rng Rand) Sampler {
+	return func(bound float64) (float64, float64) {
+		return bound * (2*rng.Float64() - 1), bound * (2*rng.Float64() - 1)
+	}
+}
+
+// Points get more likely the closer they are to the edges, leaving the center sparse
+// (the square root pushes each coord towards 1, and the sign picks the side)
+func EdgeWeightedSampler(rng Rand) Sampler {
+	return func(bound float64) (float64, float64) {
+		edgeCoord := func() float64 {
+			coord := bound * math.Sqrt(rng.Float64())
+			if rng.Intn(2) == 0 {
+				return -coord
+			}
+			return coord
+		}
+		return edgeCoord(), edgeCoord()
+	}
+}
+
+// Points only land in a ring around the center, between innerFraction*bound and bound away from it
+func RingSampler(rng Rand, innerFraction float64) Sampler {
+	return func(bound float64) (float64, float64) {
+		dist := bound * (innerFraction + (1-innerFraction)*rng.Float64())
+		angle := rng.Float64() * 2 * math.Pi
+		return dist * math.Cos(angle), dist * math.Sin(angle)
+	}
+}
+
+// Finds random coords within the given bound that don't overlap any of the players or spores
+func SpawnCoords(radius float64, bound float64, playersToAvoid *SharedCollection[*Player], sporesToAvoid *SharedCollection[*Spore]) (float64, float64) {
+	return SpawnCoordsWith(UniformSampler(GlobalRand), radius, bound, playersToAvoid, sporesToAvoid)
+}
+
+// Same as SpawnCoords, but the candidate coords come from the given sampler
+func SpawnCoordsWith(sample Sampler, radius float64, bound float64, playersToAvoid *SharedCollection[*Player], sporesToAvoid *SharedCollection[*Spore]) (float64, float64) {
+	return spawnCoords(sample, radius, bound, playersToAvoid, getPlayerRadius, sporesToAvoid)
+}
+
+// Same as SpawnCoords, but on top of not overlapping anyone, the coords also stay dangerRadius away
+// from every player big enough to eat something of the given radius (more than massRatio times its mass)
+// so new players don't get eaten the moment they show up
+func SafeSpawnCoords(radius float64, bound float64, players *SharedCollection[*Player], dangerRadius float64, massRatio float64) (float64, float64) {
+	dangerousRadius := func(p *Player) float64 {
+		if p.Radius*p.Radius > massRatio*radius*radius {
+			return p.Radius + dangerRadius
+		}
+		return p.Radius
+	}
+	return spawnCoords(UniformSampler(GlobalRand), radius, bound, players, dangerousRadius, nil)
+}
+
+// Counts of how much work finding spawn coords takes, so it shows when the map is getting too
+// crowded. Shared by everything that spawns, since the spawn functions don't get a config
+type SpawnCounters struct {
+	Spawns         atomic.Uint64
+	Attempts       atomic.Uint64 //coords tried, a spawn that fits first time is 1
+	BoundDoublings atomic.Uint64 //times a spawn ran out of tries and went looking further out
+
+	//Spawns that take more attempts than this get logged, 0 never logs
+	SlowAttempts atomic.Int64
+}
+
+var SpawnStats = &SpawnCounters{}
+
+func spawnCoords(sample Sampler, radius float64, bound float64, playersToAvoid *SharedCollection[*Player], playerRadius func(*Player) float64, sporesToAvoid *SharedCollection[*Spore]) (float64, float64) {
 	const maxTries int = 25
 
 	tries := 0
+	attempts := 0 //unlike tries this doesn't start over when the bound doubles
 
 	for {
-		x := bound * (2*rand.Float64() - 1) //Generating x and y coords in an infinite loop
-		y := bound * (2*rand.Float64() - 1)
+		x, y := sample(bound) //Generating x and y coords in an infinite loop
+		attempts++
 
 		//if the coords are not too close to another player or spores then assigns the coords
 		//otherwise generate coords again, if the max tries have been reached, we increase the
 		//max coord boundary and make it double
-		if !isTooClose(x, y, radius, playersToAvoid, getPlayerPosition, getPlayerRadius) &&
+		if !isTooClose(x, y, radius, playersToAvoid, getPlayerPosition, playerRadius) &&
 			!isTooClose(x, y, radius, sporesToAvoid, getSporePosition, getSporeRadius) {
+			SpawnStats.Spawns.Add(1)
+			SpawnStats.Attempts.Add(uint64(attempts))
+			if slow := SpawnStats.SlowAttempts.Load(); slow > 0 && int64(attempts) > slow {
+				log.Printf("WARNING: spawning something of radius %f took %d attempts, the map might be too crowded", radius, attempts)
+			}
 			return x, y
 		}
 		tries++
 		if tries >= maxTries {
 			bound *= 2
 			tries = 0
+			SpawnStats.BoundDoublings.Add(1)
 		}
 	}
 }
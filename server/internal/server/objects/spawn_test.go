@@ -0,0 +1,97 @@
+package objects
+
+import (
+	"bytes"
+	"log"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestSamplersStayInTheirArea(t *testing.T) {
+	const bound = 1000
+	samplers := map[string]struct {
+		sample  Sampler
+		minDist float64 //closest to the center a point can be
+	}{
+		"uniform": {UniformSampler(rand.New(rand.NewSource(1))), 0},
+		"edge":    {EdgeWeightedSampler(rand.New(rand.NewSource(1))), 0},
+		"ring":    {RingSampler(rand.New(rand.NewSource(1)), 0.5), 500},
+	}
+
+	for name, s := range samplers {
+		for i := 0; i < 1000; i++ {
+			x, y := s.sample(bound)
+			if math.Abs(x) > bound || math.Abs(y) > bound {
+				t.Fatalf("%s: (%f, %f) is outside the bound", name, x, y)
+			}
+			if dist := math.Hypot(x, y); dist < s.minDist-1e-9 {
+				t.Fatalf("%s: (%f, %f) is %f from the center, want at least %f", name, x, y, dist, s.minDist)
+			}
+		}
+	}
+}
+
+// Spread the same way, edge weighted coords sit further out on average than uniform ones
+// (2/3 of the bound against 1/2)
+func TestEdgeWeightedSamplerLeavesTheCenterSparse(t *testing.T) {
+	meanAbs := func(sample Sampler) float64 {
+		var sum float64
+		for i := 0; i < 2000; i++ {
+			x, y := sample(1)
+			sum += math.Abs(x) + math.Abs(y)
+		}
+		return sum / 4000
+	}
+
+	uniform := meanAbs(UniformSampler(rand.New(rand.NewSource(2))))
+	edge := meanAbs(EdgeWeightedSampler(rand.New(rand.NewSource(2))))
+	if edge < 0.62 || uniform > 0.55 {
+		t.Errorf("coords average %f from the center edge weighted and %f uniform, want about 0.67 and 0.5", edge, uniform)
+	}
+}
+
+func TestSafeSpawnsKeepAwayFromPlayersThatCouldEatThem(t *testing.T) {
+	players := NewSharedCollection[*Player]()
+	players.Add(&Player{X: 0, Y: 0, Radius: 300}, 1)  //could eat a new player
+	players.Add(&Player{X: 600, Y: 0, Radius: 20}, 2) //couldn't, only has to not be overlapped
+
+	for i := 0; i < 200; i++ {
+		x, y := SafeSpawnCoords(25, 1000, players, 500, 1.5)
+		if dist := math.Hypot(x, y); dist < 300+500+25 {
+			t.Fatalf("spawned at (%f, %f), %f from a player that could eat it", x, y, dist)
+		}
+		if dist := math.Hypot(x-600, y); dist < 20+25 {
+			t.Fatalf("spawned at (%f, %f), on top of a small player", x, y)
+		}
+	}
+}
+
+func TestCrowdedSpawnsAreCountedAndLogged(t *testing.T) {
+	var logged bytes.Buffer
+	previous := log.Writer()
+	log.SetOutput(&logged)
+	defer log.SetOutput(previous)
+	defer SpawnStats.SlowAttempts.Store(SpawnStats.SlowAttempts.Load())
+	SpawnStats.SlowAttempts.Store(10)
+
+	//Covers the whole bound, so nothing fits until it's been doubled
+	players := NewSharedCollection[*Player]()
+	players.Add(&Player{Radius: 1500}, 1)
+	spawns, attempts, doublings := SpawnStats.Spawns.Load(), SpawnStats.Attempts.Load(), SpawnStats.BoundDoublings.Load()
+
+	SpawnCoords(10, 1000, players, nil)
+	if got := SpawnStats.Spawns.Load() - spawns; got != 1 {
+		t.Errorf("counted %d spawns, want 1", got)
+	}
+	if got := SpawnStats.Attempts.Load() - attempts; got <= 25 {
+		t.Errorf("counted %d attempts, want more than the 25 before the bound doubles", got)
+	}
+	if SpawnStats.BoundDoublings.Load() == doublings {
+		t.Error("the bound doubling wasn't counted")
+	}
+	if !strings.Contains(logged.String(), "might be too crowded") {
+		t.Errorf("logged %q, want a warning about the slow spawn", logged.String())
+	}
+}
@@ -0,0 +1,39 @@
+package objects
+
+import (
+	"math"
+	"sync/atomic"
+)
+
+// The edge of the world, the playable area is the square from -bound to bound on both axes
+// The bound can change while the game is running (shrinking arena), so it's stored atomically
+// as the bits of the float
+type WorldBound struct {
+	bits atomic.Uint64
+}
+
+func NewWorldBound(bound float64) *WorldBound {
+	w := &WorldBound{}
+	w.Set(bound)
+	return w
+}
+
+func (w *WorldBound) Get() float64 {
+	return math.Float64frombits(w.bits.Load())
+}
+
+func (w *WorldBound) Set(bound float64) {
+	w.bits.Store(math.Float64bits(bound))
+}
+
+// Returns true if the point is inside the current bound
+func (w *WorldBound) Contains(x, y float64) bool {
+	bound := w.Get()
+	return math.Abs(x) <= bound && math.Abs(y) <= bound
+}
+
+// Returns the closest point to (x, y) that's inside the current bound
+func (w *WorldBound) Clamp(x, y float64) (float64, float64) {
+	bound := w.Get()
+	return max(-bound, min(x, bound)), max(-bound, min(y, bound))
+}
@@ -0,0 +1,21 @@
+package objects
+
+import "testing"
+
+func TestWorldBoundClampsToTheCurrentEdge(t *testing.T) {
+	bound := NewWorldBound(3000)
+	if !bound.Contains(2500, -2500) {
+		t.Fatal("a point inside the starting bound isn't contained")
+	}
+
+	bound.Set(2000)
+	if bound.Contains(2500, -2500) {
+		t.Error("the point is still contained after the bound shrunk past it")
+	}
+	if x, y := bound.Clamp(2500, -2500); x != 2000 || y != -2000 {
+		t.Errorf("clamped to (%f, %f), want (2000, -2000)", x, y)
+	}
+	if x, y := bound.Clamp(10, -20); x != 10 || y != -20 {
+		t.Errorf("a point inside was moved to (%f, %f)", x, y)
+	}
+}
@@ -0,0 +1,38 @@
+package server
+
+import "time"
+
+// A simple token bucket to stop clients from spamming certain messages
+// The bucket starts full, every allowed action takes a token and tokens
+// trickle back in at refillRate per second (up to maxTokens)
+type RateLimiter struct {
+	tokens     float64
+	maxTokens  float64
+	refillRate float64
+	lastRefill time.Time
+	clock      Clock
+}
+
+func NewRateLimiter(maxTokens, refillRate float64, clock Clock) *RateLimiter {
+	return &RateLimiter{
+		tokens:     maxTokens,
+		maxTokens:  maxTokens,
+		refillRate: refillRate,
+		lastRefill: clock.Now(),
+		clock:      clock,
+	}
+}
+
+// Returns true if the action is allowed and takes a token for it
+func (r *RateLimiter) Allow() bool {
+	now := r.clock.Now()
+	r.tokens = min(r.maxTokens, r.tokens+now.Sub(r.lastRefill).Seconds()*r.refillRate)
+	r.lastRefill = now
+
+	if r.tokens < 1 {
+		return false
+	}
+
+	r.tokens--
+	return true
+}
@@ -0,0 +1,39 @@
+package server_test
+
+import (
+	"server/internal/server"
+	"server/internal/servertest"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterRefillsWithTheClock(t *testing.T) {
+	clock := servertest.NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	limiter := server.NewRateLimiter(2, 1, clock)
+
+	for i, want := range []bool{true, true, false} {
+		if got := limiter.Allow(); got != want {
+			t.Fatalf("action %d allowed %v, want %v", i+1, got, want)
+		}
+	}
+
+	//Half a token isn't enough, the other half makes one
+	clock.Advance(500 * time.Millisecond)
+	if limiter.Allow() {
+		t.Error("allowed with only half a token back")
+	}
+	clock.Advance(500 * time.Millisecond)
+	if !limiter.Allow() {
+		t.Error("not allowed after a whole token came back")
+	}
+
+	//A long wait only fills the bucket, it doesn't save up more than the burst
+	clock.Advance(time.Hour)
+	allowed := 0
+	for limiter.Allow() {
+		allowed++
+	}
+	if allowed != 2 {
+		t.Errorf("allowed %d in a row after an hour, want the burst of 2", allowed)
+	}
+}
@@ -0,0 +1,131 @@
+package server
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"server/internal/server/objects"
+	"sync"
+	"time"
+)
+
+// A game slot a client can come back to: its player is kept around for a grace window after
+// the connection drops, and whoever presents the old client id and the secret gets it back
+type reconnectSlot struct {
+	secret string
+	player *objects.Player
+	client ClientInterfacer //kicked if it's still around when the slot gets reclaimed
+	parked bool             //the client is gone and the player is waiting to be reclaimed
+	leave  func()           //cleans up the player for good, run when the grace window runs out
+	timer  *time.Timer
+
+	//Closed once the slot is parked, for a reclaim waiting on the old client to let go
+	parkedChan chan struct{}
+}
+
+// How long a reclaim waits for the old connection to be cleaned up before giving up
+const reclaimWait = 3 * time.Second
+
+// Keeps the reconnect slots of every client in game, by client id
+type ReconnectSlots struct {
+	slots map[uint64]*reconnectSlot
+	mux   sync.Mutex
+}
+
+func NewReconnectSlots() *ReconnectSlots {
+	return &ReconnectSlots{
+		slots: make(map[uint64]*reconnectSlot),
+	}
+}
+
+// Opens a slot for the client's player and returns the secret needed to reclaim it
+func (r *ReconnectSlots) Open(client ClientInterfacer, player *objects.Player) (string, error) {
+	secretBytes := make([]byte, 16)
+	if _, err := rand.Read(secretBytes); err != nil {
+		return "", err
+	}
+	secret := hex.EncodeToString(secretBytes)
+
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.slots[client.Id()] = &reconnectSlot{secret: secret, player: player, client: client, parkedChan: make(chan struct{})}
+	return secret, nil
+}
+
+// Holds on to the client's player for the grace window instead of cleaning it up right away
+// leave runs if nobody reclaims the slot in time. Returns false if there's no slot to park
+func (r *ReconnectSlots) Park(clientId uint64, grace time.Duration, leave func()) bool {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	slot, exists := r.slots[clientId]
+	if !exists || grace <= 0 {
+		return false
+	}
+
+	slot.parked = true
+	slot.leave = leave
+	close(slot.parkedChan)
+	slot.timer = time.AfterFunc(grace, func() {
+		r.mux.Lock()
+		if r.slots[clientId] != slot {
+			r.mux.Unlock()
+			return //reclaimed in the meantime
+		}
+		delete(r.slots, clientId)
+		r.mux.Unlock()
+
+		leave()
+	})
+	return true
+}
+
+// Drops the client's slot, for when it leaves the game on purpose
+func (r *ReconnectSlots) Forget(clientId uint64) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	delete(r.slots, clientId)
+}
+
+// Takes over the slot of the old client if the secret matches. If the old connection is still
+// open it gets kicked first, so the player isn't driven by two clients at once
+// The player is taken out of the shared collection, ready to be added again under the new client's id
+func (h *Hub) ReclaimSlot(oldClientId uint64, secret string) (*objects.Player, bool) {
+	r := h.ReconnectSlots
+
+	r.mux.Lock()
+	slot, exists := r.slots[oldClientId]
+	parked := exists && slot.parked
+	r.mux.Unlock()
+	if !exists || subtle.ConstantTimeCompare([]byte(slot.secret), []byte(secret)) != 1 {
+		return nil, false
+	}
+
+	if !parked {
+		//The old client cleans up on its own goroutine once its connection drops, which parks the
+		//slot (its OnExit does that) so it can be taken below
+		slot.client.Kick("reconnected from another connection")
+		select {
+		case <-slot.parkedChan:
+		case <-time.After(reclaimWait):
+			return nil, false
+		}
+	}
+
+	r.mux.Lock()
+	if r.slots[oldClientId] != slot || !slot.parked {
+		r.mux.Unlock()
+		return nil, false
+	}
+	delete(r.slots, oldClientId)
+	slot.timer.Stop()
+	r.mux.Unlock()
+
+	//The player could have been eaten while nobody was controlling it
+	if !h.SharedGameObjects.Players.Remove(oldClientId) {
+		slot.leave()
+		return nil, false
+	}
+
+	return slot.player, true
+}
@@ -0,0 +1,66 @@
+package server
+
+import (
+	"log"
+	"server/pkg/packets"
+	"sync/atomic"
+	"time"
+)
+
+// Keeps track of whether the round has started yet, and how long is left on the countdown before it does
+// Players can be in game during the countdown, they just can't move until it's over
+type Round struct {
+	started   atomic.Bool
+	remaining atomic.Uint32 //seconds left on the countdown, 0 if it isn't running
+}
+
+func NewRound(started bool) *Round {
+	r := &Round{}
+	r.started.Store(started)
+	return r
+}
+
+func (r *Round) Started() bool {
+	return r.started.Load()
+}
+
+// Seconds left before the round starts, 0 if there's no countdown running
+func (r *Round) Remaining() uint32 {
+	return r.remaining.Load()
+}
+
+// Waits for enough players to join, then counts down (telling every client the seconds left each second)
+// and starts the round: the spores get seeded and players are free to move
+func (h *Hub) roundLoop() {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if h.SharedGameObjects.Players.Len() >= h.Config().RoundMinPlayers {
+			break
+		}
+	}
+
+	log.Printf("Enough players joined, starting the round in %v", h.Config().CountdownDuration)
+	for seconds := uint32(h.Config().CountdownDuration.Seconds()); seconds > 0; seconds-- {
+		h.Round.remaining.Store(seconds)
+		h.BroadcastChan <- &packets.Packet{
+			SenderId: 0,
+			Msg:      packets.NewCountdown(seconds),
+		}
+		<-ticker.C
+	}
+
+	log.Println("Countdown over, placing spores...")
+	h.placeSpores()
+	h.Round.remaining.Store(0)
+	h.Round.started.Store(true)
+
+	//Players already in game get the spores once they hear the round started
+	h.BroadcastChan <- &packets.Packet{
+		SenderId: 0,
+		Msg:      packets.NewCountdown(0),
+	}
+
+	go h.replenishSporesLoop(2 * time.Second)
+}
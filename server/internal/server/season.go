@@ -0,0 +1,76 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"server/internal/server/db"
+	"time"
+)
+
+// Ends the current season: archives everyone's best score under the given label and zeroes
+// the live leaderboard. Both happen in one transaction, so if anything fails halfway nothing is lost
+// With a write queue the reset takes its place in line behind the best scores already queued
+// (they make it into the archive) and ahead of the ones queued after it, so it's only done once
+// the queue gets to it
+func (h *Hub) ResetSeason(ctx context.Context, season string) error {
+	if season == "" {
+		return fmt.Errorf("season label can't be empty")
+	}
+	if h.WriteQueue != nil {
+		h.WriteQueue.ResetSeason(season)
+		log.Printf("Season %s reset queued", season)
+		return nil
+	}
+	if !h.dbAvailable.Load() {
+		return fmt.Errorf("the database isn't available")
+	}
+
+	tx, err := h.dbPool.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback() //does nothing once the transaction is committed
+
+	if err := archiveSeason(ctx, h.NewDbTx().Queries.WithTx(tx), season); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing season reset: %w", err)
+	}
+
+	h.seasonReset(season)
+	return nil
+}
+
+// Copies the best scores to the archive and zeroes them, the queries have to be in a transaction
+func archiveSeason(ctx context.Context, queries *db.Queries, season string) error {
+	if err := queries.ArchiveBestScores(ctx, season); err != nil {
+		return fmt.Errorf("archiving best scores: %w", err)
+	}
+	if err := queries.ResetBestScores(ctx); err != nil {
+		return fmt.Errorf("resetting best scores: %w", err)
+	}
+	return nil
+}
+
+// Once the database is reset, every best score loaded before is last season's. Players keep theirs
+// in memory wherever they are (in game, dead, spectating, waiting for a reconnect), so instead of
+// hunting them all down the season number goes up and each one starts over when it next saves
+func (h *Hub) seasonReset(season string) {
+	h.SharedGameObjects.BestScoreSeason.Add(1)
+	log.Printf("Season %s archived and leaderboard reset", season)
+}
+
+// Resets the season every interval, labelling each archived season with the date it ended
+func (h *Hub) seasonResetLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for now := range ticker.C {
+		season := now.Format("2006-01-02")
+		if err := h.ResetSeason(context.Background(), season); err != nil {
+			log.Printf("Error resetting season %s: %v", season, err)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package server_test
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"server/internal/server"
+	"server/internal/server/db"
+	"server/internal/servertest"
+	"testing"
+	"time"
+)
+
+// Makes a player for each name with that best score, returns their ids in the same order
+func seedBestScores(t *testing.T, dbPool *sql.DB, scores map[string]int64) map[string]int64 {
+	t.Helper()
+	ctx := context.Background()
+	queries := db.New(dbPool)
+	ids := make(map[string]int64)
+	for name, score := range scores {
+		user, err := queries.CreateUser(ctx, db.CreateUserParams{Username: name, PasswordHash: "hash"})
+		if err != nil {
+			t.Fatalf("creating %s: %v", name, err)
+		}
+		player, err := queries.CreatePlayer(ctx, db.CreatePlayerParams{UserID: user.ID, Name: name})
+		if err != nil {
+			t.Fatalf("creating %s's player: %v", name, err)
+		}
+		if err := queries.UpdatePlayerBestScore(ctx, db.UpdatePlayerBestScoreParams{ID: player.ID, BestScore: score}); err != nil {
+			t.Fatalf("saving %s's best score: %v", name, err)
+		}
+		ids[name] = player.ID
+	}
+	return ids
+}
+
+// Everyone's archived best score for the season, by name
+func archivedScores(t *testing.T, dbPool *sql.DB, season string) map[string]int64 {
+	t.Helper()
+	rows, err := dbPool.Query("SELECT name, best_score FROM leaderboard_archive WHERE season = ?", season)
+	if err != nil {
+		t.Fatalf("reading the archive: %v", err)
+	}
+	defer rows.Close()
+
+	scores := make(map[string]int64)
+	for rows.Next() {
+		var name string
+		var score int64
+		if err := rows.Scan(&name, &score); err != nil {
+			t.Fatalf("reading the archive: %v", err)
+		}
+		scores[name] = score
+	}
+	return scores
+}
+
+// Everyone's best score in the players table, by name
+func liveScores(t *testing.T, dbPool *sql.DB) map[string]int64 {
+	t.Helper()
+	rows, err := dbPool.Query("SELECT name, best_score FROM players")
+	if err != nil {
+		t.Fatalf("reading the players: %v", err)
+	}
+	defer rows.Close()
+
+	scores := make(map[string]int64)
+	for rows.Next() {
+		var name string
+		var score int64
+		if err := rows.Scan(&name, &score); err != nil {
+			t.Fatalf("reading the players: %v", err)
+		}
+		scores[name] = score
+	}
+	return scores
+}
+
+func TestResetSeasonArchivesTheOldScores(t *testing.T) {
+	dbPool := servertest.NewTestDb(t)
+	seedBestScores(t, dbPool, map[string]int64{"alice": 900, "bob": 350, "carol": 0})
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	hub.UseDb(dbPool)
+
+	if err := hub.ResetSeason(context.Background(), "2026-09"); err != nil {
+		t.Fatalf("resetting the season: %v", err)
+	}
+
+	archived := archivedScores(t, dbPool, "2026-09")
+	if len(archived) != 2 || archived["alice"] != 900 || archived["bob"] != 350 {
+		t.Errorf("archived %v, want alice 900 and bob 350 (carol never scored)", archived)
+	}
+	for name, score := range liveScores(t, dbPool) {
+		if score != 0 {
+			t.Errorf("%s still has a best score of %d after the reset", name, score)
+		}
+	}
+	if hub.SharedGameObjects.BestScoreSeason.Load() != 1 {
+		t.Errorf("season is %d after one reset, want 1", hub.SharedGameObjects.BestScoreSeason.Load())
+	}
+}
+
+// A best score queued before the reset belongs to the old season, one queued after it to the new one.
+// Neither can land on the wrong side of the reset however long the queue takes to get to them
+func TestQueuedSeasonResetKeepsItsPlaceInLine(t *testing.T) {
+	dbPool := servertest.NewTestDb(t)
+	ids := seedBestScores(t, dbPool, map[string]int64{"alice": 900, "bob": 350})
+
+	config := server.DefaultConfig()
+	config.WriteQueueFile = filepath.Join(t.TempDir(), "writes.queue")
+	hub, _ := servertest.NewTestHub(config)
+	queue, err := server.OpenWriteQueue(config.WriteQueueFile)
+	if err != nil {
+		t.Fatalf("opening the write queue: %v", err)
+	}
+	hub.WriteQueue = queue
+	hub.UseDb(dbPool)
+	resets := make(chan string, 1)
+	queue.OnSeasonReset = func(season string) { resets <- season }
+
+	queue.UpdatePlayerBestScore(db.UpdatePlayerBestScoreParams{ID: ids["bob"], BestScore: 1200})
+	if err := hub.ResetSeason(context.Background(), "2026-09"); err != nil {
+		t.Fatalf("resetting the season: %v", err)
+	}
+	queue.UpdatePlayerBestScore(db.UpdatePlayerBestScoreParams{ID: ids["alice"], BestScore: 40})
+
+	//Nothing's been applied yet, the reset waits its turn
+	if archived := archivedScores(t, dbPool, "2026-09"); len(archived) != 0 {
+		t.Fatalf("archived %v before the queue started", archived)
+	}
+	if err := queue.Start(dbPool); err != nil {
+		t.Fatalf("starting the write queue: %v", err)
+	}
+
+	select {
+	case season := <-resets:
+		if season != "2026-09" {
+			t.Errorf("told about a reset of season %q, want 2026-09", season)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("the queue never applied the reset")
+	}
+	deadline := time.Now().Add(2 * time.Second)
+	for queue.Pending() > 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d writes still pending", queue.Pending())
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	archived := archivedScores(t, dbPool, "2026-09")
+	if archived["alice"] != 900 || archived["bob"] != 1200 {
+		t.Errorf("archived %v, want alice 900 and bob with the 1200 queued before the reset", archived)
+	}
+	live := liveScores(t, dbPool)
+	if live["alice"] != 40 || live["bob"] != 0 {
+		t.Errorf("live scores are %v, want alice with the 40 queued after the reset and bob 0", live)
+	}
+}
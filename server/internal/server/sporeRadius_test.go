@@ -0,0 +1,53 @@
+package server_test
+
+import (
+	"math/rand"
+	"server/internal/server"
+	"server/internal/servertest"
+	"testing"
+)
+
+// Radii picked with the given distribution, a mean of 6 and a std dev of 4 so plenty fall under the
+// minimum of 5
+func sporeRadii(distribution string, n int) []float64 {
+	config := server.DefaultConfig()
+	config.SporeRadiusDistribution = distribution
+	config.SporeRadiusMean, config.SporeRadiusStdDev = 6, 4
+	config.SporeRadiusMin, config.SporeRadiusMax = 5, 15
+	hub, _ := servertest.NewTestHub(config)
+	rng := rand.New(rand.NewSource(1))
+
+	radii := make([]float64, n)
+	for i := range radii {
+		radii[i] = hub.NewSporeRadius(rng)
+	}
+	return radii
+}
+
+func TestNormalSporeRadiusIsClampedToTheMinimum(t *testing.T) {
+	atMin := 0
+	for _, radius := range sporeRadii(server.DistributionNormal, 1000) {
+		if radius < 5 {
+			t.Fatalf("radius %f is under the minimum of 5", radius)
+		}
+		if radius == 5 {
+			atMin++
+		}
+	}
+	if atMin == 0 {
+		t.Error("no radius was clamped, the std dev can't have been used")
+	}
+}
+
+func TestUniformSporeRadiusSpansMinToMax(t *testing.T) {
+	var sum float64
+	for _, radius := range sporeRadii(server.DistributionUniform, 1000) {
+		if radius < 5 || radius > 15 {
+			t.Fatalf("radius %f is outside [5, 15]", radius)
+		}
+		sum += radius
+	}
+	if mean := sum / 1000; mean < 9 || mean > 11 {
+		t.Errorf("radii average %f, want about 10 (halfway between the min and max)", mean)
+	}
+}
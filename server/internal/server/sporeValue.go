@@ -0,0 +1,59 @@
+package server
+
+import (
+	"log"
+	"math"
+	"sync/atomic"
+	"time"
+)
+
+// How much spores are worth right now compared to their size, worked out from the player count
+// every SporeValueInterval so crowded and empty servers grow at about the same pace
+type SporeValue struct {
+	multiplier atomic.Uint64 //float64 bits
+}
+
+func NewSporeValue() *SporeValue {
+	v := &SporeValue{}
+	v.set(1)
+	return v
+}
+
+// What the mass players get from eating a spore is multiplied by right now
+func (v *SporeValue) Multiplier() float64 {
+	return math.Float64frombits(v.multiplier.Load())
+}
+
+func (v *SporeValue) set(multiplier float64) {
+	v.multiplier.Store(math.Float64bits(multiplier))
+}
+
+// The spore value multiplier for the given number of players, 1 at SporeValuePlayers players
+func sporeValueFor(config *Config, players int) float64 {
+	reference := float64(config.SporeValuePlayers)
+	var multiplier float64
+	switch config.SporeValueScaling {
+	case SporeValueLinear:
+		multiplier = 1 + config.SporeValueSlope*(reference-float64(players))
+	case SporeValueInverse:
+		multiplier = reference / float64(max(players, 1))
+	default:
+		return 1
+	}
+	return min(max(multiplier, config.SporeValueMin), config.SporeValueMax)
+}
+
+// Works the spore value out again every interval, logging it when it changes
+func (h *Hub) sporeValueLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		players := h.SharedGameObjects.Players.Len()
+		multiplier := sporeValueFor(h.Config(), players)
+		if multiplier != h.SporeValue.Multiplier() {
+			log.Printf("Spores are worth %.2fx with %d players", multiplier, players)
+			h.SporeValue.set(multiplier)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package server_test
+
+import (
+	"math"
+	"server/internal/server"
+	"testing"
+)
+
+func TestSporeValueFollowsThePlayerCount(t *testing.T) {
+	tests := []struct {
+		scaling string
+		players int
+		want    float64
+	}{
+		{server.SporeValueNone, 1, 1},
+		{server.SporeValueNone, 50, 1},
+		{server.SporeValueLinear, 10, 1},
+		{server.SporeValueLinear, 6, 1.2},  //0.05 more for each of the 4 missing players
+		{server.SporeValueLinear, 14, 0.8}, //and 0.05 less for each extra one
+		{server.SporeValueLinear, 0, 1.5},
+		{server.SporeValueLinear, 40, 0.5}, //would be -0.5, held at the minimum
+		{server.SporeValueInverse, 20, 0.5},
+		{server.SporeValueInverse, 8, 1.25},
+		{server.SporeValueInverse, 0, 2}, //nobody on is counted as one, then held at the maximum
+		{"bogus", 3, 1},
+	}
+
+	config := server.DefaultConfig()
+	for _, test := range tests {
+		config.SporeValueScaling = test.scaling
+		if got := server.SporeValueFor(config, test.players); math.Abs(got-test.want) > 1e-9 {
+			t.Errorf("%s with %d players is worth %v, want %v", test.scaling, test.players, got, test.want)
+		}
+	}
+}
+
+func TestSporesStartOutWorthTheirSize(t *testing.T) {
+	if multiplier := server.NewSporeValue().Multiplier(); multiplier != 1 {
+		t.Errorf("a new spore value is %v, want 1", multiplier)
+	}
+}
@@ -23,7 +23,7 @@ func (b *BrowsingHiscores) Name() string {
 func (b *BrowsingHiscores) SetClient(client server.ClientInterfacer) {
 	b.client = client
 	loggingPrefix := fmt.Sprintf("Client %d [%s]: ", client.Id(), b.Name())
-	b.logger = log.New(log.Writer(), loggingPrefix, log.LstdFlags)
+	b.logger = log.New(client.LogWriter(), loggingPrefix, log.LstdFlags)
 	b.queries = client.DbTx().Queries
 	b.dbCtx = client.DbTx().Ctx
 }
@@ -38,6 +38,12 @@ func (b *BrowsingHiscores) HandleMessage(senderId uint64, message packets.Msg) {
 		b.handleFinishedBrowsingHiscores(senderId, message)
 	case *packets.Packet_SearchHiscore:
 		b.handleSearchHiscore(senderId, message)
+	case *packets.Packet_Chat:
+		rejectChat(b.client, senderId)
+	case *packets.Packet_Heartbeat:
+		echoHeartbeat(b.client, senderId, message)
+	default:
+		rejectUnsupported(b.client, senderId, message, b.Name())
 	}
 }
 
@@ -2,6 +2,7 @@ package states
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"log"
@@ -9,6 +10,7 @@ import (
 	"server/internal/server/db"
 	"server/internal/server/objects"
 	"server/pkg/packets"
+	"strconv"
 	"strings"
 
 	"golang.org/x/crypto/bcrypt"
@@ -20,6 +22,9 @@ type Connected struct {
 	logger  *log.Logger
 	queries *db.Queries
 	dbCtx   context.Context
+
+	//Settings picked in the menu, they take over the saved ones once the player is known
+	settings *pickedSettings
 }
 
 // Functions for methods that were initialized in the
@@ -31,7 +36,7 @@ func (c *Connected) Name() string {
 func (c *Connected) SetClient(client server.ClientInterfacer) {
 	c.client = client
 	loggingPrefix := fmt.Sprintf("Client %d [%s]: ", client.Id(), c.Name())
-	c.logger = log.New(log.Writer(), loggingPrefix, log.LstdFlags)
+	c.logger = log.New(client.LogWriter(), loggingPrefix, log.LstdFlags)
 	c.queries = client.DbTx().Queries
 	c.dbCtx = client.DbTx().Ctx
 }
@@ -42,12 +47,34 @@ func (c *Connected) OnEnter() {
 
 func (c *Connected) HandleMessage(senderId uint64, message packets.Msg) {
 	switch message := message.(type) {
+	case *packets.Packet_ClientInfo:
+		c.handleClientInfo(senderId, message)
 	case *packets.Packet_LoginRequest:
 		c.handleLoginRequest(senderId, message)
 	case *packets.Packet_RegisterRequest:
 		c.handleRegisterRequest(senderId, message)
 	case *packets.Packet_HiscoreBoardRequest:
 		c.handleHiscoreBoardRequest(senderId, message)
+	case *packets.Packet_EnterGame:
+		c.handleEnterGame(senderId, message)
+	case *packets.Packet_Reconnect:
+		c.handleReconnect(senderId, message)
+	case *packets.Packet_Spectate:
+		c.handleSpectate(senderId, message)
+	case *packets.Packet_Settings:
+		c.handleSettings(senderId, message)
+	case *packets.Packet_Chat:
+		rejectChat(c.client, senderId)
+	case *packets.Packet_RequestServerInfo:
+		c.handleRequestServerInfo(senderId, message)
+	case *packets.Packet_Heartbeat:
+		//Clients can keep checking their ping from the menu too
+		echoHeartbeat(c.client, senderId, message)
+	case *packets.Packet_RequestStats:
+		//Running the query in the background so the read pump isn't held up by the DB
+		go c.handleRequestStats(senderId, message)
+	default:
+		rejectUnsupported(c.client, senderId, message, c.Name())
 	}
 }
 
@@ -55,6 +82,71 @@ func (c *Connected) OnExit() {
 
 }
 
+// Function to store the version the client says it is, too old clients are told to update right away
+func (c *Connected) handleClientInfo(senderId uint64, message *packets.Packet_ClientInfo) {
+	if senderId != c.client.Id() {
+		return
+	}
+
+	c.client.SetClientVersion(message.ClientInfo.Version)
+	c.client.SetSupportsAcks(message.ClientInfo.SupportsAcks)
+	c.checkClientVersion()
+}
+
+// Function to check the server has a database to work with, if it doesn't the client is told
+// that whatever it asked for isn't available right now and false is returned
+func (c *Connected) checkDbAvailable() bool {
+	if c.client.DbTx().Available() {
+		return true
+	}
+
+	c.client.SocketSend(packets.NewDenyResponse("That isn't available right now, but you can still play as a guest"))
+	return false
+}
+
+// Function to check the client is at least the minimum version from the config
+// If it isn't, the client is told to update and false is returned so it can't get into the game
+func (c *Connected) checkClientVersion() bool {
+	minVersion := c.client.Config().MinClientVersion
+	if minVersion == "" {
+		return true
+	}
+
+	version := c.client.ClientVersion()
+	if compareVersions(version, minVersion) >= 0 {
+		return true
+	}
+
+	c.logger.Printf("Client version %q is older than the minimum %s", version, minVersion)
+	c.client.SocketSend(packets.NewUpdateRequired(minVersion))
+	return false
+}
+
+// Function to let a client that lost its connection take its old player back
+func (c *Connected) handleReconnect(senderId uint64, message *packets.Packet_Reconnect) {
+	if senderId != c.client.Id() {
+		return
+	}
+
+	if !c.checkClientVersion() {
+		return
+	}
+
+	player, ok := c.client.ReclaimSlot(message.Reconnect.ClientId, message.Reconnect.Secret)
+	if !ok {
+		c.logger.Printf("Couldn't reconnect to the slot of client %d", message.Reconnect.ClientId)
+		c.client.SocketSend(packets.NewDenyResponse("Couldn't reconnect, the game has moved on"))
+		return
+	}
+
+	c.logger.Printf("Reconnected to the player %s of client %d", player.Name, message.Reconnect.ClientId)
+	c.client.SocketSendReliable(packets.NewOkResponse())
+	c.client.SetState(&InGame{
+		player:      player,
+		reconnected: true,
+	})
+}
+
 // Function to handle login requests:
 func (c *Connected) handleLoginRequest(senderId uint64, message *packets.Packet_LoginRequest) {
 	//Making sure the sender is our own client
@@ -63,6 +155,10 @@ func (c *Connected) handleLoginRequest(senderId uint64, message *packets.Packet_
 		return
 	}
 
+	if !c.checkClientVersion() || !c.checkDbAvailable() || !c.checkGameNotFull() {
+		return
+	}
+
 	username := message.LoginRequest.Username
 
 	genericFailMessage := packets.NewDenyResponse("Incorrect username or password!")
@@ -81,7 +177,9 @@ func (c *Connected) handleLoginRequest(senderId uint64, message *packets.Packet_
 		return
 	}
 
-	player, err := c.queries.GetPlayerByUserId(c.dbCtx, user.ID)
+	//Taken before the best score is read, so a season reset in between can only zero it for nothing
+	season := c.client.SharedGameObjects().BestScoreSeason.Load()
+	player, err := c.getOrCreatePlayer(user)
 
 	if err != nil {
 		c.logger.Printf("Error getting player for the user %s: %v", username, err)
@@ -89,18 +187,127 @@ func (c *Connected) handleLoginRequest(senderId uint64, message *packets.Packet_
 		return
 	}
 
+	settings, err := c.getPlayerSettings(player.ID)
+	if err != nil {
+		c.logger.Printf("Error getting settings for the user %s: %v", username, err)
+		c.client.SocketSend(genericFailMessage)
+		return
+	}
+
+	achievements, err := c.getPlayerAchievements(player.ID)
+	if err != nil {
+		c.logger.Printf("Error getting achievements for the user %s: %v", username, err)
+		c.client.SocketSend(genericFailMessage)
+		return
+	}
+
 	//But if the username and password are correct:
 	c.logger.Printf("User %s logged in successfully!", username)
-	c.client.SocketSend(packets.NewOkResponse())
+	c.client.SocketSendReliable(packets.NewOkResponse())
 
 	//Once the user logs in, we're changing the state to in-game
-	c.client.SetState(&InGame{
+	c.client.SetState(c.withPickedSettings(&InGame{
 		player: &objects.Player{
-			Name:      player.Name,
-			DbId:      player.ID,
-			BestScore: player.BestScore,
-			Color:     int32(player.Color),
+			Name:            player.Name,
+			DbId:            player.ID,
+			BestScore:       player.BestScore,
+			BestScoreSeason: season,
+			Color:           playerColor(int32(player.Color)),
+			SkinId:          uint32(player.SkinID),
+			Settings:        settings,
+			Achievements:    achievements,
 		},
+	}))
+}
+
+// Function to keep the settings the client picks in the menu, before we know who the player is
+func (c *Connected) handleSettings(senderId uint64, message *packets.Packet_Settings) {
+	if senderId != c.client.Id() {
+		return
+	}
+
+	picked, ok := readSettings(c.client, message)
+	if !ok {
+		return
+	}
+	c.settings = &picked
+
+	preview := &objects.Player{}
+	picked.apply(preview)
+	c.client.SocketSend(packets.NewSettings(preview))
+}
+
+// Puts the settings picked in the menu (if any) on the player about to go in game
+func (c *Connected) withPickedSettings(game *InGame) *InGame {
+	if c.settings != nil {
+		c.settings.apply(game.player)
+		game.settingsChanged = true
+	}
+	return game
+}
+
+// Players from before colors were checked could have a see through one, they get a random one instead
+func playerColor(color int32) int32 {
+	if objects.ValidColor(color) {
+		return color
+	}
+	return objects.RandomColor()
+}
+
+// Function to load the ids of the achievements a player unlocked before
+func (c *Connected) getPlayerAchievements(playerId int64) (map[string]bool, error) {
+	ids, err := c.queries.GetPlayerAchievements(c.dbCtx, playerId)
+	if err != nil {
+		return nil, err
+	}
+
+	achievements := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		achievements[id] = true
+	}
+	return achievements, nil
+}
+
+// Function to load the preferences a player saved, players that never saved any get the defaults
+// (so do saved values that make no sense anymore)
+func (c *Connected) getPlayerSettings(playerId int64) (objects.PlayerSettings, error) {
+	settings, err := c.queries.GetPlayerSettings(c.dbCtx, playerId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return objects.DefaultPlayerSettings(), nil
+	}
+	if err != nil {
+		return objects.PlayerSettings{}, err
+	}
+
+	selfEcho := objects.SelfEcho(settings.SelfEcho)
+	if !objects.ValidSelfEcho(selfEcho) {
+		selfEcho = objects.SelfEchoAlways
+	}
+
+	return objects.PlayerSettings{
+		ChatEnabled:    settings.ChatEnabled,
+		MinimapEnabled: settings.MinimapEnabled,
+		AutoCollect:    settings.AutoCollect,
+		SelfEcho:       selfEcho,
+		HapticsEnabled: settings.HapticsEnabled,
+	}, nil
+}
+
+// Function to load the player linked to a user, so the game gets its DB id and best score
+// If the user somehow has no player (e.g. registration failed halfway), a new one is created
+func (c *Connected) getOrCreatePlayer(user db.User) (db.Player, error) {
+	player, err := c.queries.GetPlayerByUserId(c.dbCtx, user.ID)
+	if err == nil {
+		return player, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return db.Player{}, err
+	}
+
+	c.logger.Printf("User %s has no player, creating one", user.Username)
+	return c.queries.CreatePlayer(c.dbCtx, db.CreatePlayerParams{
+		UserID: user.ID,
+		Name:   user.Username,
 	})
 }
 
@@ -112,6 +319,10 @@ func (c *Connected) handleRegisterRequest(senderId uint64, message *packets.Pack
 		return
 	}
 
+	if !c.checkDbAvailable() {
+		return
+	}
+
 	username := message.RegisterRequest.Username
 	err := validateUsername(username) //Validating the username
 
@@ -124,6 +335,12 @@ func (c *Connected) handleRegisterRequest(senderId uint64, message *packets.Pack
 		return
 	}
 
+	if !objects.ValidColor(message.RegisterRequest.Color) {
+		c.logger.Printf("Invalid color: %x", message.RegisterRequest.Color)
+		c.client.SocketSend(packets.NewDenyResponse("The color can't be see through"))
+		return
+	}
+
 	//If username exists already:
 	if _, err := c.queries.GetUserByUsername(c.dbCtx, strings.ToLower(username)); err == nil {
 		c.logger.Printf("User already exists: %v", err)
@@ -170,10 +387,164 @@ func (c *Connected) handleRegisterRequest(senderId uint64, message *packets.Pack
 	c.logger.Printf("User %s registered successfully", username)
 }
 
+// Function to let a client jump into the game as a guest with just a name (no account)
+// Guests aren't linked to the DB, so their scores aren't saved
+func (c *Connected) handleEnterGame(senderId uint64, message *packets.Packet_EnterGame) {
+	if senderId != c.client.Id() {
+		c.logger.Printf("Recieved enter game request from another client (Id: %d)", senderId)
+		return
+	}
+
+	if !c.checkClientVersion() || !c.checkGameNotFull() {
+		return
+	}
+
+	name := message.EnterGame.Name
+	if err := validateUsername(name); err != nil {
+		reason := fmt.Sprintf("Invalid name: %s", err)
+		c.logger.Println(reason)
+		c.client.SocketSend(packets.NewDenyResponse(reason))
+		return
+	}
+
+	//Guests can't take the name of a registered player (without the DB there's nobody registered to check)
+	if c.client.DbTx().Available() {
+		if _, err := c.queries.GetPlayerByName(c.dbCtx, name); err == nil {
+			c.logger.Printf("Guest tried to use the registered name %s", name)
+			c.client.SocketSend(packets.NewDenyResponse("That name belongs to a registered player"))
+			return
+		}
+	}
+
+	//Or the name of someone who's already playing
+	nameTaken := false
+	c.client.SharedGameObjects().Players.ForEach(func(_ uint64, player *objects.Player) {
+		if strings.EqualFold(player.Name, name) {
+			nameTaken = true
+		}
+	})
+	if nameTaken {
+		c.logger.Printf("Guest tried to use the name %s which is already in game", name)
+		c.client.SocketSend(packets.NewDenyResponse("That name is already in use"))
+		return
+	}
+
+	c.logger.Printf("Guest %s entering the game", name)
+	c.client.SocketSendReliable(packets.NewOkResponse())
+
+	c.client.SetState(c.withPickedSettings(&InGame{
+		player: &objects.Player{
+			Name:     name,
+			Color:    objects.RandomColor(),
+			Settings: objects.DefaultPlayerSettings(),
+		},
+	}))
+}
+
+func (c *Connected) handleRequestServerInfo(senderId uint64, _ *packets.Packet_RequestServerInfo) {
+	if senderId != c.client.Id() {
+		return
+	}
+
+	info := c.client.ServerInfo()
+	c.client.SocketSend(packets.NewServerInfo(info.Name, info.Version, info.Clients, info.Players, info.MaxPlayers, info.GameMode, info.UptimeSeconds))
+}
+
+// Function to check there's room in the game, if there isn't the client is told and false is returned
+func (c *Connected) checkGameNotFull() bool {
+	if !c.client.GameFull() {
+		return true
+	}
+
+	c.client.SocketSend(packets.NewDenyResponse("The game is full, try again in a bit"))
+	return false
+}
+
+func (c *Connected) handleSpectate(senderId uint64, message *packets.Packet_Spectate) {
+	if senderId != c.client.Id() {
+		return
+	}
+
+	c.client.SetState(newSpectating(message.Spectate.TargetId))
+}
+
 func (c *Connected) handleHiscoreBoardRequest(senderId uint64, message *packets.Packet_HiscoreBoardRequest) {
+	if !c.checkDbAvailable() {
+		return
+	}
 	c.client.SetState(&BrowsingHiscores{})
 }
 
+// Function to send the lifetime stats of a player back to the client
+// If the player doesn't exist, the client just gets all zeros
+func (c *Connected) handleRequestStats(_ uint64, message *packets.Packet_RequestStats) {
+	name := message.RequestStats.Name
+	if !c.checkDbAvailable() {
+		return
+	}
+
+	stats, err := c.queries.GetPlayerStats(c.dbCtx, name)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		c.logger.Printf("Error getting stats for player %s: %v", name, err)
+		c.client.SocketSend(packets.NewDenyResponse("Failed to get the player stats - please try again later."))
+		return
+	}
+
+	c.client.SocketSend(packets.NewPlayerStats(name, stats.BestScore, stats.MatchesPlayed, stats.TotalMassEaten))
+}
+
+// Chat is only for players in the game (they have a name everyone can see), anything sent from
+// another state is turned down instead of going out anonymously
+func rejectChat(client server.ClientInterfacer, senderId uint64) {
+	if senderId == client.Id() {
+		client.SocketSend(packets.NewError("You have to be in the game to chat"))
+	}
+}
+
+// Sends our own client's heartbeat straight back so it can work out its ping, whatever state it's in
+func echoHeartbeat(client server.ClientInterfacer, senderId uint64, message *packets.Packet_Heartbeat) {
+	if senderId == client.Id() {
+		client.SocketSend(message)
+	}
+}
+
+// Lets our own client know the message it sent doesn't do anything in the state it's in, instead
+// of it being dropped without a word. Messages from anyone else that a state doesn't need are fine
+func rejectUnsupported(client server.ClientInterfacer, senderId uint64, message packets.Msg, stateName string) {
+	if senderId != client.Id() {
+		return
+	}
+
+	messageType := strings.TrimPrefix(fmt.Sprintf("%T", message), "*packets.Packet_")
+	client.SocketSend(packets.NewError(fmt.Sprintf("A %s message isn't valid in the %s state", messageType, stateName)))
+}
+
+// Compares two versions like "1.2.0" part by part
+// returns -1 if a is older than b, 1 if it's newer and 0 if they're the same
+// Missing or invalid parts count as 0, so an empty version is older than anything
+func compareVersions(a, b string) int {
+	aParts := strings.Split(a, ".")
+	bParts := strings.Split(b, ".")
+
+	for i := 0; i < max(len(aParts), len(bParts)); i++ {
+		var aNum, bNum int
+		if i < len(aParts) {
+			aNum, _ = strconv.Atoi(aParts[i])
+		}
+		if i < len(bParts) {
+			bNum, _ = strconv.Atoi(bParts[i])
+		}
+
+		if aNum != bNum {
+			if aNum < bNum {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
 // Function to validate the username:
 func validateUsername(username string) error {
 	if len(username) <= 0 {
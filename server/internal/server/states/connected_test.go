@@ -0,0 +1,349 @@
+package states
+
+import (
+	"context"
+	"server/internal/server"
+	"server/internal/server/db"
+	"server/internal/server/objects"
+	"server/internal/servertest"
+	"server/pkg/packets"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// The secret the client was given to take its player back after a drop
+func reconnectSecret(t *testing.T, client *servertest.TestClient) string {
+	t.Helper()
+	reconnects := servertest.MessagesOf[*packets.Packet_Reconnect](client.SentMessages())
+	if len(reconnects) == 0 {
+		t.Fatal("the client wasn't given a reconnect slot")
+	}
+	return reconnects[len(reconnects)-1].Reconnect.Secret
+}
+
+// Has a new connection ask for the old client's player back, returns the new client
+func reconnect(hub *server.Hub, oldClientId uint64, secret string) *servertest.TestClient {
+	client := servertest.NewTestClient(hub)
+	client.SetState(&Connected{})
+	client.ProcessMessage(client.Id(), packets.NewReconnect(oldClientId, secret))
+	return client
+}
+
+func TestReconnectKeepsThePlayer(t *testing.T) {
+	config := server.DefaultConfig()
+	config.ReconnectGrace = time.Minute
+	hub, _ := servertest.NewTestHub(config)
+	old, state := joinGame(t, hub, "dropper")
+	state.player.X, state.player.Y, state.player.Radius = 120, -45, 60
+	secret := reconnectSecret(t, old)
+
+	//The connection drops, the player waits for the grace window
+	old.Close("connection dropped")
+	if _, exists := hub.SharedGameObjects.Players.Get(old.Id()); !exists {
+		t.Fatal("the player left the game right away")
+	}
+
+	client := reconnect(hub, old.Id(), secret)
+	t.Cleanup(func() { client.Close("test over") })
+	waitFor(t, "the player to be back", func() bool {
+		_, exists := hub.SharedGameObjects.Players.Get(client.Id())
+		return exists
+	})
+
+	player, _ := hub.SharedGameObjects.Players.Get(client.Id())
+	if player.X != 120 || player.Y != -45 || player.Radius != 60 {
+		t.Errorf("player came back at (%f, %f) with radius %f, want (120, -45) with radius 60", player.X, player.Y, player.Radius)
+	}
+	if _, exists := hub.SharedGameObjects.Players.Get(old.Id()); exists {
+		t.Error("the player is still in the game under the old id too")
+	}
+	//Everyone else never saw it leave, so it doesn't join again either
+	if joined := servertest.MessagesOf[*packets.Packet_PlayerJoined](client.Broadcasts()); len(joined) != 0 {
+		t.Error("the reconnected player was announced as joining")
+	}
+}
+
+func TestReconnectKicksTheOldConnection(t *testing.T) {
+	config := server.DefaultConfig()
+	config.ReconnectGrace = time.Minute
+	hub, _ := servertest.NewTestHub(config)
+	old, _ := joinGame(t, hub, "twice")
+	secret := reconnectSecret(t, old)
+
+	//The old connection is still up, so the reclaim has to wait for it to close on its own goroutine
+	reconnected := make(chan *servertest.TestClient)
+	go func() { reconnected <- reconnect(hub, old.Id(), secret) }()
+
+	waitFor(t, "the old client to be kicked", func() bool { return old.QueuedTasks() > 0 })
+	if old.StateName() != "InGame" {
+		t.Fatalf("the old client was closed from another goroutine, it's in %s", old.StateName())
+	}
+	old.RunQueued()
+
+	client := <-reconnected
+	t.Cleanup(func() { client.Close("test over") })
+	if client.StateName() != "InGame" {
+		t.Errorf("the new client is in %s, want InGame", client.StateName())
+	}
+	if old.Kicked() == "" {
+		t.Error("the old client wasn't told it was replaced")
+	}
+}
+
+func TestReconnectNeedsTheSecret(t *testing.T) {
+	config := server.DefaultConfig()
+	config.ReconnectGrace = time.Minute
+	hub, _ := servertest.NewTestHub(config)
+	old, _ := joinGame(t, hub, "victim")
+	old.Close("connection dropped")
+
+	client := reconnect(hub, old.Id(), "not the secret")
+	if client.StateName() != "Connected" {
+		t.Errorf("the client took the player with a wrong secret, it's in %s", client.StateName())
+	}
+}
+
+func TestSettingsPickedInTheMenuCarryIntoTheGame(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	client := servertest.NewTestClient(hub)
+	client.SetState(&Connected{})
+	t.Cleanup(func() { client.Close("test over") })
+
+	picked := &objects.Player{
+		Color:    objects.RandomColor(),
+		Settings: objects.PlayerSettings{ChatEnabled: false, MinimapEnabled: true, SelfEcho: objects.SelfEchoOff},
+	}
+	client.ProcessMessage(client.Id(), packets.NewSettings(picked))
+	if len(servertest.MessagesOf[*packets.Packet_Settings](client.SentMessages())) != 1 {
+		t.Fatal("the client wasn't sent its settings back")
+	}
+
+	client.ProcessMessage(client.Id(), packets.NewEnterGame("picky"))
+	waitFor(t, "the player to be in the game", func() bool {
+		_, exists := hub.SharedGameObjects.Players.Get(client.Id())
+		return exists
+	})
+
+	player, _ := hub.SharedGameObjects.Players.Get(client.Id())
+	if player.Settings != picked.Settings || player.Color != picked.Color {
+		t.Errorf("player went in with %+v and color %d, want %+v and color %d", player.Settings, player.Color, picked.Settings, picked.Color)
+	}
+}
+
+// The ping keeps working outside the game, whatever screen the client is on
+func TestHeartbeatsAreEchoedInEveryState(t *testing.T) {
+	for _, state := range []server.ClientStateHandler{
+		&Connected{},
+		&Dead{player: &objects.Player{Name: "ghost"}},
+		newSpectating(0),
+		&BrowsingHiscores{},
+	} {
+		t.Run(state.Name(), func(t *testing.T) {
+			hub, _ := servertest.NewTestHub(server.DefaultConfig())
+			hub.UseDb(servertest.NewTestDb(t)) //the hiscores are loaded as soon as they're opened
+			client := servertest.NewTestClient(hub)
+			client.SetState(state)
+			t.Cleanup(func() { client.Close("test over") })
+			client.ClearSent()
+
+			heartbeat := &packets.Packet_Heartbeat{Heartbeat: &packets.HeartbeatMessage{}}
+			client.ProcessMessage(client.Id(), heartbeat)
+			client.ProcessMessage(client.Id()+1, heartbeat)
+
+			sent := client.SentMessages()
+			if len(sent) != 1 || len(servertest.MessagesOf[*packets.Packet_Heartbeat](sent)) != 1 {
+				t.Errorf("sent %v, want just our own heartbeat back", sent)
+			}
+		})
+	}
+}
+
+// A user left without a player (registration failed halfway) still gets into the game, with a
+// new player row to save to
+func TestLoginCreatesAMissingPlayer(t *testing.T) {
+	dbPool := servertest.NewTestDb(t)
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	hub.UseDb(dbPool)
+
+	ctx := context.Background()
+	queries := db.New(dbPool)
+	hash, err := bcrypt.GenerateFromPassword([]byte("hunter22"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := queries.CreateUser(ctx, db.CreateUserParams{Username: "halfway", PasswordHash: string(hash)}); err != nil {
+		t.Fatalf("creating the user: %v", err)
+	}
+
+	client := servertest.NewTestClient(hub)
+	client.SetState(&Connected{})
+	t.Cleanup(func() { client.Close("test over") })
+	client.ProcessMessage(client.Id(), &packets.Packet_LoginRequest{
+		LoginRequest: &packets.LoginRequestMessage{Username: "halfway", Password: "hunter22"},
+	})
+
+	state, inGame := client.State().(*InGame)
+	if !inGame {
+		t.Fatalf("client is in %s after logging in, sent %v", client.StateName(), client.SentMessages())
+	}
+	row, err := queries.GetPlayerByName(ctx, "halfway")
+	if err != nil {
+		t.Fatalf("the player wasn't created: %v", err)
+	}
+	if state.player.DbId != row.ID {
+		t.Errorf("player in game has DB id %d, want the new row's %d", state.player.DbId, row.ID)
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.2.0", "1.2.0", 0},
+		{"1.2", "1.2.0", 0},
+		{"1.10.0", "1.9.3", 1},
+		{"1.2.0", "1.2.1", -1},
+		{"2", "1.99.99", 1},
+		{"", "0.0.1", -1},
+		{"garbage", "0.0.1", -1},
+	}
+	for _, test := range tests {
+		if got := compareVersions(test.a, test.b); got != test.want {
+			t.Errorf("compareVersions(%q, %q) = %d, want %d", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func TestOldClientsCantGetIntoTheGame(t *testing.T) {
+	config := server.DefaultConfig()
+	config.MinClientVersion = "1.1.0"
+	hub, _ := servertest.NewTestHub(config)
+
+	for version, allowed := range map[string]bool{"1.0.9": false, "1.1.0": true} {
+		client := servertest.NewTestClient(hub)
+		client.SetState(&Connected{})
+		t.Cleanup(func() { client.Close("test over") })
+
+		client.ProcessMessage(client.Id(), &packets.Packet_ClientInfo{ClientInfo: &packets.ClientInfoMessage{Version: version}})
+		client.ProcessMessage(client.Id(), packets.NewEnterGame("player"))
+
+		updates := servertest.MessagesOf[*packets.Packet_UpdateRequired](client.SentMessages())
+		if allowed && (len(updates) != 0 || client.StateName() != "InGame") {
+			t.Errorf("version %s was told to update %d times and is in %s", version, len(updates), client.StateName())
+		}
+		//Told once on connecting and again when trying to play
+		if !allowed && (len(updates) != 2 || client.StateName() != "Connected") {
+			t.Errorf("version %s was told to update %d times and is in %s", version, len(updates), client.StateName())
+		}
+	}
+}
+
+func TestSeeThroughColorsFromBeforeAreReplaced(t *testing.T) {
+	if color := playerColor(0x11223380); !objects.ValidColor(color) {
+		t.Errorf("the saved see through color became %08x", uint32(color))
+	}
+	if color := playerColor(0x112233ff); color != 0x112233ff {
+		t.Errorf("a good saved color became %08x", uint32(color))
+	}
+}
+
+func TestOnlyPlayersInTheGameCanChat(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	client := servertest.NewTestClient(hub)
+	client.SetState(&Connected{})
+	t.Cleanup(func() { client.Close("test over") })
+	chat := &packets.Packet_Chat{Chat: &packets.ChatMessage{Msg: "hi"}}
+
+	client.ProcessMessage(client.Id(), chat)
+	if len(client.Broadcasts()) != 0 {
+		t.Error("chat from outside the game went out")
+	}
+	if len(servertest.MessagesOf[*packets.Packet_Error](client.SentMessages())) != 1 {
+		t.Error("wasn't told why the chat didn't go out")
+	}
+
+	//Someone else's chat just isn't shown, there's nothing to tell them
+	client.ClearSent()
+	client.ProcessMessage(client.Id()+1, chat)
+	if len(client.Sent()) != 0 {
+		t.Errorf("got %v for someone else's chat", client.SentMessages())
+	}
+
+	nameless, _ := joinGame(t, hub, "")
+	nameless.ProcessMessage(nameless.Id(), chat)
+	if len(servertest.MessagesOf[*packets.Packet_Chat](nameless.Broadcasts())) != 0 {
+		t.Error("a player without a name got to chat")
+	}
+}
+
+func TestMessagesAStateDoesntHandleGetAnError(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	client := servertest.NewTestClient(hub)
+	client.SetState(&Connected{})
+	t.Cleanup(func() { client.Close("test over") })
+	client.ClearSent()
+
+	client.ProcessMessage(client.Id(), &packets.Packet_Eject{Eject: &packets.EjectMessage{}})
+	errorPackets := servertest.MessagesOf[*packets.Packet_Error](client.SentMessages())
+	if len(errorPackets) != 1 || errorPackets[0].Error.Message != "A Eject message isn't valid in the Connected state" {
+		t.Errorf("sent %v, want an error naming the message and the state", client.SentMessages())
+	}
+
+	//Only our own client hears about its mistakes
+	client.ClearSent()
+	client.ProcessMessage(client.Id()+1, &packets.Packet_Eject{Eject: &packets.EjectMessage{}})
+	if len(client.Sent()) != 0 {
+		t.Errorf("sent %v for another client's message", client.SentMessages())
+	}
+}
+
+func TestWithoutTheDbOnlyGuestsGetIn(t *testing.T) {
+	//No UseDb, like a server that couldn't open its database and didn't require one
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	client := servertest.NewTestClient(hub)
+	client.SetState(&Connected{})
+	t.Cleanup(func() { client.Close("test over") })
+
+	client.ProcessMessage(client.Id(), &packets.Packet_LoginRequest{
+		LoginRequest: &packets.LoginRequestMessage{Username: "someone", Password: "hunter22"},
+	})
+	client.ProcessMessage(client.Id(), &packets.Packet_RegisterRequest{
+		RegisterRequest: &packets.RegisterRequestMessage{Username: "someone", Password: "hunter22"},
+	})
+	if denies := servertest.MessagesOf[*packets.Packet_DenyResponse](client.SentMessages()); len(denies) != 2 {
+		t.Fatalf("got %d denies for logging in and registering, want 2", len(denies))
+	}
+
+	client.ProcessMessage(client.Id(), packets.NewEnterGame("guest"))
+	if client.StateName() != "InGame" {
+		t.Errorf("guest is in %s, want them in the game", client.StateName())
+	}
+}
+
+func TestNobodyGetsIntoAFullGame(t *testing.T) {
+	config := server.DefaultConfig()
+	config.MaxPlayers = 1
+	hub, _ := servertest.NewTestHub(config)
+	joinGame(t, hub, "first")
+
+	client := servertest.NewTestClient(hub)
+	client.SetState(&Connected{})
+	t.Cleanup(func() { client.Close("test over") })
+	client.ProcessMessage(client.Id(), packets.NewEnterGame("second"))
+	if client.StateName() != "Connected" {
+		t.Errorf("got into a full game, in %s", client.StateName())
+	}
+	if len(servertest.MessagesOf[*packets.Packet_DenyResponse](client.SentMessages())) != 1 {
+		t.Error("wasn't told the game is full")
+	}
+
+	//Asking about the server still works, and shows why
+	client.ProcessMessage(client.Id(), &packets.Packet_RequestServerInfo{RequestServerInfo: &packets.RequestServerInfoMessage{}})
+	infos := servertest.MessagesOf[*packets.Packet_ServerInfo](client.SentMessages())
+	if len(infos) != 1 || infos[0].ServerInfo.Players != 1 || infos[0].ServerInfo.MaxPlayers != 1 {
+		t.Errorf("sent %v, want the server info with the game full", infos)
+	}
+}
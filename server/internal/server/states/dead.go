@@ -0,0 +1,79 @@
+package states
+
+import (
+	"fmt"
+	"log"
+	"server/internal/server"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+)
+
+// State for a player that was just eaten, it stays here (on the client's death screen) until the
+// client asks to respawn, instead of being thrown right back into the game
+type Dead struct {
+	client server.ClientInterfacer
+	logger *log.Logger
+	player *objects.Player //kept so the player comes back with its DB id, best score, look and settings
+}
+
+func (d *Dead) Name() string {
+	return "Dead"
+}
+
+func (d *Dead) SetClient(client server.ClientInterfacer) {
+	d.client = client
+	loggingPrefix := fmt.Sprintf("Client %d [%s]: ", client.Id(), d.Name())
+	d.logger = log.New(client.LogWriter(), loggingPrefix, log.LstdFlags)
+}
+
+func (d *Dead) OnEnter() {
+
+}
+
+func (d *Dead) HandleMessage(senderId uint64, message packets.Msg) {
+	switch message := message.(type) {
+	case *packets.Packet_Respawn:
+		d.handleRespawn(senderId, message)
+	case *packets.Packet_Disconnect:
+		//Logging out from the death screen, the player already left the game when it was eaten
+		if senderId == d.client.Id() {
+			d.client.SetState(&Connected{})
+		}
+	case *packets.Packet_Chat:
+		rejectChat(d.client, senderId)
+	case *packets.Packet_Heartbeat:
+		echoHeartbeat(d.client, senderId, message)
+
+	//The death screen can still show what's going on in the game
+	case *packets.Packet_KillFeed:
+		d.client.SocketSendAs(message, senderId)
+	case *packets.Packet_PlayerJoined:
+		d.client.SocketSendAs(message, senderId)
+	case *packets.Packet_PlayerLeft:
+		d.client.SocketSendAs(message, senderId)
+	case *packets.Packet_Leaderboard:
+		d.client.SocketSendAs(message, senderId)
+	case *packets.Packet_LeaderboardDelta:
+		d.client.SocketSendAs(message, senderId)
+	case *packets.Packet_Event:
+		d.client.SocketSendAs(message, senderId)
+	default:
+		rejectUnsupported(d.client, senderId, message, d.Name())
+	}
+}
+
+func (d *Dead) OnExit() {
+
+}
+
+// Puts the player back in the game, OnEnter of the game gives it a safe spot and the starting size
+func (d *Dead) handleRespawn(senderId uint64, _ *packets.Packet_Respawn) {
+	if senderId != d.client.Id() {
+		return
+	}
+
+	d.logger.Println("Respawning")
+	d.client.SetState(&InGame{
+		player: d.player,
+	})
+}
@@ -0,0 +1,69 @@
+package states
+
+import (
+	"server/internal/server"
+	"server/internal/server/objects"
+	"server/internal/servertest"
+	"server/pkg/packets"
+	"testing"
+)
+
+// The two ways off the death screen the client has, its respawn and logout buttons
+func TestDeathScreenRespawnsOrLogsOut(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+
+	respawner := servertest.NewTestClient(hub)
+	respawner.SetState(&Dead{player: &objects.Player{Name: "again"}})
+	t.Cleanup(func() { respawner.Close("test over") })
+	respawner.ProcessMessage(respawner.Id(), &packets.Packet_Respawn{Respawn: &packets.RespawnMessage{}})
+	if respawner.StateName() != "InGame" {
+		t.Errorf("respawning went to %s, want InGame", respawner.StateName())
+	}
+
+	quitter := servertest.NewTestClient(hub)
+	quitter.SetState(&Dead{player: &objects.Player{Name: "done"}})
+	t.Cleanup(func() { quitter.Close("test over") })
+
+	//Someone else leaving is nothing to do with us
+	quitter.ProcessMessage(respawner.Id(), packets.NewDisconnect("they logged out"))
+	if quitter.StateName() != "Dead" {
+		t.Fatalf("another client's disconnect moved us to %s", quitter.StateName())
+	}
+
+	quitter.ProcessMessage(quitter.Id(), packets.NewDisconnect("they logged out"))
+	if quitter.StateName() != "Connected" {
+		t.Errorf("logging out from the death screen went to %s, want Connected", quitter.StateName())
+	}
+}
+
+// A respawn is the same player starting over, only its spot and size are new
+func TestRespawnKeepsThePlayer(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	eater, eaterState := joinGame(t, hub, "eater")
+	victim, victimState := joinGame(t, hub, "victim")
+	player := victimState.player
+	player.DbId, player.BestScore = 42, 900
+	player.Color, player.SkinId = 0x3cb44bff, 3
+	player.Direction, player.TargetDirection = 1.2, 1.2
+	lineUpMeal(eaterState.player, player)
+
+	eatPlayer(eater, victim.Id())
+	victim.ProcessMessage(eater.Id(), servertest.MessagesOf[*packets.Packet_PlayerConsumed](eater.Broadcasts())[0])
+	victim.RunQueued()
+	victim.ProcessMessage(victim.Id(), &packets.Packet_Respawn{Respawn: &packets.RespawnMessage{}})
+	waitFor(t, "the victim to be back in the game", func() bool {
+		_, exists := hub.SharedGameObjects.Players.Get(victim.Id())
+		return exists
+	})
+
+	respawned, _ := hub.SharedGameObjects.Players.Get(victim.Id())
+	if respawned != player {
+		t.Fatal("the respawned player is a new object")
+	}
+	if player.DbId != 42 || player.BestScore != 900 || player.Color != 0x3cb44bff || player.SkinId != 3 {
+		t.Errorf("respawned player lost what it had: %+v", player)
+	}
+	if player.Radius != startingRadius || player.Direction != 0 || player.TargetDirection != 0 {
+		t.Errorf("respawned with radius %f heading %f/%f, want a fresh start", player.Radius, player.Direction, player.TargetDirection)
+	}
+}
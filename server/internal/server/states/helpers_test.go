@@ -0,0 +1,59 @@
+package states
+
+import (
+	"server/internal/server"
+	"server/internal/server/objects"
+	"server/internal/servertest"
+	"server/pkg/packets"
+	"testing"
+	"time"
+)
+
+// Waits up to a second for the condition, for things the states do in the background
+func waitFor(t *testing.T, what string, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// Puts a new test client in game, once its player is in the shared collection
+func joinGame(t *testing.T, hub *server.Hub, name string) (*servertest.TestClient, *InGame) {
+	t.Helper()
+	client := servertest.NewTestClient(hub)
+	state := &InGame{player: &objects.Player{Name: name}}
+	client.SetState(state)
+	waitFor(t, name+" to be in the game", func() bool {
+		_, exists := hub.SharedGameObjects.Players.Get(client.Id())
+		return exists
+	})
+	t.Cleanup(func() { client.Close("test over") })
+	return client, state
+}
+
+// Puts the victim right on top of the eater and makes the eater big enough to eat it
+func lineUpMeal(eater, victim *objects.Player) {
+	eater.Radius = 100
+	victim.Radius = 20
+	victim.X, victim.Y = eater.X, eater.Y
+}
+
+// Has the client's player eat the other client's player
+func eatPlayer(eater *servertest.TestClient, victimId uint64) {
+	eater.ProcessMessage(eater.Id(), &packets.Packet_PlayerConsumed{
+		PlayerConsumed: &packets.PlayerConsumedMessage{PlayerId: victimId},
+	})
+}
+
+// An InGame state that's set up but never entered, so no update loop runs and the test ticks it
+// itself with syncPlayer
+func unenteredGame(hub *server.Hub, player *objects.Player) (*servertest.TestClient, *InGame) {
+	client := servertest.NewTestClient(hub)
+	state := &InGame{player: player}
+	state.SetClient(client)
+	return client, state
+}
@@ -10,7 +10,10 @@ import (
 	"server/internal/server/db"
 	"server/internal/server/objects"
 	"server/pkg/packets"
+	"slices"
+	"sync/atomic"
 	"time"
+	"unicode/utf8"
 )
 
 // Structure that defines the elements of ingame state
@@ -19,8 +22,50 @@ type InGame struct {
 	player                 *objects.Player
 	logger                 *log.Logger
 	cancelPlayerUpdateLoop context.CancelFunc
+	cancelBestScoreLoop    context.CancelFunc
+	emoteLimiter           *server.RateLimiter
+	ejectLimiter           *server.RateLimiter
+	chatLimiter            *server.RateLimiter
+	typingLimiter          *server.RateLimiter
+	resyncLimiter          *server.RateLimiter
+	colorLimiter           *server.RateLimiter
+	massEaten              float64 //total mass consumed this life, saved to the match history on exit
+	sporesEaten            int     //the rest of the stats for this life, also saved and added to the session
+	playersEaten           int
+	maxMass                float64
+	distance               float64
+	eaten                  bool
+	exited                 bool //OnExit already ran, so tasks queued before it have nothing to do
+	idleTimer              *time.Timer
+	lastInput              atomic.Int64 //unix nanos of the last time the player played (steered or ate something)
+	afk                    atomic.Bool  //read and written by the update loop too
+	lastAfkUpdate          time.Time    //when the last update went out while AFK
+	reconnected            bool         //the player was taken over from a dropped client, so it keeps its spot and size
+	settingsChanged        bool         //the settings were changed in the menu, so they get saved once we're in
+	reportedX, reportedY   float64      //where our client last said the player is, for the divergence self echo
+	reported               bool
+	typing                 bool          //whether nearby players were last told we're typing
+	ticks                  atomic.Uint32 //player updates since the last connection stats, for the tick rate
+
+	//Players we ate recently and when, so a duplicated PlayerConsumed gets ignored
+	recentVictims map[uint64]time.Time
 }
 
+// Emotes are only shown to players within this distance of the sender
+const emoteRadius float64 = 1500
+
+// Same for the typing indicator
+const typingRadius float64 = 1500
+
+// Players can't shrink below this radius from losing mass
+const minPlayerRadius float64 = 10
+
+// The radius players start the game with
+const startingRadius float64 = 25
+
+// Most spores a player can eat in one batch, a big player sweeping over a field can't overlap more
+const maxBatchConsume = 50
+
 //The functions below are here to satisfy the constructor of ClientStateHandler in Hub.gp
 
 // Function that returns the name of the state
@@ -33,7 +78,14 @@ func (g *InGame) Name() string {
 func (g *InGame) SetClient(client server.ClientInterfacer) {
 	g.client = client
 	loggingPrefix := fmt.Sprintf("Client %d [%s]: ", client.Id(), g.Name())
-	g.logger = log.New(log.Writer(), loggingPrefix, log.LstdFlags)
+	g.logger = log.New(client.LogWriter(), loggingPrefix, log.LstdFlags)
+	g.emoteLimiter = server.NewRateLimiter(3, 0.5, client.Clock())  //burst of 3 emotes, then one every 2 seconds
+	g.ejectLimiter = server.NewRateLimiter(5, 5, client.Clock())    //up to 5 ejects a second
+	g.chatLimiter = server.NewRateLimiter(5, 1, client.Clock())     //burst of 5 messages, then one a second
+	g.typingLimiter = server.NewRateLimiter(4, 1, client.Clock())   //starting and stopping a couple of times, then once a second
+	g.resyncLimiter = server.NewRateLimiter(2, 0.2, client.Clock()) //a couple in a row, then one every 5 seconds
+	g.colorLimiter = server.NewRateLimiter(3, 0.5, client.Clock())  //trying a few out, then one every 2 seconds
+	g.recentVictims = make(map[uint64]time.Time)
 }
 
 // Function that defines what happens when player enters the game, it logs a message and
@@ -44,19 +96,73 @@ func (g *InGame) OnEnter() {
 	go g.client.SharedGameObjects().Players.Add(g.player, g.client.Id())
 
 	//Setting the initial player properties such as mass, position etc
-	g.player.X, g.player.Y = objects.SpawnCoords(g.player.Radius, g.client.SharedGameObjects().Players, nil)
-	g.player.Speed = 150.0
-	g.player.Radius = 25
+	if !g.reconnected {
+		g.player.Radius = startingRadius
+		g.player.X, g.player.Y = objects.SafeSpawnCoords(g.player.Radius, g.client.SharedGameObjects().WorldBound.Get(), g.client.SharedGameObjects().Players, g.client.Config().SpawnDangerRadius, g.client.Config().ConsumeMassRatio)
+		g.player.Speed = 150.0
+		g.player.Direction = 0
+		g.player.TargetDirection = 0
+	}
 
 	//Sending the initial state of the player to the client
-	g.client.SocketSend(packets.NewPlayer(g.client.Id(), g.player))
+	g.client.SocketSend(packets.NewPlayer(g.client.Id(), g.player, g.client.SharedGameObjects().WorldBound))
+	g.client.SocketSendReliable(packets.NewGameConfig(g.client.SharedGameObjects().WorldBound.Get()))
+	g.client.SocketSend(packets.NewSettings(g.player))
+	if g.player.Settings.ChatEnabled {
+		g.client.SocketSend(packets.NewChatHistory(g.player.Mutes.Filter(g.client.ChatHistory().Snapshot())))
+	}
+	if g.settingsChanged {
+		go g.savePlayerSettings()
+	}
+	g.openReconnectSlot()
+	sendLeaderboard(g.client)
+	sendRoster(g.client)
+
+	//A player coming back from a dropped connection never left as far as everyone else knows
+	if !g.reconnected {
+		g.client.Broadcast(packets.NewPlayerJoined(g.client.Id(), g.player))
+	}
 
 	//Sending the spores to the client in the background using go routines
 	go g.sendInitialSpores(20, 50*time.Millisecond)
+
+	//Joining in the middle of events, so we need to know they're on
+	sendActiveEvents(g.client)
+
+	//Joining mid countdown, so we need to know how long is left
+	if remaining := g.client.Round().Remaining(); remaining > 0 {
+		g.client.SocketSend(packets.NewCountdown(remaining))
+	}
+
+	now := g.client.Clock().Now()
+	g.lastInput.Store(now.UnixNano())
+	if g.player.Session.StartedAt.IsZero() {
+		g.player.Session.StartedAt = now
+	}
+	g.client.EventBus().Publish(server.PlayerJoined{PlayerId: g.client.Id(), Player: g.player})
+
+	//Saving the best score every so often instead of after everything the player eats
+	ctx, cancel := context.WithCancel(context.Background())
+	g.cancelBestScoreLoop = cancel
+	go g.bestScoreLoop(ctx, g.client.Config().BestScoreSyncInterval)
+	if interval := g.client.Config().ConnectionStatsInterval; interval > 0 {
+		go g.connectionStatsLoop(ctx, interval) //lasts as long as the best score loop, so it's cancelled with it
+	}
+
+	//Kicking the player if they never do anything
+	if timeout := g.client.Config().IdleKickTimeout; timeout > 0 {
+		g.idleTimer = time.AfterFunc(timeout, func() { g.client.RunLater(g.kickIdle) })
+	}
 }
 
 // Handling chat
 func (g *InGame) HandleMessage(senderId uint64, message packets.Msg) {
+	//Anything our own client sends means the player isn't idle, except heartbeats which the client
+	//sends on its own
+	if _, heartbeat := message.(*packets.Packet_Heartbeat); senderId == g.client.Id() && !heartbeat {
+		g.markActive()
+	}
+
 	switch message := message.(type) {
 	case *packets.Packet_Player:
 		g.handlePlayer(senderId, message) //ignores the message if the client and sender IDs are same
@@ -66,38 +172,222 @@ func (g *InGame) HandleMessage(senderId uint64, message packets.Msg) {
 		g.HandleChat(senderId, message)
 	case *packets.Packet_SporeConsumed:
 		g.handleSporeConsumed(senderId, message)
+	case *packets.Packet_BatchConsume:
+		g.handleBatchConsume(senderId, message)
 	case *packets.Packet_PlayerConsumed:
 		g.handlePlayerConsumed(senderId, message)
 	case *packets.Packet_Spore:
 		g.handleSpore(senderId, message)
 	case *packets.Packet_Disconnect:
 		g.handleDisconnect(senderId, message)
+	case *packets.Packet_Emote:
+		g.handleEmote(senderId, message)
+	case *packets.Packet_Eject:
+		g.handleEject(senderId, message)
+	case *packets.Packet_Typing:
+		g.handleTyping(senderId, message)
+	case *packets.Packet_RequestResync:
+		g.handleRequestResync(senderId, message)
+	case *packets.Packet_ChangeColor:
+		g.handleChangeColor(senderId, message)
+	case *packets.Packet_Mute:
+		g.handleMute(senderId, message)
+	case *packets.Packet_Unmute:
+		g.handleUnmute(senderId, message)
+	case *packets.Packet_Respawn:
+		if senderId == g.client.Id() {
+			g.client.SocketSend(packets.NewError("You can't respawn while you're still alive"))
+		}
+	case *packets.Packet_WorldBounds:
+		g.client.SocketSendAs(message, senderId)
+	case *packets.Packet_KillFeed:
+		g.client.SocketSendAs(message, senderId)
+	case *packets.Packet_Leaderboard:
+		g.client.SocketSendAs(message, senderId)
+	case *packets.Packet_LeaderboardDelta:
+		g.client.SocketSendAs(message, senderId)
+	case *packets.Packet_Event:
+		g.client.SocketSendAs(message, senderId)
+	case *packets.Packet_PlayerJoined:
+		g.client.SocketSendAs(message, senderId)
+	case *packets.Packet_PlayerLeft:
+		g.client.SocketSendAs(message, senderId)
+	case *packets.Packet_Minimap:
+		if g.player.Settings.MinimapEnabled {
+			g.client.SocketSendAs(message, senderId)
+		}
+	case *packets.Packet_SporesDespawned:
+		g.client.SocketSendAs(message, senderId)
+	case *packets.Packet_Heartbeat:
+		echoHeartbeat(g.client, senderId, message)
+	case *packets.Packet_Countdown:
+		g.handleCountdown(senderId, message)
+	case *packets.Packet_Settings:
+		g.handleSettings(senderId, message)
+	default:
+		rejectUnsupported(g.client, senderId, message, g.Name())
+	}
+}
+
+// Goes to the death screen after being eaten, unless we already left the game some other way
+func (g *InGame) die() {
+	if g.exited {
+		return
 	}
+	g.client.SetState(&Dead{
+		player: g.player,
+	})
 }
 
 // To cleanup once the player leaves and free up memory
 func (g *InGame) OnExit() {
+	g.exited = true
 	if g.cancelPlayerUpdateLoop != nil {
 		g.cancelPlayerUpdateLoop()
 	}
-	g.client.SharedGameObjects().Players.Remove(g.client.Id())
+	if g.cancelBestScoreLoop != nil {
+		g.cancelBestScoreLoop()
+	}
+	if g.idleTimer != nil {
+		g.idleTimer.Stop()
+		g.idleTimer = nil
+	}
+
+	//If the connection dropped, the player stays in the game for a bit in case the client reconnects
+	if g.client.Closing() && g.client.ReconnectSlots().Park(g.client.Id(), g.client.Config().ReconnectGrace, g.leave) {
+		g.logger.Println("Connection dropped, keeping the player around for a reconnect")
+		return
+	}
+	g.client.ReconnectSlots().Forget(g.client.Id())
+	g.leave()
+}
+
+// Takes the player out of the game for good and saves how it did
+func (g *InGame) leave() {
+	//The final mass is read now, the player object gets reset right away if it's respawning
+	finalMass := radToMass(g.player.Radius)
+	rank, total := g.finalRank(finalMass)
+
+	if !g.client.SharedGameObjects().Players.Remove(g.client.Id()) {
+		g.logger.Println("Player was already removed from the shared collection (consumed)")
+	}
+	g.client.EventBus().Publish(server.PlayerLeft{PlayerId: g.client.Id()})
+	g.client.Broadcast(packets.NewPlayerLeft(g.client.Id()))
 	g.syncPlayerBestScore()
+	g.sendMatchSummary()
+
+	sessionLength := g.client.Clock().Now().Sub(g.player.Session.StartedAt)
+	g.client.SocketSendReliable(packets.NewGameOver(finalMass, g.maxMass, rank, total, sessionLength))
+
+	go g.saveMatchHistory(finalMass)
+}
+
+// Where the player places with the given mass among everyone else still in the game, and out of how many
+func (g *InGame) finalRank(finalMass float64) (uint32, uint32) {
+	others := objects.RankPlayers(g.client.SharedGameObjects().Players, func(id uint64, _ *objects.Player) bool {
+		return id != g.client.Id()
+	})
+
+	rank := 1
+	for _, entry := range others {
+		if entry.Mass > finalMass {
+			rank++
+		}
+	}
+	return uint32(rank), uint32(len(others) + 1)
+}
+
+// Adds this life's stats to the session and sends the player the totals so far
+func (g *InGame) sendMatchSummary() {
+	g.maxMass = max(g.maxMass, radToMass(g.player.Radius))
+
+	session := &g.player.Session
+	session.SporesEaten += g.sporesEaten
+	session.PlayersEaten += g.playersEaten
+	session.MaxMass = max(session.MaxMass, g.maxMass)
+	session.Distance += g.distance
+	if g.eaten {
+		session.TimesEaten++
+	}
+
+	g.client.SocketSend(packets.NewMatchSummary(*session))
+}
+
+// Gives the client what it needs to take the player back if the connection drops
+func (g *InGame) openReconnectSlot() {
+	if g.client.Config().ReconnectGrace <= 0 {
+		return
+	}
+
+	secret, err := g.client.ReconnectSlots().Open(g.client, g.player)
+	if err != nil {
+		g.logger.Printf("Error opening a reconnect slot: %v", err)
+		return
+	}
+	g.client.SocketSendReliable(packets.NewReconnect(g.client.Id(), secret))
+}
+
+// Function to pass the countdown on to the client, once it hits 0 the spores have just been placed
+// so they get sent over
+// Only the hub counts down, a client sending its own countdown would get every spore sent again
+func (g *InGame) handleCountdown(senderId uint64, message *packets.Packet_Countdown) {
+	if senderId != 0 {
+		g.logger.Printf("Dropping a countdown from %d, only the server counts down", senderId)
+		return
+	}
+
+	g.client.SocketSendAs(message, senderId)
+	if message.Countdown.Seconds == 0 {
+		go g.sendInitialSpores(20, 50*time.Millisecond)
+	}
 }
 
 // Function to log if sender id and client id match
 func (g *InGame) handlePlayer(senderId uint64, message *packets.Packet_Player) {
+	//The server decides where our player is, but a client doing its own prediction tells us where it
+	//thinks it is, so we know when to correct it
 	if senderId == g.client.Id() {
-		g.logger.Println("Recoeved player messages from our own client, ignoring")
+		g.reportedX, g.reportedY = message.Player.X, message.Player.Y
+		g.reported = true
 		return
 	}
 
-	g.client.SocketSendAs(message, senderId)
+	if verified, ok := verifyPeerPlayer(g.client, senderId, message); ok {
+		g.client.SocketSendAs(verified, senderId)
+	}
+}
+
+// Checks a player update from another client against what the server has for that player, since
+// a modified client could send fake positions for itself. Updates that are close enough to the
+// server's go through as they are, the rest get replaced with the server's version
+// Returns false if the player isn't in the game anymore, so there's nothing to send
+func verifyPeerPlayer(client server.ClientInterfacer, senderId uint64, message *packets.Packet_Player) (*packets.Packet_Player, bool) {
+	player, exists := client.SharedGameObjects().Players.Get(senderId)
+	if !exists {
+		return nil, false
+	}
+
+	tolerance := client.Config().PeerPositionTolerance
+	dx := message.Player.X - player.X
+	dy := message.Player.Y - player.Y
+	if message.Player.Id == senderId && dx*dx+dy*dy <= tolerance*tolerance && math.Abs(message.Player.Radius-player.Radius) <= tolerance {
+		return message, true
+	}
+
+	return packets.NewPlayer(senderId, player, client.SharedGameObjects().WorldBound).(*packets.Packet_Player), true
 }
 
 // Function to
 func (g *InGame) handlePlayerDirection(senderId uint64, message *packets.Packet_PlayerDirection) {
 	if senderId == g.client.Id() {
-		g.player.Direction = message.PlayerDirection.Direction
+		//Holding a steady heading is still playing, the client only keeps sending it while it's steering
+		g.markPlaying()
+
+		//Without a turn rate limit we turn right away, otherwise syncPlayer turns us bit by bit
+		g.player.TargetDirection = message.PlayerDirection.Direction
+		if g.client.Config().MaxTurnRate <= 0 {
+			g.player.Direction = g.player.TargetDirection
+		}
 
 		//If it's the first time recieveing direction updates from the player, we'll start the
 		//UpdatePlayerDirectionLoop
@@ -111,71 +401,311 @@ func (g *InGame) handlePlayerDirection(senderId uint64, message *packets.Packet_
 
 func (g *InGame) HandleChat(senderId uint64, message *packets.Packet_Chat) {
 	if senderId == g.client.Id() {
+		if g.player.Name == "" {
+			g.client.SocketSend(packets.NewError("You need a name to chat"))
+			return
+		}
+		if maxLength := g.client.Config().ChatMaxLength; maxLength > 0 && utf8.RuneCountInString(message.Chat.Msg) > maxLength {
+			g.client.SocketSend(packets.NewChatError(fmt.Sprintf("Messages can't be longer than %d characters", maxLength)))
+			return
+		}
+		if !g.chatLimiter.Allow() {
+			g.client.SocketSend(packets.NewChatError("You're sending messages too fast"))
+			return
+		}
+
+		g.client.ChatHistory().Add(objects.ChatEntry{
+			SenderId:   senderId,
+			SenderName: g.player.Name,
+			Message:    message.Chat.Msg,
+			SentAt:     g.client.Clock().Now(),
+		})
 		g.client.Broadcast(message)
-	} else {
+	} else if g.player.Settings.ChatEnabled && !g.player.Mutes.Muted(senderId) {
 		g.client.SocketSendAs(message, senderId)
 	}
 }
 
+// Function to stop passing on a player's chat to us, done here and not just on the client so it
+// sticks even with a modified client
+func (g *InGame) handleMute(senderId uint64, message *packets.Packet_Mute) {
+	if senderId != g.client.Id() {
+		return
+	}
+
+	targetId := message.Mute.TargetId
+	if targetId == g.client.Id() {
+		g.client.SocketSend(packets.NewError("You can't mute yourself"))
+		return
+	}
+	if _, exists := g.client.SharedGameObjects().Players.Get(targetId); !exists {
+		g.client.SocketSend(packets.NewError("That player isn't in the game"))
+		return
+	}
+
+	g.player.Mutes.Mute(targetId)
+	g.logger.Printf("Muted player %d", targetId)
+	g.client.SocketSend(packets.NewMute(targetId)) //so the client knows it went through
+}
+
+// Function to hear from a muted player again
+func (g *InGame) handleUnmute(senderId uint64, message *packets.Packet_Unmute) {
+	if senderId != g.client.Id() {
+		return
+	}
+
+	g.player.Mutes.Unmute(message.Unmute.TargetId)
+	g.logger.Printf("Unmuted player %d", message.Unmute.TargetId)
+	g.client.SocketSend(packets.NewUnmute(message.Unmute.TargetId))
+}
+
+// Settings a client sent, already checked and ready to go on its player
+type pickedSettings struct {
+	settings objects.PlayerSettings
+	color    int32
+	skinId   uint32
+}
+
+// Function to check the settings a client sent, the client gets told what's wrong if they can't be used
+func readSettings(client server.ClientInterfacer, message *packets.Packet_Settings) (pickedSettings, bool) {
+	if !objects.ValidColor(message.Settings.Color) {
+		client.SocketSend(packets.NewError("The color can't be see through"))
+		return pickedSettings{}, false
+	}
+	if !objects.ValidSkinId(message.Settings.SkinId) {
+		client.SocketSend(packets.NewError("That skin doesn't exist"))
+		return pickedSettings{}, false
+	}
+	selfEcho := objects.SelfEcho(message.Settings.SelfEcho)
+	if !objects.ValidSelfEcho(selfEcho) {
+		client.SocketSend(packets.NewError("Unknown self echo setting"))
+		return pickedSettings{}, false
+	}
+
+	return pickedSettings{
+		settings: objects.PlayerSettings{
+			ChatEnabled:    message.Settings.ChatEnabled,
+			MinimapEnabled: message.Settings.MinimapEnabled,
+			AutoCollect:    message.Settings.AutoCollect,
+			SelfEcho:       selfEcho,
+			HapticsEnabled: message.Settings.HapticsEnabled,
+		},
+		color:  message.Settings.Color,
+		skinId: message.Settings.SkinId,
+	}, true
+}
+
+// Puts the settings on the player, returns whether its color or skin changed
+func (p pickedSettings) apply(player *objects.Player) bool {
+	lookChanged := player.Color != p.color || player.SkinId != p.skinId
+	player.Settings = p.settings
+	player.Color = p.color
+	player.SkinId = p.skinId
+	return lookChanged
+}
+
+// Function to change the player's preferences, registered players get them saved for next time
+func (g *InGame) handleSettings(senderId uint64, message *packets.Packet_Settings) {
+	if senderId != g.client.Id() {
+		return
+	}
+
+	picked, ok := readSettings(g.client, message)
+	if !ok {
+		return
+	}
+
+	//These go out to everyone with the next player update, but a change gets told right away too
+	if picked.apply(g.player) {
+		g.client.Broadcast(packets.NewChangeColor(g.client.Id(), g.player.Color, g.player.SkinId))
+	}
+
+	g.client.SocketSend(packets.NewSettings(g.player))
+	if g.player.Settings.ChatEnabled {
+		g.client.SocketSend(packets.NewChatHistory(g.player.Mutes.Filter(g.client.ChatHistory().Snapshot())))
+	}
+	go g.savePlayerSettings()
+}
+
+func (g *InGame) savePlayerSettings() {
+	//Guests don't have a row in the DB, their settings only last until they leave
+	if g.player.DbId == 0 || !g.client.DbTx().Available() {
+		return
+	}
+
+	queries := g.client.DbTx().Queries
+	ctx := g.client.DbTx().Ctx
+
+	err := queries.UpsertPlayerSettings(ctx, db.UpsertPlayerSettingsParams{
+		PlayerID:       g.player.DbId,
+		ChatEnabled:    g.player.Settings.ChatEnabled,
+		MinimapEnabled: g.player.Settings.MinimapEnabled,
+		AutoCollect:    g.player.Settings.AutoCollect,
+		SelfEcho:       int64(g.player.Settings.SelfEcho),
+		HapticsEnabled: g.player.Settings.HapticsEnabled,
+	})
+	if err != nil {
+		g.logger.Printf("Error saving the player settings: %v", err)
+		return
+	}
+
+	err = queries.UpdatePlayerLook(ctx, db.UpdatePlayerLookParams{
+		ID:     g.player.DbId,
+		Color:  int64(g.player.Color),
+		SkinID: int64(g.player.SkinId),
+	})
+	if err != nil {
+		g.logger.Printf("Error saving the player color and skin: %v", err)
+	}
+}
+
 func (g *InGame) handleSporeConsumed(senderId uint64, message *packets.Packet_SporeConsumed) {
-	//If the info is coming from another client, it means the checks were already performed on
-	//that client's server side. So we'll forward the message to godot directly
+	//Consumptions are only decided here, by the eater's own state below, and broadcast once they've
+	//been applied. Clients can't fake the sender id (the read pump stamps their own on everything),
+	//so one from another sender is a decision that was already made, we just pass it on to godot
 	if senderId != g.client.Id() {
-		g.client.SocketSendAs(message, senderId)
+		if g.inConsumeEventRange(senderId) {
+			g.client.SocketSendAs(message, senderId)
+		} else {
+			//Too far to see who ate it, but the spore still has to go away on our side
+			g.client.SocketSend(packets.NewSporesDespawned([]uint64{message.SporeConsumed.SporeId}))
+		}
 		return
 	}
 
-	//If the spore was consumed by our player, we'll have to verify
-	errMsg := "Could not verify spore consumption: "
+	sporeId := message.SporeConsumed.SporeId
+	sporeMass, eaten := g.eatSpore(sporeId, "Could not verify spore consumption: ")
+	if !eaten {
+		return
+	}
+
+	message.SporeConsumed.Mass = sporeMass
+	message.SporeConsumed.Feedback = g.sporeFeedback(sporeMass)
+	g.client.Broadcast(message)
+	g.client.EventBus().Publish(server.SporeEaten{PlayerId: g.client.Id(), SporeId: sporeId, Mass: sporeMass})
+}
+
+// Function to handle a bunch of spores eaten in one go, every spore goes through the same checks
+// as a single one but the mass is broadcast once for all of them
+func (g *InGame) handleBatchConsume(senderId uint64, message *packets.Packet_BatchConsume) {
+	//Same as single spores, anything from another client was already decided and applied by its state
+	if senderId != g.client.Id() {
+		if g.inConsumeEventRange(senderId) {
+			g.client.SocketSendAs(message, senderId)
+		} else {
+			g.client.SocketSend(packets.NewSporesDespawned(message.BatchConsume.SporeIds))
+		}
+		return
+	}
+
+	errMsg := "Could not verify batch spore consumption: "
+
+	sporeIds := message.BatchConsume.SporeIds
+	if len(sporeIds) > maxBatchConsume {
+		g.logger.Printf(errMsg+"too many spores in one batch (%d, max %d)", len(sporeIds), maxBatchConsume)
+		return
+	}
 
+	//Each one is eaten like a single spore would be, the ones that don't hold up are left out
+	//(a spore in the batch twice is only eaten the first time)
+	consumedIds := make([]uint64, 0, len(sporeIds))
+	masses := make([]float64, 0, len(sporeIds))
+	totalMass := 0.0
+	for _, sporeId := range sporeIds {
+		sporeMass, eaten := g.eatSpore(sporeId, errMsg)
+		if !eaten {
+			continue
+		}
+		consumedIds = append(consumedIds, sporeId)
+		masses = append(masses, sporeMass)
+		totalMass += sporeMass
+	}
+
+	if len(consumedIds) == 0 {
+		return
+	}
+
+	//The feedback goes with the biggest spore in there, one big spore in a sweep still feels big
+	g.client.Broadcast(packets.NewBatchConsume(consumedIds, totalMass, g.sporeFeedback(slices.Max(masses))))
+	for i, sporeId := range consumedIds {
+		g.client.EventBus().Publish(server.SporeEaten{PlayerId: g.client.Id(), SporeId: sporeId, Mass: masses[i]})
+	}
+}
+
+// Checks a spore our player says it ate and, if it holds up, removes it and grows the player
+// Single spores and batches both go through here so they're checked, reported and counted the same
+// Returns the mass the player got and whether the spore was eaten
+func (g *InGame) eatSpore(sporeId uint64, errMsg string) (float64, bool) {
 	//First, checking if the spore exists
-	sporeId := message.SporeConsumed.SporeId
 	spore, err := g.getSpore(sporeId)
 	if err != nil {
-		g.logger.Println(errMsg + err.Error())
-		return
+		g.reportSuspicion(server.SuspicionMissingObject, errMsg+err.Error())
+		return 0, false
 	}
 
 	//Now checkin if the spore is close enough to be consumed
-	err = g.validatePlayerCloseToObjects(spore.X, spore.Y, spore.Radius, 10)
+	err = g.validatePlayerCloseToObjects(spore.X, spore.Y, spore.Radius, g.consumeBuffer())
 	if err != nil {
-		g.logger.Println(errMsg + err.Error())
-		return
+		g.reportSuspicion(server.SuspicionTooFar, errMsg+err.Error())
+		return 0, false
 	}
 
-	//Finally, check if the spore wasn't dropped by the player too recently
+	//Then check if the spore wasn't dropped too recently (by anyone, and by us even more so)
 	err = g.validatePlayerDropCooldown(spore, 10)
 	if err != nil {
-		g.logger.Println(errMsg + err.Error())
-		return
+		g.reportSuspicion(server.SuspicionDropCooldown, errMsg+err.Error())
+		return 0, false
 	}
 
-	//If we make it this far, it means the spore consumption is valid, so we'll grow the player
-	//and remove the spore as well as broadcast the event
-	sporeMass := radToMass(spore.Radius)
-	g.player.Radius = g.nextRadius(sporeMass)
+	//Finally, checking we're big enough to eat it, if the config asks for that
+	err = g.validateMassiveEnoughForSpore(spore)
+	if err != nil {
+		g.reportSuspicion(server.SuspicionNotMassive, errMsg+err.Error())
+		return 0, false
+	}
 
-	go g.client.SharedGameObjects().Spores.Remove(sporeId)
+	//If we make it this far, it means the spore consumption is valid, so we'll remove the spore
+	//and grow the player
+	//The spore is removed first so only one player can get its mass if two eat it at the same time,
+	//and so the shared state is already up to date by the time anyone hears about it
+	if !g.client.SharedGameObjects().Spores.Remove(sporeId) {
+		g.logger.Println(errMsg + "spore was already consumed")
+		return 0, false
+	}
 
-	g.client.Broadcast(message)
+	sporeMass := radToMass(spore.Radius) * g.client.Events().MassMultiplier() * g.client.SporeValue().Multiplier()
+	g.player.Radius = g.nextRadius(sporeMass)
+	g.massEaten += sporeMass
+	g.sporesEaten++
+	g.markPlaying()
+	return sporeMass, true
+}
 
-	// Syncing the best scores after player eats spores in a go routine because it'll involve DB operations
-	go g.syncPlayerBestScore()
+// How eating a spore of that mass should feel on the client
+func (g *InGame) sporeFeedback(sporeMass float64) packets.ConsumeFeedback {
+	if sporeMass >= g.client.Config().BigSporeMass {
+		return packets.ConsumeFeedback_CONSUME_FEEDBACK_BIG_SPORE
+	}
+	return packets.ConsumeFeedback_CONSUME_FEEDBACK_SMALL_SPORE
 }
 
 // Function to handle the consumption of player on server side
 func (g *InGame) handlePlayerConsumed(senderId uint64, message *packets.Packet_PlayerConsumed) {
-	//No need to verify it if it came from another player since it was already verified on that player's side
+	//Same as spores, one from another sender was already decided by the eater's state and applied
+	//before it was broadcast, all that's left is telling our client (and dying if it was us)
 	if senderId != g.client.Id() {
-		g.client.SocketSendAs(message, senderId)
+		//We always hear about it if we're the one being eaten
+		if message.PlayerConsumed.PlayerId == g.client.Id() || g.inConsumeEventRange(senderId) {
+			g.client.SocketSendAs(message, senderId)
+		}
 
-		if message.PlayerConsumed.PlayerId == g.client.Id() {
-			g.logger.Println("Player was consumed, respawning")
-			g.client.SetState(&InGame{
-				player: &objects.Player{
-					Name: g.player.Name,
-				},
-			})
+		if message.PlayerConsumed.PlayerId == g.client.Id() && !g.eaten {
+			g.sendHaptic(packets.HapticKind_HAPTIC_EATEN, 1)
+			g.logger.Println("Player was consumed, waiting for the client to respawn")
+			g.eaten = true
+			//We hear about it from the hub, leaving the game broadcasts that we left and the hub
+			//can't take a broadcast while it's busy handing us this one, so our own goroutine does it
+			g.client.RunLater(g.die)
 		}
 
 		return
@@ -184,38 +714,117 @@ func (g *InGame) handlePlayerConsumed(senderId uint64, message *packets.Packet_P
 	//But if we're the one consuming, we need to verify
 	errMsg := "Could not verify player consumtion: "
 
-	//First checking if the player exists
+	//Can't eat ourselves, the mass check would fail anyway but no point getting that far
 	otherId := message.PlayerConsumed.PlayerId
+	if otherId == g.client.Id() {
+		g.logger.Println(errMsg + "player tried to consume themselves")
+		g.client.SocketSend(packets.NewError("You can't consume yourself"))
+		return
+	}
+
+	//A duplicate of a message we already went through, the victim is gone so it would only
+	//count as suspicious when it's really just the network
+	if g.recentlyAte(otherId) {
+		g.logger.Printf(errMsg+"already consumed player %d", otherId)
+		return
+	}
+
+	//First checking if the player exists
 	other, err := g.getOtherPlayer(otherId)
 	if err != nil {
-		g.logger.Println(errMsg + err.Error())
+		g.reportSuspicion(server.SuspicionMissingObject, errMsg+err.Error())
 		return
 	}
 
-	//Checking if the other player's mass is 150% smaller than ours
+	//Checking if our mass is more than ConsumeMassRatio times the other player's
 	ourMass := radToMass(g.player.Radius)
 	otherMass := radToMass(other.Radius)
-	if ourMass <= otherMass*1.5 {
-		g.logger.Printf(errMsg+"player not massive enough to consume the other player (our radius: %f, other radius: %f)", g.player.Radius, other.Radius)
+	if ourMass <= otherMass*g.client.Config().ConsumeMassRatio {
+		g.reportSuspicion(server.SuspicionNotMassive, fmt.Sprintf(errMsg+"player not massive enough to consume the other player (our radius: %f, other radius: %f)", g.player.Radius, other.Radius))
 		return
 	}
 
 	//Lastly checking if the player was close enough
-	err = g.validatePlayerCloseToObjects(other.X, other.Y, other.Radius, 10)
+	err = g.validatePlayerCloseToObjects(other.X, other.Y, other.CollisionRadius(g.client.Config().CollisionRadiusScale), g.consumeBuffer())
 	if err != nil {
-		g.logger.Println(errMsg + err.Error())
+		g.reportSuspicion(server.SuspicionTooFar, errMsg+err.Error())
 		return
 	}
 
-	//If we make it this far, it means everything is valid, we'll grow the player and broadcast the event
-	g.player.Radius = g.nextRadius(otherMass)
+	//If we make it this far, it means everything is valid, so we remove the other player (only
+	//one consumer can win that), part of their mass scatters around as spores and we get the rest,
+	//then we broadcast the event
+	if !g.client.SharedGameObjects().Players.Remove(otherId) {
+		g.logger.Println(errMsg + "player was already consumed")
+		return
+	}
 
-	go g.client.SharedGameObjects().Players.Remove(otherId)
+	g.rememberVictim(otherId)
+	scatteredMass := g.scatterMassAsSpores(other)
+	gainedMass := (otherMass - scatteredMass) * g.client.Events().MassMultiplier()
+	g.player.Radius = g.nextRadius(gainedMass)
+	g.massEaten += gainedMass
+	g.playersEaten++
+	g.markPlaying()
 
+	message.PlayerConsumed.Mass = gainedMass
+	message.PlayerConsumed.Feedback = packets.ConsumeFeedback_CONSUME_FEEDBACK_SMALL_PLAYER
+	if gainedMass >= g.client.Config().BigPlayerMass {
+		message.PlayerConsumed.Feedback = packets.ConsumeFeedback_CONSUME_FEEDBACK_BIG_PLAYER
+	}
 	g.client.Broadcast(message)
+	//The bigger the meal the stronger the buzz, a big player gets the full one
+	g.sendHaptic(packets.HapticKind_HAPTIC_ATE_PLAYER, max(min(gainedMass/g.client.Config().BigPlayerMass, 1), 0.2))
+	g.client.EventBus().Publish(server.PlayerEaten{
+		EaterId:    g.client.Id(),
+		EaterName:  g.player.Name,
+		VictimId:   otherId,
+		VictimName: other.Name,
+		Mass:       gainedMass,
+	})
+}
+
+// Function to hint the client to vibrate, unless the player turned it off
+func (g *InGame) sendHaptic(kind packets.HapticKind, intensity float64) {
+	if g.player.Settings.HapticsEnabled {
+		g.client.SocketSend(packets.NewHaptic(kind, intensity))
+	}
+}
+
+// Function to scatter part of a consumed player's mass around where they died as spores
+// the fraction comes from the config, returns the total mass that was scattered
+// The spores count as dropped by us, they land inside our player so we shouldn't get to eat them
+// right back before moving off of them
+func (g *InGame) scatterMassAsSpores(player *objects.Player) float64 {
+	fraction := min(max(g.client.Config().DeathScatterFraction, 0), 1)
+	scatteredMass := radToMass(player.Radius) * fraction
+	if scatteredMass <= 0 {
+		return 0
+	}
+
+	//Splitting the mass into spores about the size of a regular spore, but not too many of them
+	const maxSpores = 20
+	sporeCount := int(min(max(scatteredMass/radToMass(10), 1), maxSpores))
+	sporeRadius := massToRad(scatteredMass / float64(sporeCount))
 
-	// Syncing the best scores after player eats someone in a go routine because it'll involve DB operations
-	go g.syncPlayerBestScore()
+	for i := 0; i < sporeCount; i++ {
+		//Random point inside the dead player's circle
+		angle := rand.Float64() * 2 * math.Pi
+		dist := rand.Float64() * player.Radius
+
+		spore := &objects.Spore{
+			X:         player.X + dist*math.Cos(angle),
+			Y:         player.Y + dist*math.Sin(angle),
+			Radius:    sporeRadius,
+			DroppedBy: g.player,
+			DroppedAt: g.client.Clock().Now(),
+		}
+		sporeId := g.client.SharedGameObjects().Spores.Add(spore)
+		g.client.Broadcast(packets.NewSpore(sporeId, spore, g.client.SharedGameObjects().WorldBound))
+		g.client.SocketSend(packets.NewSpore(sporeId, spore, g.client.SharedGameObjects().WorldBound))
+	}
+
+	return scatteredMass
 }
 
 func (g *InGame) handleSpore(senderId uint64, message *packets.Packet_Spore) {
@@ -224,12 +833,246 @@ func (g *InGame) handleSpore(senderId uint64, message *packets.Packet_Spore) {
 
 func (g *InGame) handleDisconnect(senderId uint64, message *packets.Packet_Disconnect) {
 	if senderId == g.client.Id() {
-		g.client.Broadcast(message)
+		g.client.Broadcast(packets.NewPlayerDisconnect(message.Disconnect.Reason, g.client.Id(), g.player, g.client.SharedGameObjects().WorldBound))
 		g.client.SetState(&Connected{})
 		return
 	}
 
-	go g.client.SocketSendAs(message, senderId)
+	//Nothing to remove if they weren't in the game
+	if !message.Disconnect.InGame {
+		return
+	}
+
+	//Only players close by hear that someone left, the rest just have them quietly removed
+	radius := g.client.Config().DisconnectEventRadius
+	dx := message.Disconnect.X - g.player.X
+	dy := message.Disconnect.Y - g.player.Y
+	if radius <= 0 || dx*dx+dy*dy <= radius*radius {
+		g.client.SocketSendAs(message, senderId)
+	} else {
+		g.client.SocketSend(packets.NewPlayerDespawned(senderId))
+	}
+}
+
+// Emotes from our own client are validated and broadcast, emotes from other clients
+// are only forwarded if the sender is close enough to our player to see it
+func (g *InGame) handleEmote(senderId uint64, message *packets.Packet_Emote) {
+	if senderId != g.client.Id() {
+		if g.isNearby(senderId, emoteRadius) {
+			g.client.SocketSendAs(message, senderId)
+		}
+		return
+	}
+
+	emote := message.Emote.Emote
+	if _, valid := packets.EmoteType_name[int32(emote)]; !valid {
+		g.logger.Printf("Recieved invalid emote %d, ignoring", emote)
+		return
+	}
+
+	if !g.emoteLimiter.Allow() {
+		g.logger.Println("Emoting too fast, dropping emote")
+		return
+	}
+
+	g.client.Broadcast(message)
+	g.client.SocketSend(message)
+}
+
+// Function to relay whether a player is writing a chat message to the players around them
+// Only changes go out (saying we're typing twice does nothing) and starting is rate limited on top,
+// so toggling it can't be used to spam everyone
+func (g *InGame) handleTyping(senderId uint64, message *packets.Packet_Typing) {
+	if senderId != g.client.Id() {
+		if g.isNearby(senderId, typingRadius) {
+			g.client.SocketSendAs(message, senderId)
+		}
+		return
+	}
+
+	//Stopping always goes through, so nobody's left looking at an indicator that never goes away
+	typing := message.Typing.Typing
+	if typing == g.typing || (typing && !g.typingLimiter.Allow()) {
+		return
+	}
+	g.typing = typing
+
+	g.client.Broadcast(packets.NewTyping(g.client.Id(), typing))
+}
+
+// Function to check if we ate the player with the given id within the victim cooldown
+func (g *InGame) recentlyAte(victimId uint64) bool {
+	eatenAt, exists := g.recentVictims[victimId]
+	return exists && g.client.Clock().Now().Sub(eatenAt) < g.client.Config().VictimCooldown
+}
+
+// Function to remember a player we just ate, forgetting the ones whose cooldown is over
+func (g *InGame) rememberVictim(victimId uint64) {
+	now := g.client.Clock().Now()
+	cooldown := g.client.Config().VictimCooldown
+	for id, eatenAt := range g.recentVictims {
+		if now.Sub(eatenAt) >= cooldown {
+			delete(g.recentVictims, id)
+		}
+	}
+	if cooldown > 0 {
+		g.recentVictims[victimId] = now
+	}
+}
+
+// Function to change the player's color (and skin) mid game, everyone else is told right away
+// so they don't have to wait for the next player update to re-render
+func (g *InGame) handleChangeColor(senderId uint64, message *packets.Packet_ChangeColor) {
+	if senderId != g.client.Id() {
+		g.client.SocketSendAs(message, senderId)
+		return
+	}
+
+	if !objects.ValidColor(message.ChangeColor.Color) {
+		g.client.SocketSend(packets.NewError("The color can't be see through"))
+		return
+	}
+	if !objects.ValidSkinId(message.ChangeColor.SkinId) {
+		g.client.SocketSend(packets.NewError("That skin doesn't exist"))
+		return
+	}
+	if !g.colorLimiter.Allow() {
+		g.client.SocketSend(packets.NewError("You're changing color too fast"))
+		return
+	}
+
+	g.player.Color = message.ChangeColor.Color
+	g.player.SkinId = message.ChangeColor.SkinId
+	g.client.Broadcast(packets.NewChangeColor(g.client.Id(), g.player.Color, g.player.SkinId))
+	g.client.SocketSend(packets.NewSettings(g.player))
+	go g.savePlayerSettings()
+}
+
+// Function to give a client that thinks it's out of sync the server's version of its player and
+// of everything around it, so it can throw away whatever it predicted
+func (g *InGame) handleRequestResync(senderId uint64, _ *packets.Packet_RequestResync) {
+	if senderId != g.client.Id() {
+		return
+	}
+	if !g.resyncLimiter.Allow() {
+		g.client.SocketSend(packets.NewError("Too many resyncs, try again in a bit"))
+		return
+	}
+
+	radius := g.client.Config().ResyncRadius
+	inView := func(x, y float64) bool {
+		dx := x - g.player.X
+		dy := y - g.player.Y
+		return dx*dx+dy*dy <= radius*radius
+	}
+
+	players := make(map[uint64]*objects.Player)
+	g.client.SharedGameObjects().Players.ForEach(func(playerId uint64, player *objects.Player) {
+		if playerId != g.client.Id() && inView(player.X, player.Y) {
+			players[playerId] = player
+		}
+	})
+	spores := make(map[uint64]*objects.Spore)
+	g.client.SharedGameObjects().Spores.ForEach(func(sporeId uint64, spore *objects.Spore) {
+		if inView(spore.X, spore.Y) {
+			spores[sporeId] = spore
+		}
+	})
+
+	g.logger.Printf("Resyncing with %d players and %d spores in view", len(players), len(spores))
+	g.client.SocketSend(packets.NewResync(g.client.Id(), g.player, players, spores, g.client.SharedGameObjects().WorldBound))
+}
+
+// Function to shoot a chunk of the player's mass out in front of them as a moving spore
+// anyone can eat it, but the player that ejected it has to wait the drop cooldown like any dropped spore
+func (g *InGame) handleEject(senderId uint64, _ *packets.Packet_Eject) {
+	if senderId != g.client.Id() {
+		return
+	}
+
+	if !g.client.Round().Started() || !g.ejectLimiter.Allow() {
+		return
+	}
+
+	//Can't eject if it would make the player smaller than they started
+	config := g.client.Config()
+	if radToMass(g.player.Radius)-config.EjectMass < radToMass(startingRadius) {
+		return
+	}
+	g.markPlaying()
+
+	g.player.Radius = g.nextRadius(-config.EjectMass)
+
+	//Starting just outside the player so it's not inside them straight away
+	sporeRadius := massToRad(config.EjectMass)
+	dirX, dirY := math.Cos(g.player.Direction), math.Sin(g.player.Direction)
+	spore := &objects.Spore{
+		X:         g.player.X + dirX*(g.player.Radius+sporeRadius),
+		Y:         g.player.Y + dirY*(g.player.Radius+sporeRadius),
+		Radius:    sporeRadius,
+		DroppedBy: g.player,
+		DroppedAt: g.client.Clock().Now(),
+		VX:        dirX * config.EjectSpeed,
+		VY:        dirY * config.EjectSpeed,
+	}
+	spore.X, spore.Y = g.client.SharedGameObjects().WorldBound.Clamp(spore.X, spore.Y)
+
+	//Moving, so the hub picks it up and keeps it going until it slows down to a stop
+	sporeId := g.client.SharedGameObjects().AddSpore(spore)
+	g.client.Broadcast(packets.NewSpore(sporeId, spore, g.client.SharedGameObjects().WorldBound))
+	g.client.SocketSend(packets.NewSpore(sporeId, spore, g.client.SharedGameObjects().WorldBound))
+}
+
+// Anything that counts as actually playing brings the player back from being AFK
+func (g *InGame) markPlaying() {
+	g.lastInput.Store(g.client.Clock().Now().UnixNano())
+	if g.afk.Swap(false) {
+		g.logger.Println("Player is back from being AFK")
+	}
+}
+
+// Checks if the player has gone AFK, and if so whether it's time for another update
+// Returns false if this tick's update should be skipped
+func (g *InGame) shouldSendUpdate() bool {
+	config := g.client.Config()
+	if config.AfkThreshold <= 0 {
+		return true
+	}
+
+	now := g.client.Clock().Now()
+	lastInput := time.Unix(0, g.lastInput.Load())
+	if now.Sub(lastInput) >= config.AfkThreshold && g.afk.CompareAndSwap(false, true) {
+		g.logger.Printf("Player hasn't played for %v, marking them AFK", config.AfkThreshold)
+	}
+
+	if !g.afk.Load() {
+		return true
+	}
+	if now.Sub(g.lastAfkUpdate) < config.AfkUpdateInterval {
+		return false
+	}
+	g.lastAfkUpdate = now
+	return true
+}
+
+// Pushes the idle kick back, called whenever the player sends some input
+func (g *InGame) markActive() {
+	if g.idleTimer != nil {
+		g.idleTimer.Reset(g.client.Config().IdleKickTimeout)
+	}
+}
+
+// Run on the client's goroutine once the idle timer fires, if the player hasn't sent anything for
+// too long it sends them back to the menu to free up their spot in the game
+func (g *InGame) kickIdle() {
+	//Already out of the game by the time the task got its turn
+	if g.idleTimer == nil {
+		return
+	}
+	g.logger.Println("Player has been idle for too long, kicking")
+	g.client.SocketSendReliable(packets.NewKick("idle"))
+	g.client.Broadcast(packets.NewPlayerDisconnect("idle", g.client.Id(), g.player, g.client.SharedGameObjects().WorldBound))
+	g.client.SetState(&Connected{})
 }
 
 // Function to keep running syncPlayer in a loop
@@ -240,56 +1083,153 @@ func (g *InGame) playerUpdateLoop(ctx context.Context) {
 	//ticker allows us to run something in equal intervals
 	defer ticker.Stop()
 
+	lastTick := time.Now()
+
 	for {
 		select {
-		case <-ticker.C:
-			g.syncPlayer(delta)
+		case now := <-ticker.C:
+			g.syncPlayer(g.tickDelta(delta, now.Sub(lastTick)))
+			g.ticks.Add(1)
+			lastTick = now
 		case <-ctx.Done():
 			return //return once the context has been fulfilled
 		}
 	}
 }
 
+// Picks the delta to move the player by for this tick. By default it's always the fixed delta,
+// but with RealDeltaMovement on it's the time that really passed since the last tick, so a tick
+// that runs late doesn't slow the player down. It's clamped so a long stall doesn't teleport anyone
+func (g *InGame) tickDelta(fixedDelta float64, elapsed time.Duration) float64 {
+	config := g.client.Config()
+	if !config.RealDeltaMovement {
+		return fixedDelta
+	}
+	return min(elapsed.Seconds(), config.MaxMoveDelta)
+}
+
 // keep track of player movement on the server side
 // delta is the time passed since we last synced the player
 // with the server
 func (g *InGame) syncPlayer(delta float64) {
+	//An admin moving us takes the place of this tick's movement
+	if to := g.player.PendingTeleport.Swap(nil); to != nil {
+		g.teleport(*to)
+		return
+	}
+
+	//Everyone stays put until the countdown is over, but still gets sent out so players can see each other
+	//Same for players an admin froze
+	if !g.client.Round().Started() || g.player.Frozen.Load() {
+		g.broadcastPlayer()
+		return
+	}
+
+	g.turnTowardsTarget(delta)
+
 	newX := g.player.X + g.player.Speed*math.Cos(g.player.Direction)*delta
 	newY := g.player.Y + g.player.Speed*math.Sin(g.player.Direction)*delta
 
+	worldBound := g.client.SharedGameObjects().WorldBound
+	if worldBound.Contains(g.player.X, g.player.Y) {
+		//Normal movement plus any drift, just can't go past the edge
+		driftX, driftY := g.drift(newX, newY, delta)
+		pushX, pushY := g.collisionPush(newX, newY, delta)
+		newX, newY = worldBound.Clamp(newX+driftX+pushX, newY+driftY+pushY)
+	} else {
+		//The world shrunk over us, so we lose mass and get pushed back in
+		config := g.client.Config()
+		massLost := radToMass(g.player.Radius) * config.OutOfBoundsMassLoss * delta
+		g.player.Radius = max(g.nextRadius(-massLost), minPlayerRadius)
+
+		bound := worldBound.Get()
+		push := config.OutOfBoundsPushSpeed * delta
+		newX = pushInward(g.player.X, bound, push)
+		newY = pushInward(g.player.Y, bound, push)
+	}
+
+	g.distance += math.Hypot(newX-g.player.X, newY-g.player.Y)
 	g.player.X = newX
 	g.player.Y = newY
+	g.maxMass = max(g.maxMass, radToMass(g.player.Radius))
 
-	//Drop a spore
+	//Drop a spore, unless the map is already full of them
 	probability := g.player.Radius / float64(server.MaxSpores*5)
-	if rand.Float64() < probability && g.player.Radius > 10 {
+	sporesFull := g.client.SharedGameObjects().Spores.Len() >= server.MaxSpores
+	if rand.Float64() < probability && g.player.Radius > 10 && !sporesFull {
 		spore := &objects.Spore{
 			X:         g.player.X,
 			Y:         g.player.Y,
 			Radius:    min(5+g.player.Radius/50, 15),
 			DroppedBy: g.player,
-			DroppedAt: time.Now(),
+			DroppedAt: g.client.Clock().Now(),
 		}
 		sporeId := g.client.SharedGameObjects().Spores.Add(spore)
-		g.client.Broadcast(packets.NewSpore(sporeId, spore))
-		go g.client.SocketSend(packets.NewSpore(sporeId, spore))
+		g.client.Broadcast(packets.NewSpore(sporeId, spore, g.client.SharedGameObjects().WorldBound))
+		g.client.SocketSend(packets.NewSpore(sporeId, spore, g.client.SharedGameObjects().WorldBound))
 		g.player.Radius = g.nextRadius(-radToMass(spore.Radius))
 	}
 
-	//Broadcasting the updated player state
-	updatePacket := packets.NewPlayer(g.client.Id(), g.player)
+	g.broadcastPlayer()
+}
+
+// Function to put the player straight at the given position, telling everyone (us included) it
+// teleported so the jump is snapped to instead of smoothed over
+func (g *InGame) teleport(to objects.Position) {
+	g.logger.Printf("Teleported from (%.0f, %.0f) to (%.0f, %.0f)", g.player.X, g.player.Y, to.X, to.Y)
+	g.player.X = to.X
+	g.player.Y = to.Y
+
+	teleport := packets.NewTeleport(g.client.Id(), g.player, g.client.SharedGameObjects().WorldBound)
+	g.client.Broadcast(teleport)
+	g.client.SocketSend(teleport)
+}
+
+// Broadcasting the updated player state (not as often if the player is AFK)
+func (g *InGame) broadcastPlayer() {
+	if !g.shouldSendUpdate() {
+		return
+	}
+
+	updatePacket := packets.NewPlayer(g.client.Id(), g.player, g.client.SharedGameObjects().WorldBound)
 	g.client.Broadcast(updatePacket)
-	go g.client.SocketSend(updatePacket)
+	if g.shouldEchoSelf() {
+		g.client.SocketSend(updatePacket) //never blocks, a full send channel just drops it
+	}
+}
+
+// Whether our own client should get the update we just sent everyone else, depending on its settings
+func (g *InGame) shouldEchoSelf() bool {
+	switch g.player.Settings.SelfEcho {
+	case objects.SelfEchoOff:
+		return false
+	case objects.SelfEchoOnDivergence:
+		//Until the client reports a position there's nothing to compare with
+		if !g.reported {
+			return true
+		}
+		threshold := g.client.Config().SelfEchoThreshold
+		dx := g.player.X - g.reportedX
+		dy := g.player.Y - g.reportedY
+		return dx*dx+dy*dy > threshold*threshold
+	default:
+		return true
+	}
 }
 
 func (g *InGame) sendInitialSpores(batchSize int, delay time.Duration) {
+	sendAllSpores(g.client, batchSize, delay)
+}
+
+// Sends every spore on the map to the client in batches, used by any state that shows the map
+func sendAllSpores(client server.ClientInterfacer, batchSize int, delay time.Duration) {
 	sporesBatch := make(map[uint64]*objects.Spore, batchSize)
 
-	g.client.SharedGameObjects().Spores.ForEach(func(sporeId uint64, spore *objects.Spore) {
+	client.SharedGameObjects().Spores.ForEach(func(sporeId uint64, spore *objects.Spore) {
 		sporesBatch[sporeId] = spore
 
 		if len(sporesBatch) >= batchSize {
-			g.client.SocketSend(packets.NewSporeBatch(sporesBatch))
+			sendSporeBatch(client, sporesBatch)
 			sporesBatch = make(map[uint64]*objects.Spore, batchSize)
 			time.Sleep(delay)
 		}
@@ -297,7 +1237,57 @@ func (g *InGame) sendInitialSpores(batchSize int, delay time.Duration) {
 
 	//Sending any remaining spores
 	if len(sporesBatch) > 0 {
-		g.client.SocketSend(packets.NewSporeBatch(sporesBatch))
+		sendSporeBatch(client, sporesBatch)
+	}
+}
+
+// Sends a batch of spores, splitting it in halves until every frame fits under MaxSporeBatchBytes
+func sendSporeBatch(client server.ClientInterfacer, sporesBatch map[uint64]*objects.Spore) {
+	message := packets.NewSporeBatch(sporesBatch, client.SharedGameObjects().WorldBound)
+	maxBytes := client.Config().MaxSporeBatchBytes
+	if maxBytes <= 0 || len(sporesBatch) <= 1 || packets.Size(message) <= maxBytes {
+		client.SocketSend(message)
+		return
+	}
+
+	half := len(sporesBatch) / 2
+	first := make(map[uint64]*objects.Spore, half)
+	second := make(map[uint64]*objects.Spore, len(sporesBatch)-half)
+	for sporeId, spore := range sporesBatch {
+		if len(first) < half {
+			first[sporeId] = spore
+		} else {
+			second[sporeId] = spore
+		}
+	}
+	sendSporeBatch(client, first)
+	sendSporeBatch(client, second)
+}
+
+// Gives a client that just showed up the whole global leaderboard, since everyone else only gets
+// told what changed from now on. With the region scope the next leaderboard is a whole one anyway
+func sendLeaderboard(client server.ClientInterfacer) {
+	if client.Config().LeaderboardScope == server.LeaderboardRegion {
+		return
+	}
+	client.SocketSend(packets.NewLeaderboard(client.Leaderboard().Entries()))
+}
+
+// Tells a client that just showed up who's already in the game, everyone after that it hears
+// about from the join and leave broadcasts
+func sendRoster(client server.ClientInterfacer) {
+	client.SharedGameObjects().Players.ForEach(func(playerId uint64, player *objects.Player) {
+		if playerId != client.Id() {
+			client.SocketSendAs(packets.NewPlayerJoined(playerId, player), playerId)
+		}
+	})
+}
+
+// Tells a client that just showed up about the events already running
+func sendActiveEvents(client server.ClientInterfacer) {
+	now := client.Clock().Now()
+	for _, event := range client.Events().Active() {
+		client.SocketSend(packets.NewEvent(event.Name, true, event.EndsAt.Sub(now), event.MassMultiplier, event.SporeRateMultiplier))
 	}
 }
 
@@ -319,13 +1309,35 @@ func (g *InGame) getOtherPlayer(playerId uint64) (*objects.Player, error) {
 	return player, nil
 }
 
+// Whether we're close enough to the consumer to see what they ate
+func (g *InGame) inConsumeEventRange(consumerId uint64) bool {
+	radius := g.client.Config().ConsumeEventRadius
+	return radius <= 0 || g.isNearby(consumerId, radius)
+}
+
+// Function to check if another player is within the given distance of our player
+func (g *InGame) isNearby(playerId uint64, radius float64) bool {
+	other, exists := g.client.SharedGameObjects().Players.Get(playerId)
+	if !exists {
+		return false
+	}
+
+	dx := other.X - g.player.X
+	dy := other.Y - g.player.Y
+	return dx*dx+dy*dy <= radius*radius
+}
+
 // Function to check if the player was close enough to the spore/ other player to consume it
+// Players are checked with their collision radius, so objRadius has to be the other player's one too
 func (g *InGame) validatePlayerCloseToObjects(objX, objY, objRadius, buffer float64) error {
 	realDX := g.player.X - objX
 	realDY := g.player.Y - objY
 	realDistSq := realDX*realDX + realDY*realDY
 
-	thresholdDist := g.player.Radius + buffer + objRadius
+	//The target has to sink ConsumeOverlap of its diameter into us before we're close enough
+	overlap := g.client.Config().ConsumeOverlap * 2 * objRadius
+	ourRadius := g.player.CollisionRadius(g.client.Config().CollisionRadiusScale)
+	thresholdDist := max(ourRadius+objRadius-overlap, 0) + buffer
 	thresholdDistSq := thresholdDist * thresholdDist
 
 	if realDistSq > thresholdDistSq {
@@ -334,15 +1346,179 @@ func (g *InGame) validatePlayerCloseToObjects(objX, objY, objRadius, buffer floa
 	return nil
 }
 
+// The leniency for consumption checks, the configured base buffer plus however far the player
+// could have moved while the message was on its way to us
+func (g *InGame) consumeBuffer() float64 {
+	config := g.client.Config()
+	oneWayLatency := min(g.client.Rtt(), config.ConsumeBufferMaxRtt).Seconds() / 2
+	return config.ConsumeBuffer + config.ConsumeBufferRttScale*g.player.Speed*oneWayLatency
+}
+
+// Dropped spores can't be eaten by anyone until they're MinSporeAge old, and the player that
+// dropped one also has to have had the time to move off of it, so dropping and eating a spore
+// right back can't be looped. Anything that consumes spores on the server has to go through this too
 func (g *InGame) validatePlayerDropCooldown(spore *objects.Spore, buffer float64) error {
+	if spore.DroppedBy == nil {
+		return nil //placed by the server, not dropped
+	}
+
+	sinceDrop := g.client.Clock().Now().Sub(spore.DroppedAt)
+	minAge := g.client.Config().MinSporeAge
+	if sinceDrop < minAge {
+		return fmt.Errorf("spore was dropped too recently (time since drop: %v, min spore age: %v)", sinceDrop, minAge)
+	}
+
 	minAcceptableDistance := spore.Radius + g.player.Radius - buffer
 	minAcceptableTime := time.Duration(minAcceptableDistance/g.player.Speed*1000) * time.Millisecond
-	if spore.DroppedBy == g.player && time.Since(spore.DroppedAt) < minAcceptableTime {
-		return fmt.Errorf("player dropped the spore too recently (time since drop: %v, min acceptable time: %v)", time.Since(spore.DroppedAt), minAcceptableTime)
+	if spore.DroppedBy == g.player && sinceDrop < minAcceptableTime {
+		return fmt.Errorf("player dropped the spore too recently (time since drop: %v, min acceptable time: %v)", sinceDrop, minAcceptableTime)
+	}
+	return nil
+}
+
+// Same idea as the mass ratio between players, but for spores and only if SporeConsumeRatio is set
+func (g *InGame) validateMassiveEnoughForSpore(spore *objects.Spore) error {
+	ratio := g.client.Config().SporeConsumeRatio
+	if ratio <= 0 {
+		return nil
+	}
+
+	ourMass := radToMass(g.player.Radius)
+	sporeMass := radToMass(spore.Radius)
+	if ourMass <= sporeMass*ratio {
+		return fmt.Errorf("player not massive enough to consume the spore (our radius: %f, spore radius: %f, ratio: %f)", g.player.Radius, spore.Radius, ratio)
 	}
 	return nil
 }
 
+// Function to report a failed validation to the anti-cheat, once the client has failed too many
+// of them it either gets kicked or flagged depending on the config
+func (g *InGame) reportSuspicion(kind server.SuspicionType, details string) {
+	if !g.client.AntiCheat().Report(g.client.Id(), kind, details) {
+		return
+	}
+
+	if g.client.Config().SuspicionKick {
+		g.logger.Println("Too many failed validations, kicking client")
+		g.client.Close("Kicked for suspicious behaviour")
+		return
+	}
+
+	g.logger.Println("Too many failed validations, flagging client as suspicious")
+}
+
+// Turns the player's direction towards the target direction, by at most the max turn rate
+func (g *InGame) turnTowardsTarget(delta float64) {
+	config := g.client.Config()
+	if config.MaxTurnRate <= 0 {
+		return
+	}
+
+	turnRate := config.MaxTurnRate
+	if config.TurnRateMassScaling {
+		turnRate *= min(1, startingRadius/g.player.Radius)
+	}
+	maxTurn := turnRate * delta
+
+	//The shortest way around the circle, between -pi and pi
+	diff := g.player.TargetDirection - g.player.Direction
+	diff = math.Atan2(math.Sin(diff), math.Cos(diff))
+
+	if math.Abs(diff) <= maxTurn {
+		g.player.Direction = g.player.TargetDirection
+		return
+	}
+	g.player.Direction += math.Copysign(maxTurn, diff)
+}
+
+// How far the drift from the config moves a player at the given position this tick
+func (g *InGame) drift(x, y, delta float64) (float64, float64) {
+	config := g.client.Config()
+	step := config.DriftStrength * delta
+
+	switch config.DriftMode {
+	case server.DriftCenter, server.DriftPoint:
+		targetX, targetY := 0.0, 0.0
+		if config.DriftMode == server.DriftPoint {
+			angle := float64(g.client.Clock().Now().UnixNano()) / float64(time.Second) * config.DriftPointSpeed
+			targetX = config.DriftPointRadius * math.Cos(angle)
+			targetY = config.DriftPointRadius * math.Sin(angle)
+		}
+
+		dx := targetX - x
+		dy := targetY - y
+		dist := math.Hypot(dx, dy)
+		//Close enough to land right on it, instead of overshooting back and forth
+		if dist <= step {
+			return dx, dy
+		}
+		return dx / dist * step, dy / dist * step
+	case server.DriftWind:
+		return math.Cos(config.DriftWindAngle) * step, math.Sin(config.DriftWindAngle) * step
+	default:
+		return 0, 0
+	}
+}
+
+// How far overlapping players that can't eat each other push this player away this tick
+// Each player only moves itself by its half of the overlap, and never by more than that half in
+// one tick, so two players settle apart instead of bouncing off each other
+func (g *InGame) collisionPush(x, y, delta float64) (float64, float64) {
+	config := g.client.Config()
+	if !config.SoftCollision {
+		return 0, 0
+	}
+
+	ourMass := radToMass(g.player.Radius)
+	ratio := config.ConsumeMassRatio
+	fraction := min(config.CollisionPushStrength*delta, 1)
+	pushX, pushY := 0.0, 0.0
+	g.client.SharedGameObjects().Players.ForEach(func(otherId uint64, other *objects.Player) {
+		if otherId == g.client.Id() {
+			return
+		}
+
+		//One of us can eat the other, so overlapping is how that happens
+		otherMass := radToMass(other.Radius)
+		if ourMass > otherMass*ratio || otherMass > ourMass*ratio {
+			return
+		}
+
+		dx := x - other.X
+		dy := y - other.Y
+		dist := math.Hypot(dx, dy)
+		scale := config.CollisionRadiusScale
+		overlap := g.player.CollisionRadius(scale) + other.CollisionRadius(scale) - dist
+		if overlap <= 0 {
+			return
+		}
+
+		//Right on top of each other, any way out will do as long as the two pick different ones
+		if dist == 0 {
+			dx, dy, dist = 1, 0, 1
+			if g.client.Id() < otherId {
+				dx = -1
+			}
+		}
+
+		push := overlap / 2 * fraction
+		pushX += dx / dist * push
+		pushY += dy / dist * push
+	})
+	return pushX, pushY
+}
+
+// Moves a coordinate that's past the bound towards it by at most step, without overshooting
+func pushInward(coord, bound, step float64) float64 {
+	if coord > bound {
+		return max(bound, coord-step)
+	}
+	if coord < -bound {
+		return min(-bound, coord+step)
+	}
+	return coord
+}
+
 func radToMass(radius float64) float64 {
 	return math.Pi * radius * radius
 }
@@ -357,17 +1533,91 @@ func (g *InGame) nextRadius(massDiff float64) float64 {
 	return massToRad(newMass)
 }
 
+// Saves the best score every interval while the player is in the game, OnExit saves it one last time
+// One goroutine for the whole life of the player, instead of one for everything they eat
+func (g *InGame) bestScoreLoop(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			g.syncPlayerBestScore()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Sends the client how its connection looks from our side every interval, to help players figure out lag
+func (g *InGame) connectionStatsLoop(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			tickRate := float64(g.ticks.Swap(0)) / interval.Seconds()
+			g.client.SocketSend(packets.NewConnectionStats(g.client.Rtt(), tickRate, g.client.DroppedPackets()))
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 func (g *InGame) syncPlayerBestScore() {
+	//Guests don't have a row in the DB, nothing to save
+	if g.player.DbId == 0 || !g.client.DbTx().Available() {
+		return
+	}
+
+	//The season was reset since the best score was loaded, last season's doesn't count anymore
+	if season := g.client.SharedGameObjects().BestScoreSeason.Load(); g.player.BestScoreSeason != season {
+		g.player.BestScore = 0
+		g.player.BestScoreSeason = season
+	}
+
 	currentScore := int64(math.Round(radToMass(g.player.Radius)))
 	if currentScore > g.player.BestScore {
 		g.player.BestScore = currentScore
-		err := g.client.DbTx().Queries.UpdatePlayerBestScore(g.client.DbTx().Ctx, db.UpdatePlayerBestScoreParams{
+		params := db.UpdatePlayerBestScoreParams{
 			ID:        g.player.DbId,
 			BestScore: g.player.BestScore,
-		})
+		}
+		if queue := g.client.DbTx().Queue; queue != nil {
+			queue.UpdatePlayerBestScore(params)
+			return
+		}
 
+		err := g.client.DbTx().Queries.UpdatePlayerBestScore(g.client.DbTx().Ctx, params)
 		if err != nil {
 			g.logger.Printf("Error updating the player best score: %v", err)
 		}
 	}
 }
+
+// Function to store this life as a finished match, only for players linked to the DB
+func (g *InGame) saveMatchHistory(finalMass float64) {
+	if g.player.DbId == 0 || !g.client.DbTx().Available() {
+		return
+	}
+
+	params := db.CreateMatchHistoryParams{
+		PlayerID:     g.player.DbId,
+		FinalScore:   int64(math.Round(finalMass)),
+		MassEaten:    int64(math.Round(g.massEaten)),
+		SporesEaten:  int64(g.sporesEaten),
+		PlayersEaten: int64(g.playersEaten),
+		MaxMass:      int64(math.Round(g.maxMass)),
+		Distance:     int64(math.Round(g.distance)),
+	}
+	if queue := g.client.DbTx().Queue; queue != nil {
+		queue.CreateMatchHistory(params)
+		return
+	}
+
+	err := g.client.DbTx().Queries.CreateMatchHistory(g.client.DbTx().Ctx, params)
+	if err != nil {
+		g.logger.Printf("Error saving the match history: %v", err)
+	}
+}
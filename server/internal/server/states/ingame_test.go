@@ -0,0 +1,1563 @@
+package states
+
+import (
+	"context"
+	"math"
+	"runtime"
+	"server/internal/server"
+	"server/internal/server/db"
+	"server/internal/server/objects"
+	"server/internal/servertest"
+	"server/pkg/packets"
+	"testing"
+	"time"
+)
+
+func TestSporeConsumptionGrowsPlayer(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	client, state := joinGame(t, hub, "eater")
+
+	player := state.player
+	sporeId := hub.SharedGameObjects.Spores.Add(&objects.Spore{X: player.X, Y: player.Y, Radius: 10})
+	radiusBefore := player.Radius
+
+	client.ProcessMessage(client.Id(), &packets.Packet_SporeConsumed{
+		SporeConsumed: &packets.SporeConsumedMessage{SporeId: sporeId},
+	})
+
+	if player.Radius <= radiusBefore {
+		t.Errorf("player didn't grow, radius went from %f to %f", radiusBefore, player.Radius)
+	}
+	if _, exists := hub.SharedGameObjects.Spores.Get(sporeId); exists {
+		t.Error("the eaten spore is still on the map")
+	}
+	if len(servertest.MessagesOf[*packets.Packet_SporeConsumed](client.Broadcasts())) != 1 {
+		t.Error("the consumption wasn't broadcast")
+	}
+}
+
+func TestConsumeBufferCapsTheRoundTripTime(t *testing.T) {
+	config := server.DefaultConfig()
+	config.ConsumeBufferRttScale = 1
+	config.ConsumeBufferMaxRtt = 200 * time.Millisecond
+	hub, _ := servertest.NewTestHub(config)
+	client, state := joinGame(t, hub, "laggy")
+	speed := state.player.Speed
+
+	client.SetRtt(100 * time.Millisecond)
+	if want := config.ConsumeBuffer + speed*0.05; state.consumeBuffer() != want {
+		t.Errorf("buffer with a 100ms round trip is %f, want %f", state.consumeBuffer(), want)
+	}
+
+	//A client claiming a huge round trip time gets no more room than the cap
+	client.SetRtt(time.Hour)
+	if want := config.ConsumeBuffer + speed*0.1; state.consumeBuffer() != want {
+		t.Errorf("buffer with an hour round trip is %f, want %f", state.consumeBuffer(), want)
+	}
+}
+
+func TestConsumeBufferIgnoresRoundTripTimeByDefault(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	client, state := joinGame(t, hub, "laggy")
+
+	client.SetRtt(time.Hour)
+	if state.consumeBuffer() != hub.Config().ConsumeBuffer {
+		t.Errorf("buffer is %f, want the plain %f", state.consumeBuffer(), hub.Config().ConsumeBuffer)
+	}
+}
+
+func TestScatteredSporesCantBeEatenBackRightAway(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	eater, eaterState := joinGame(t, hub, "eater")
+	victim, victimState := joinGame(t, hub, "victim")
+	lineUpMeal(eaterState.player, victimState.player)
+
+	eatPlayer(eater, victim.Id())
+	if _, exists := hub.SharedGameObjects.Players.Get(victim.Id()); exists {
+		t.Fatal("the victim wasn't eaten")
+	}
+
+	spores := servertest.MessagesOf[*packets.Packet_Spore](eater.SentMessages())
+	if len(spores) == 0 {
+		t.Fatal("no mass was scattered")
+	}
+	for _, message := range spores {
+		sporeId := message.Spore.Id
+		spore, _ := hub.SharedGameObjects.Spores.Get(sporeId)
+		if spore.DroppedBy != eaterState.player {
+			t.Errorf("spore %d isn't marked as dropped by the eater", sporeId)
+		}
+
+		eater.ProcessMessage(eater.Id(), &packets.Packet_SporeConsumed{
+			SporeConsumed: &packets.SporeConsumedMessage{SporeId: sporeId},
+		})
+		if _, exists := hub.SharedGameObjects.Spores.Get(sporeId); !exists {
+			t.Errorf("spore %d was eaten back right away", sporeId)
+		}
+	}
+}
+
+func TestIdlePlayerIsKickedOnItsOwnGoroutine(t *testing.T) {
+	config := server.DefaultConfig()
+	config.IdleKickTimeout = 10 * time.Millisecond
+	hub, _ := servertest.NewTestHub(config)
+	client, _ := joinGame(t, hub, "idler")
+
+	//The timer only queues the kick, the state doesn't change under the client's feet
+	waitFor(t, "the idle kick to be queued", func() bool { return client.QueuedTasks() > 0 })
+	if client.StateName() != "InGame" {
+		t.Fatalf("state changed to %s before the client ran the kick", client.StateName())
+	}
+
+	client.RunQueued()
+	if client.StateName() != "Connected" {
+		t.Errorf("idle player is in %s, want Connected", client.StateName())
+	}
+	if _, exists := hub.SharedGameObjects.Players.Get(client.Id()); exists {
+		t.Error("idle player is still in the game")
+	}
+	if len(servertest.MessagesOf[*packets.Packet_Kick](client.SentMessages())) != 1 {
+		t.Error("idle player wasn't told why they were kicked")
+	}
+}
+
+func TestIdleKickIsSkippedAfterLeaving(t *testing.T) {
+	config := server.DefaultConfig()
+	config.IdleKickTimeout = 10 * time.Millisecond
+	hub, _ := servertest.NewTestHub(config)
+	client, _ := joinGame(t, hub, "idler")
+
+	waitFor(t, "the idle kick to be queued", func() bool { return client.QueuedTasks() > 0 })
+	client.SetState(&Connected{})
+	client.ClearSent()
+
+	//Joining again before the old kick runs, the new game shouldn't get kicked by the old one
+	//(and it isn't around long enough to get one of its own)
+	config.IdleKickTimeout = time.Hour
+	client.SetState(&InGame{player: &objects.Player{Name: "idler"}})
+	client.RunQueued()
+	if client.StateName() != "InGame" {
+		t.Errorf("player is in %s, the old idle kick shouldn't have applied", client.StateName())
+	}
+}
+
+func TestCountdownOnlyComesFromTheServer(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	client, _ := joinGame(t, hub, "counter")
+	hub.SharedGameObjects.Spores.Add(&objects.Spore{Radius: 10})
+	client.ClearSent()
+
+	//The client ending the countdown itself would get it every spore again, as often as it likes
+	client.ProcessMessage(client.Id(), packets.NewCountdown(0))
+	if len(client.Sent()) != 0 {
+		t.Fatalf("a countdown from the client went through, sent %v", client.SentMessages())
+	}
+
+	client.ProcessMessage(0, packets.NewCountdown(0))
+	if len(servertest.MessagesOf[*packets.Packet_Countdown](client.SentMessages())) != 1 {
+		t.Error("the server's countdown wasn't passed on")
+	}
+	waitFor(t, "the spores to be sent", func() bool {
+		return len(servertest.MessagesOf[*packets.Packet_SporesBatch](client.SentMessages())) > 0
+	})
+}
+
+func TestEjectedSporeKeepsMoving(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	client, state := joinGame(t, hub, "ejector")
+	state.player.Radius = 100
+	client.ClearSent()
+
+	client.ProcessMessage(client.Id(), &packets.Packet_Eject{Eject: &packets.EjectMessage{}})
+	spores := servertest.MessagesOf[*packets.Packet_Spore](client.SentMessages())
+	if len(spores) != 1 {
+		t.Fatalf("ejecting sent %d spores, want 1", len(spores))
+	}
+
+	//The hub only moves the spores it knows are moving
+	sporeId := spores[0].Spore.Id
+	spore, moving := hub.SharedGameObjects.MovingSpores.Get(sporeId)
+	if !moving {
+		t.Fatal("the ejected spore isn't tracked as moving")
+	}
+	if !spore.Moving() {
+		t.Error("the ejected spore has no velocity")
+	}
+}
+
+func TestJoiningAndLeavingAreBroadcast(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	client, _ := joinGame(t, hub, "visitor")
+
+	joined := servertest.MessagesOf[*packets.Packet_PlayerJoined](client.Broadcasts())
+	if len(joined) != 1 || joined[0].PlayerJoined.Id != client.Id() || joined[0].PlayerJoined.Name != "visitor" {
+		t.Fatalf("broadcast %v on joining, want one PlayerJoined for the visitor", joined)
+	}
+
+	client.SetState(&Connected{})
+	left := servertest.MessagesOf[*packets.Packet_PlayerLeft](client.Broadcasts())
+	if len(left) != 1 || left[0].PlayerLeft.Id != client.Id() {
+		t.Errorf("broadcast %v on leaving, want one PlayerLeft for the visitor", left)
+	}
+}
+
+func TestEatenPlayerLeavesOnItsOwnGoroutine(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	eater, eaterState := joinGame(t, hub, "eater")
+	victim, victimState := joinGame(t, hub, "victim")
+	lineUpMeal(eaterState.player, victimState.player)
+	victim.ClearSent()
+
+	eatPlayer(eater, victim.Id())
+	consumed := servertest.MessagesOf[*packets.Packet_PlayerConsumed](eater.Broadcasts())
+	if len(consumed) != 1 {
+		t.Fatalf("eater broadcast %d PlayerConsumed, want 1", len(consumed))
+	}
+
+	//The hub hands the victim the news on its own goroutine, where a PlayerLeft broadcast would
+	//wait on the hub forever, so the victim can only queue its death there. Hearing it twice
+	//doesn't queue it twice
+	victim.ProcessMessage(eater.Id(), consumed[0])
+	victim.ProcessMessage(eater.Id(), consumed[0])
+	if victim.StateName() != "InGame" {
+		t.Fatalf("victim went to %s while the hub was handing it the message", victim.StateName())
+	}
+	if left := servertest.MessagesOf[*packets.Packet_PlayerLeft](victim.Broadcasts()); len(left) != 0 {
+		t.Fatal("victim broadcast that it left from the hub's goroutine")
+	}
+	if victim.QueuedTasks() != 1 {
+		t.Fatalf("victim queued %d tasks, want 1", victim.QueuedTasks())
+	}
+
+	victim.RunQueued()
+	if victim.StateName() != "Dead" {
+		t.Errorf("victim is in %s, want Dead", victim.StateName())
+	}
+	if left := servertest.MessagesOf[*packets.Packet_PlayerLeft](victim.Broadcasts()); len(left) != 1 {
+		t.Errorf("victim broadcast %d PlayerLeft, want 1", len(left))
+	}
+}
+
+func TestEatenPlayerDoesntDieAfterLeaving(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	eater, eaterState := joinGame(t, hub, "eater")
+	victim, victimState := joinGame(t, hub, "victim")
+	lineUpMeal(eaterState.player, victimState.player)
+
+	eatPlayer(eater, victim.Id())
+	consumed := servertest.MessagesOf[*packets.Packet_PlayerConsumed](eater.Broadcasts())
+	victim.ProcessMessage(eater.Id(), consumed[0])
+
+	//Back to the menu before the death got its turn, it shouldn't pull the client out of there
+	victim.SetState(&Connected{})
+	victim.RunQueued()
+	if victim.StateName() != "Connected" {
+		t.Errorf("victim is in %s, want Connected", victim.StateName())
+	}
+}
+
+func TestDuplicatePlayerConsumedIsIgnored(t *testing.T) {
+	hub, clock := servertest.NewTestHub(server.DefaultConfig())
+	eater, eaterState := joinGame(t, hub, "eater")
+	victim, victimState := joinGame(t, hub, "victim")
+	lineUpMeal(eaterState.player, victimState.player)
+
+	eatPlayer(eater, victim.Id())
+	radius := eaterState.player.Radius
+
+	//The same message again, like a resend after a network hiccup
+	eatPlayer(eater, victim.Id())
+	if eaterState.player.Radius != radius {
+		t.Errorf("eater grew from %f to %f eating the same player twice", radius, eaterState.player.Radius)
+	}
+	if count := hub.AntiCheat.Count(eater.Id()); count != 0 {
+		t.Errorf("the duplicate counted as %d suspicious events, want none", count)
+	}
+	if consumed := servertest.MessagesOf[*packets.Packet_PlayerConsumed](eater.Broadcasts()); len(consumed) != 1 {
+		t.Errorf("eater broadcast %d PlayerConsumed, want 1", len(consumed))
+	}
+
+	//Long after, eating a player that isn't there is suspicious again
+	clock.Advance(hub.Config().VictimCooldown)
+	eatPlayer(eater, victim.Id())
+	if count := hub.AntiCheat.Count(eater.Id()); count != 1 {
+		t.Errorf("eating a missing player after the cooldown counted %d suspicious events, want 1", count)
+	}
+}
+
+func TestTwoPlayersEatingTheSameVictimAtOnce(t *testing.T) {
+	//Racing a lot of times, so the two eaters really do get to the victim together in some of them
+	for round := 0; round < 50; round++ {
+		hub, _ := servertest.NewTestHub(server.DefaultConfig())
+		first, firstState := joinGame(t, hub, "first")
+		second, secondState := joinGame(t, hub, "second")
+		victim, victimState := joinGame(t, hub, "victim")
+		lineUpMeal(firstState.player, victimState.player)
+		lineUpMeal(secondState.player, victimState.player)
+
+		start := make(chan struct{})
+		done := make(chan struct{})
+		for _, eater := range []*servertest.TestClient{first, second} {
+			go func() {
+				<-start
+				eatPlayer(eater, victim.Id())
+				done <- struct{}{}
+			}()
+		}
+		close(start)
+		<-done
+		<-done
+
+		firstAte := len(servertest.MessagesOf[*packets.Packet_PlayerConsumed](first.Broadcasts()))
+		secondAte := len(servertest.MessagesOf[*packets.Packet_PlayerConsumed](second.Broadcasts()))
+		if firstAte+secondAte != 1 {
+			t.Fatalf("round %d: the victim was eaten %d times, want once", round, firstAte+secondAte)
+		}
+
+		grown := 0
+		for _, state := range []*InGame{firstState, secondState} {
+			if state.player.Radius > 100 {
+				grown++
+			}
+		}
+		if grown != 1 {
+			t.Fatalf("round %d: %d eaters got the victim's mass, want 1", round, grown)
+		}
+		if _, exists := hub.SharedGameObjects.Players.Get(victim.Id()); exists {
+			t.Fatalf("round %d: the victim is still in the game", round)
+		}
+	}
+}
+
+func TestFabricatedPlayerConsumedChangesNothing(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	cheater, cheaterState := joinGame(t, hub, "cheater")
+	victim, victimState := joinGame(t, hub, "victim")
+	//The cheater is the small one, far away, so it can't eat anyone for real
+	cheaterState.player.Radius = 20
+	victimState.player.Radius = 100
+	victimState.player.X = cheaterState.player.X + 5000
+	cheater.ClearSent()
+
+	//Sent from the cheater's socket, so it comes in as the cheater's own and gets checked
+	eatPlayer(cheater, victim.Id())
+	//Even handed to its state as if another client had decided it, it only goes back to the cheater
+	cheater.ProcessMessage(victim.Id()+100, &packets.Packet_PlayerConsumed{
+		PlayerConsumed: &packets.PlayerConsumedMessage{PlayerId: victim.Id()},
+	})
+
+	if _, exists := hub.SharedGameObjects.Players.Get(victim.Id()); !exists {
+		t.Fatal("the victim was taken out of the game")
+	}
+	if victimState.player.Radius != 100 || cheaterState.player.Radius != 20 {
+		t.Errorf("radii changed to %f (victim) and %f (cheater)", victimState.player.Radius, cheaterState.player.Radius)
+	}
+	if consumed := servertest.MessagesOf[*packets.Packet_PlayerConsumed](cheater.Broadcasts()); len(consumed) != 0 {
+		t.Error("the made up consumption was broadcast to the other clients")
+	}
+	if victim.StateName() != "InGame" || victim.QueuedTasks() != 0 {
+		t.Errorf("victim is in %s with %d tasks queued, it shouldn't have heard anything", victim.StateName(), victim.QueuedTasks())
+	}
+	if hub.AntiCheat.Count(cheater.Id()) == 0 {
+		t.Error("the made up consumption wasn't reported as suspicious")
+	}
+}
+
+// Has the client steer its player
+func steer(client *servertest.TestClient, direction float64) {
+	client.ProcessMessage(client.Id(), &packets.Packet_PlayerDirection{
+		PlayerDirection: &packets.PlayerDirectionMessage{Direction: direction},
+	})
+}
+
+func TestSteadyHeadingIsntAfk(t *testing.T) {
+	config := server.DefaultConfig()
+	config.AfkThreshold = 30 * time.Second
+	hub, clock := servertest.NewTestHub(config)
+	client, state := joinGame(t, hub, "cruiser")
+	//The test runs the update checks itself instead of the update loop
+	state.cancelPlayerUpdateLoop = func() {}
+
+	//Keeps going the same way for longer than the threshold
+	for i := 0; i < 4; i++ {
+		steer(client, 1.5)
+		clock.Advance(20 * time.Second)
+		if !state.shouldSendUpdate() || state.afk.Load() {
+			t.Fatalf("player holding a heading was marked AFK after %d updates", i+1)
+		}
+	}
+
+	//Stops sending anything, now it is AFK and only gets an update every so often
+	clock.Advance(time.Minute)
+	state.shouldSendUpdate()
+	if !state.afk.Load() {
+		t.Fatal("player that stopped steering wasn't marked AFK")
+	}
+	if state.shouldSendUpdate() {
+		t.Error("AFK player got two updates in a row")
+	}
+
+	steer(client, 1.5)
+	if !state.shouldSendUpdate() || state.afk.Load() {
+		t.Error("player is still AFK after steering again")
+	}
+}
+
+func TestIdleKickIsOffByDefault(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	_, state := joinGame(t, hub, "lurker")
+	if state.idleTimer != nil {
+		t.Error("an idle timer was started without IdleKickTimeout being set")
+	}
+}
+
+// A player still holding last season's best score (dead, spectating or in game, it doesn't matter)
+// has to start over once the season is reset, or their next score never gets saved
+func TestBestScoreStartsOverAfterASeasonReset(t *testing.T) {
+	dbPool := servertest.NewTestDb(t)
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	hub.UseDb(dbPool)
+	client := servertest.NewTestClient(hub)
+
+	ctx := context.Background()
+	queries := db.New(dbPool)
+	user, err := queries.CreateUser(ctx, db.CreateUserParams{Username: "veteran", PasswordHash: "hash"})
+	if err != nil {
+		t.Fatalf("creating the user: %v", err)
+	}
+	row, err := queries.CreatePlayer(ctx, db.CreatePlayerParams{UserID: user.ID, Name: "veteran"})
+	if err != nil {
+		t.Fatalf("creating the player: %v", err)
+	}
+
+	//Loaded last season, never mind how, the state isn't even entered
+	state := &InGame{player: &objects.Player{Name: "veteran", DbId: row.ID, BestScore: 5000, Radius: 20}}
+	state.SetClient(client)
+
+	if err := hub.ResetSeason(ctx, "2026-09"); err != nil {
+		t.Fatalf("resetting the season: %v", err)
+	}
+	state.syncPlayerBestScore()
+
+	want := int64(math.Round(radToMass(20)))
+	saved, err := queries.GetPlayerByName(ctx, "veteran")
+	if err != nil {
+		t.Fatalf("reading the player: %v", err)
+	}
+	if saved.BestScore != want {
+		t.Errorf("saved best score is %d, want this season's %d", saved.BestScore, want)
+	}
+	if state.player.BestScore != want {
+		t.Errorf("cached best score is %d, want %d", state.player.BestScore, want)
+	}
+}
+
+// Every spore in a batch is eaten and reported like a single one, and the broadcast carries what
+// was really eaten
+func TestBatchConsumeChecksEverySporeLikeASingleOne(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	client, state := joinGame(t, hub, "sweeper")
+	player := state.player
+	player.Radius = 100
+
+	var eaten []server.SporeEaten
+	hub.EventBus.Subscribe(func(event server.GameEvent) {
+		if sporeEaten, ok := event.(server.SporeEaten); ok {
+			eaten = append(eaten, sporeEaten)
+		}
+	})
+
+	big := hub.SharedGameObjects.Spores.Add(&objects.Spore{X: player.X, Y: player.Y, Radius: 20})
+	small := hub.SharedGameObjects.Spores.Add(&objects.Spore{X: player.X, Y: player.Y, Radius: 5})
+	far := hub.SharedGameObjects.Spores.Add(&objects.Spore{X: player.X + 5000, Y: player.Y, Radius: 5})
+	missing := far + 100
+	suspicionBefore := hub.AntiCheat.Count(client.Id())
+
+	client.ProcessMessage(client.Id(), &packets.Packet_BatchConsume{
+		BatchConsume: &packets.BatchConsumeMessage{SporeIds: []uint64{big, small, far, missing, small}},
+	})
+
+	batches := servertest.MessagesOf[*packets.Packet_BatchConsume](client.Broadcasts())
+	if len(batches) != 1 {
+		t.Fatalf("%d batches broadcast, want 1", len(batches))
+	}
+	batch := batches[0].BatchConsume
+	if len(batch.SporeIds) != 2 || batch.SporeIds[0] != big || batch.SporeIds[1] != small {
+		t.Errorf("broadcast spores %v, want only %d and %d", batch.SporeIds, big, small)
+	}
+	bigMass, smallMass := radToMass(20), radToMass(5)
+	if math.Abs(batch.Mass-(bigMass+smallMass)) > 1e-9 {
+		t.Errorf("broadcast mass %f, want %f", batch.Mass, bigMass+smallMass)
+	}
+	if batch.Feedback != packets.ConsumeFeedback_CONSUME_FEEDBACK_BIG_SPORE {
+		t.Errorf("feedback is %v, want big for the batch with the big spore in it", batch.Feedback)
+	}
+
+	//Each spore keeps its own mass instead of an average
+	if len(eaten) != 2 || eaten[0].Mass != bigMass || eaten[1].Mass != smallMass {
+		t.Errorf("spore eaten events %+v, want %f then %f", eaten, bigMass, smallMass)
+	}
+
+	//Too far, doesn't exist and already eaten by the time its second copy comes up, the same as
+	//if they'd come on their own
+	if got := hub.AntiCheat.Count(client.Id()) - suspicionBefore; got != 3 {
+		t.Errorf("%d suspicious spores reported, want 3", got)
+	}
+	if _, exists := hub.SharedGameObjects.Spores.Get(far); !exists {
+		t.Error("the spore that was too far got eaten")
+	}
+}
+
+func TestEmotesAreRateLimited(t *testing.T) {
+	hub, clock := servertest.NewTestHub(server.DefaultConfig())
+	client, _ := joinGame(t, hub, "waver")
+
+	for i := 0; i < 5; i++ {
+		client.ProcessMessage(client.Id(), packets.NewEmote(packets.EmoteType_EMOTE_WAVE))
+	}
+	if n := len(servertest.MessagesOf[*packets.Packet_Emote](client.Broadcasts())); n != 3 {
+		t.Fatalf("%d of 5 emotes in a row went out, want the burst of 3", n)
+	}
+
+	client.ClearSent()
+	clock.Advance(2 * time.Second)
+	client.ProcessMessage(client.Id(), packets.NewEmote(packets.EmoteType_EMOTE_GG))
+	if len(servertest.MessagesOf[*packets.Packet_Emote](client.Broadcasts())) != 1 {
+		t.Error("no emote allowed after waiting two seconds")
+	}
+}
+
+func TestMadeUpEmotesAreDropped(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	client, _ := joinGame(t, hub, "waver")
+	client.ClearSent()
+
+	client.ProcessMessage(client.Id(), packets.NewEmote(packets.EmoteType(99)))
+	if len(client.Broadcasts()) != 0 || len(client.Sent()) != 0 {
+		t.Errorf("an emote that doesn't exist went out: %v %v", client.Broadcasts(), client.SentMessages())
+	}
+}
+
+func TestEmotesOnlyReachPlayersNearby(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	sender, senderState := joinGame(t, hub, "waver")
+	receiver, receiverState := joinGame(t, hub, "watcher")
+	receiverState.player.X, receiverState.player.Y = 0, 0
+
+	for _, test := range []struct {
+		x         float64
+		forwarded bool
+	}{
+		{emoteRadius - 1, true},
+		{emoteRadius + 1, false},
+	} {
+		senderState.player.X, senderState.player.Y = test.x, 0
+		receiver.ClearSent()
+		receiver.ProcessMessage(sender.Id(), packets.NewEmote(packets.EmoteType_EMOTE_LAUGH))
+		if got := len(servertest.MessagesOf[*packets.Packet_Emote](receiver.SentMessages())) == 1; got != test.forwarded {
+			t.Errorf("emote from %.0f away forwarded: %v, want %v", test.x, got, test.forwarded)
+		}
+	}
+}
+
+func TestTooManyFailedValidationsKickOnlyWhenAskedTo(t *testing.T) {
+	for _, kick := range []bool{false, true} {
+		config := server.DefaultConfig()
+		config.SuspicionThreshold = 2
+		config.SuspicionKick = kick
+		hub, _ := servertest.NewTestHub(config)
+		cheater, _ := joinGame(t, hub, "cheater")
+
+		//Spores that were never on the map
+		for sporeId := uint64(1000); sporeId < 1002; sporeId++ {
+			cheater.ProcessMessage(cheater.Id(), &packets.Packet_SporeConsumed{
+				SporeConsumed: &packets.SporeConsumedMessage{SporeId: sporeId},
+			})
+		}
+
+		if hub.AntiCheat.Count(cheater.Id()) != 2 {
+			t.Errorf("kick %v: counted %d suspicions, want 2", kick, hub.AntiCheat.Count(cheater.Id()))
+		}
+		if cheater.Closing() != kick {
+			t.Errorf("kick %v: client closed %v", kick, cheater.Closing())
+		}
+	}
+}
+
+func TestGuestsBestScoreIsNeverSaved(t *testing.T) {
+	dbPool := servertest.NewTestDb(t)
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	hub.UseDb(dbPool)
+	_, state := joinGame(t, hub, "guest")
+	state.player.Radius = 200
+
+	state.syncPlayerBestScore()
+	if state.player.BestScore != 0 {
+		t.Errorf("guest got a best score of %d, there's nowhere to keep it", state.player.BestScore)
+	}
+
+	var rows int
+	if err := dbPool.QueryRow("SELECT COUNT(*) FROM players").Scan(&rows); err != nil {
+		t.Fatal(err)
+	}
+	if rows != 0 {
+		t.Errorf("%d player rows were written for a guest", rows)
+	}
+}
+
+func TestPlayerLeftOutsideAShrunkWorldIsPushedBackIn(t *testing.T) {
+	config := server.DefaultConfig()
+	config.OutOfBoundsMassLoss = 0.5
+	config.OutOfBoundsPushSpeed = 400
+	hub, _ := servertest.NewTestHub(config)
+	_, state := unenteredGame(hub, &objects.Player{Name: "straggler", X: 1300, Y: -1050, Radius: 50})
+	hub.SharedGameObjects.WorldBound.Set(1000)
+
+	state.syncPlayer(0.5)
+	if state.player.X != 1100 || state.player.Y != -1000 {
+		t.Errorf("player was pushed to (%f, %f), want (1100, -1000)", state.player.X, state.player.Y)
+	}
+	if state.player.Radius >= 50 {
+		t.Errorf("player outside the bound kept its radius of %f", state.player.Radius)
+	}
+}
+
+func TestPushInwardNeverOvershootsTheBound(t *testing.T) {
+	tests := []struct {
+		coord, want float64
+	}{
+		{1300, 1200},
+		{1050, 1000},
+		{-1050, -1000},
+		{-1300, -1200},
+		{500, 500},
+	}
+	for _, test := range tests {
+		if got := pushInward(test.coord, 1000, 100); got != test.want {
+			t.Errorf("pushInward(%f) = %f, want %f", test.coord, got, test.want)
+		}
+	}
+}
+
+func TestEatingAPlayerMakesTheKillFeed(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	eater, eaterState := joinGame(t, hub, "hunter")
+	victim, victimState := joinGame(t, hub, "prey")
+	lineUpMeal(eaterState.player, victimState.player)
+
+	eatPlayer(eater, victim.Id())
+
+	//The kill feed comes from the server, not from the eater's client
+	var feed *packets.Packet_KillFeed
+	timeout := time.After(time.Second)
+	for feed == nil {
+		select {
+		case packet := <-hub.BroadcastChan:
+			feed, _ = packet.Msg.(*packets.Packet_KillFeed)
+		case <-timeout:
+			t.Fatal("the kill never made it to the feed")
+		}
+	}
+	kill := feed.KillFeed
+	if kill.ConsumerId != eater.Id() || kill.ConsumerName != "hunter" || kill.VictimId != victim.Id() || kill.VictimName != "prey" {
+		t.Errorf("kill feed says %v", kill)
+	}
+
+	//Everyone still in the game shows it
+	eater.ClearSent()
+	eater.ProcessMessage(0, feed)
+	if len(servertest.MessagesOf[*packets.Packet_KillFeed](eater.SentMessages())) != 1 {
+		t.Error("the kill feed wasn't passed on to the client")
+	}
+}
+
+func TestEnteringTheGameSendsTheWorldSize(t *testing.T) {
+	config := server.DefaultConfig()
+	config.WorldBound = 1234
+	hub, _ := servertest.NewTestHub(config)
+	client, _ := joinGame(t, hub, "newcomer")
+
+	gameConfigs := servertest.MessagesOf[*packets.Packet_GameConfig](client.SentMessages())
+	if len(gameConfigs) != 1 || gameConfigs[0].GameConfig.WorldBound != 1234 {
+		t.Errorf("sent game configs %v, want one with a world bound of 1234", gameConfigs)
+	}
+}
+
+func TestEatingYourselfIsAnError(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	client, state := joinGame(t, hub, "ouroboros")
+	state.player.Radius = 100
+	client.ClearSent()
+
+	eatPlayer(client, client.Id())
+
+	errorPackets := servertest.MessagesOf[*packets.Packet_Error](client.SentMessages())
+	if len(errorPackets) != 1 {
+		t.Fatalf("sent %v, want one error", client.SentMessages())
+	}
+	if _, exists := hub.SharedGameObjects.Players.Get(client.Id()); !exists || state.player.Radius != 100 {
+		t.Error("the player changed after trying to eat itself")
+	}
+	if len(client.Broadcasts()) != 0 {
+		t.Errorf("broadcast %v", client.Broadcasts())
+	}
+}
+
+func TestTickDeltaFollowsTheClockOnlyWhenAskedTo(t *testing.T) {
+	tests := []struct {
+		realDelta bool
+		elapsed   time.Duration
+		want      float64
+	}{
+		{false, 80 * time.Millisecond, 0.05},
+		{true, 80 * time.Millisecond, 0.08},
+		{true, 30 * time.Millisecond, 0.03},
+		//A long stall moves the player no further than the max
+		{true, 3 * time.Second, 0.2},
+	}
+	for _, test := range tests {
+		config := server.DefaultConfig()
+		config.RealDeltaMovement = test.realDelta
+		config.MaxMoveDelta = 0.2
+		hub, _ := servertest.NewTestHub(config)
+		_, state := unenteredGame(hub, &objects.Player{Name: "runner"})
+
+		if got := state.tickDelta(0.05, test.elapsed); math.Abs(got-test.want) > 1e-9 {
+			t.Errorf("real delta %v after %v: moved by %f, want %f", test.realDelta, test.elapsed, got, test.want)
+		}
+	}
+}
+
+func TestTurningIsLimitedByTheTurnRate(t *testing.T) {
+	tests := []struct {
+		name              string
+		scaling           bool
+		radius            float64
+		direction, target float64
+		want              float64
+	}{
+		{"small turn is done at once", false, 25, 0, 0.05, 0.05},
+		{"big turn is cut short", false, 25, 0, 1, 0.1},
+		{"turning right", false, 25, 0, -1, -0.1},
+		//From just under pi to just over -pi is a short turn through pi, not all the way around
+		{"across pi", false, 25, 3.0, -3.0, 3.1},
+		{"twice the size turns half as fast", true, 50, 0, 1, 0.05},
+		{"smaller than at the start isn't any faster", true, 10, 0, 1, 0.1},
+	}
+	for _, test := range tests {
+		config := server.DefaultConfig()
+		config.MaxTurnRate = 1
+		config.TurnRateMassScaling = test.scaling
+		hub, _ := servertest.NewTestHub(config)
+		player := &objects.Player{Radius: test.radius, Direction: test.direction, TargetDirection: test.target}
+		_, state := unenteredGame(hub, player)
+
+		state.turnTowardsTarget(0.1)
+		if math.Abs(player.Direction-test.want) > 1e-9 {
+			t.Errorf("%s: turned to %f, want %f", test.name, player.Direction, test.want)
+		}
+	}
+}
+
+func TestWithoutATurnRateDirectionChangesAtOnce(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	client, state := joinGame(t, hub, "swerver")
+
+	steer(client, 2.5)
+	if state.player.Direction != 2.5 {
+		t.Errorf("direction is %f right after steering, want 2.5", state.player.Direction)
+	}
+}
+
+func TestConsumeOverlapDecidesHowFarInTheTargetHasToBe(t *testing.T) {
+	tests := []struct {
+		overlap  float64
+		distance float64
+		close    bool
+	}{
+		//Touching, the edges are 50+10 apart
+		{0, 59, true},
+		{0, 61, false},
+		//The spore's center inside the player
+		{0.5, 49, true},
+		{0.5, 51, false},
+		//All of the spore inside
+		{1, 39, true},
+		{1, 41, false},
+	}
+	for _, test := range tests {
+		config := server.DefaultConfig()
+		config.ConsumeOverlap = test.overlap
+		hub, _ := servertest.NewTestHub(config)
+		_, state := unenteredGame(hub, &objects.Player{Radius: 50})
+
+		err := state.validatePlayerCloseToObjects(test.distance, 0, 10, 0)
+		if (err == nil) != test.close {
+			t.Errorf("overlap %.1f at %.0f away: got %v, want close enough %v", test.overlap, test.distance, err, test.close)
+		}
+	}
+}
+
+func TestNoSporeIsDroppedOnAFullMap(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	//Big enough that it drops a spore every tick it can
+	_, state := unenteredGame(hub, &objects.Player{Name: "dropper", Radius: server.MaxSpores * 5})
+	spores := hub.SharedGameObjects.Spores
+	var lastId uint64
+	for spores.Len() < server.MaxSpores {
+		lastId = spores.Add(&objects.Spore{X: 2000, Y: 2000, Radius: 5})
+	}
+
+	state.syncPlayer(0.05)
+	if spores.Len() != server.MaxSpores || state.player.Radius != server.MaxSpores*5 {
+		t.Fatalf("dropped a spore on a full map, there are %d and the radius is %f", spores.Len(), state.player.Radius)
+	}
+
+	spores.Remove(lastId)
+	state.syncPlayer(0.05)
+	if spores.Len() != server.MaxSpores {
+		t.Error("no spore was dropped once there was room")
+	}
+}
+
+func TestDespawnedSporesArePassedOn(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	client, _ := joinGame(t, hub, "watcher")
+	client.ClearSent()
+
+	client.ProcessMessage(0, packets.NewSporesDespawned([]uint64{4, 8}))
+	despawned := servertest.MessagesOf[*packets.Packet_SporesDespawned](client.SentMessages())
+	if len(despawned) != 1 || len(despawned[0].SporesDespawned.SporeIds) != 2 {
+		t.Errorf("sent %v, want the despawned spores", client.SentMessages())
+	}
+}
+
+func TestOwnDropCooldownRunsOnTheHubClock(t *testing.T) {
+	hub, clock := servertest.NewTestHub(server.DefaultConfig())
+	_, state := unenteredGame(hub, &objects.Player{Radius: 50, Speed: 100})
+	//60 between the edges at 100 per second, so it takes 600ms to get off the spore
+	spore := &objects.Spore{Radius: 10, DroppedBy: state.player, DroppedAt: clock.Now()}
+
+	clock.Advance(500 * time.Millisecond)
+	if err := state.validatePlayerDropCooldown(spore, 0); err == nil {
+		t.Error("eating the spore back after 500ms was allowed")
+	}
+	clock.Advance(200 * time.Millisecond)
+	if err := state.validatePlayerDropCooldown(spore, 0); err != nil {
+		t.Errorf("eating the spore back after 700ms was refused: %v", err)
+	}
+}
+
+func TestConsumptionsFarAwayAreOnlySeenByWhoTheyConcern(t *testing.T) {
+	config := server.DefaultConfig()
+	config.ConsumeEventRadius = 500
+	hub, _ := servertest.NewTestHub(config)
+	watcher, watcherState := joinGame(t, hub, "watcher")
+	near, nearState := joinGame(t, hub, "near")
+	far, farState := joinGame(t, hub, "far")
+	watcherState.player.X, watcherState.player.Y = 0, 0
+	nearState.player.X, nearState.player.Y = 100, 0
+	farState.player.X, farState.player.Y = 2000, 0
+
+	sporeEaten := func(sporeId uint64) packets.Msg {
+		return &packets.Packet_SporeConsumed{SporeConsumed: &packets.SporeConsumedMessage{SporeId: sporeId}}
+	}
+	watcher.ClearSent()
+	watcher.ProcessMessage(near.Id(), sporeEaten(1))
+	watcher.ProcessMessage(far.Id(), sporeEaten(2))
+	sent := watcher.SentMessages()
+	if consumed := servertest.MessagesOf[*packets.Packet_SporeConsumed](sent); len(consumed) != 1 || consumed[0].SporeConsumed.SporeId != 1 {
+		t.Errorf("saw the spore consumptions %v, want only the near one", consumed)
+	}
+	//The far spore still has to disappear
+	if despawned := servertest.MessagesOf[*packets.Packet_SporesDespawned](sent); len(despawned) != 1 || despawned[0].SporesDespawned.SporeIds[0] != 2 {
+		t.Errorf("despawned %v, want the far spore", despawned)
+	}
+
+	watcher.ClearSent()
+	watcher.ProcessMessage(far.Id(), &packets.Packet_PlayerConsumed{PlayerConsumed: &packets.PlayerConsumedMessage{PlayerId: near.Id()}})
+	if len(watcher.Sent()) != 0 {
+		t.Errorf("saw a far away player eat someone else: %v", watcher.SentMessages())
+	}
+	watcher.ProcessMessage(far.Id(), &packets.Packet_PlayerConsumed{PlayerConsumed: &packets.PlayerConsumedMessage{PlayerId: watcher.Id()}})
+	if len(servertest.MessagesOf[*packets.Packet_PlayerConsumed](watcher.SentMessages())) != 1 {
+		t.Error("wasn't told about being eaten from far away")
+	}
+}
+
+func TestNobodyEatsADroppedSporeBeforeItsOldEnough(t *testing.T) {
+	config := server.DefaultConfig()
+	config.MinSporeAge = time.Second
+	hub, clock := servertest.NewTestHub(config)
+	_, eater := unenteredGame(hub, &objects.Player{Radius: 50, Speed: 100})
+	dropper := &objects.Player{Radius: 50, Speed: 100}
+	dropped := &objects.Spore{Radius: 10, DroppedBy: dropper, DroppedAt: clock.Now()}
+	placed := &objects.Spore{Radius: 10}
+
+	clock.Advance(900 * time.Millisecond)
+	if err := eater.validatePlayerDropCooldown(dropped, 0); err == nil {
+		t.Error("someone else's spore was eaten before it was a second old")
+	}
+	if err := eater.validatePlayerDropCooldown(placed, 0); err != nil {
+		t.Errorf("a spore the server placed was held back: %v", err)
+	}
+	clock.Advance(100 * time.Millisecond)
+	if err := eater.validatePlayerDropCooldown(dropped, 0); err != nil {
+		t.Errorf("a second old spore was refused: %v", err)
+	}
+}
+
+func TestLateJoinersCatchUpOnTheChat(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	talker, _ := joinGame(t, hub, "talker")
+	talker.ProcessMessage(talker.Id(), &packets.Packet_Chat{Chat: &packets.ChatMessage{Msg: "anyone here?"}})
+
+	//Only a player that wants the chat gets the history
+	listener := servertest.NewTestClient(hub)
+	listener.SetState(&InGame{player: &objects.Player{Name: "listener", Settings: objects.DefaultPlayerSettings()}})
+	t.Cleanup(func() { listener.Close("test over") })
+	histories := servertest.MessagesOf[*packets.Packet_ChatHistory](listener.SentMessages())
+	if len(histories) != 1 {
+		t.Fatalf("got %d chat histories, want 1", len(histories))
+	}
+	entries := histories[0].ChatHistory.Entries
+	if len(entries) != 1 || entries[0].Msg != "anyone here?" || entries[0].SenderId != talker.Id() || entries[0].SenderName != "talker" {
+		t.Errorf("chat history is %v, want the talker's message", entries)
+	}
+
+	deaf, _ := joinGame(t, hub, "deaf")
+	if histories := servertest.MessagesOf[*packets.Packet_ChatHistory](deaf.SentMessages()); len(histories) != 0 {
+		t.Error("a player with the chat off got the history")
+	}
+}
+
+func TestOnlyPlayersNearbyHearAboutDisconnects(t *testing.T) {
+	config := server.DefaultConfig()
+	config.DisconnectEventRadius = 1000
+	hub, _ := servertest.NewTestHub(config)
+	client, state := unenteredGame(hub, &objects.Player{X: 0, Y: 0})
+	leftAt := func(inGame bool, x float64) *packets.Packet_Disconnect {
+		return &packets.Packet_Disconnect{Disconnect: &packets.DisconnectMessage{Reason: "bye", InGame: inGame, X: x}}
+	}
+
+	state.handleDisconnect(client.Id()+1, leftAt(true, 800))
+	if disconnects := servertest.MessagesOf[*packets.Packet_Disconnect](client.SentMessages()); len(disconnects) != 1 {
+		t.Errorf("got %d disconnects for a player leaving nearby, want 1", len(disconnects))
+	}
+
+	client.ClearSent()
+	state.handleDisconnect(client.Id()+2, leftAt(true, 1200))
+	sent := client.SentMessages()
+	if len(servertest.MessagesOf[*packets.Packet_Disconnect](sent)) != 0 {
+		t.Error("heard about a player leaving far away")
+	}
+	if despawns := servertest.MessagesOf[*packets.Packet_PlayerDespawned](sent); len(despawns) != 1 || despawns[0].PlayerDespawned.PlayerId != client.Id()+2 {
+		t.Errorf("despawned %v, want the far player", despawns)
+	}
+
+	//A client that never made it into the game has nothing to take off the map
+	client.ClearSent()
+	state.handleDisconnect(client.Id()+3, leftAt(false, 0))
+	if len(client.Sent()) != 0 {
+		t.Errorf("got %v for a client leaving from outside the game", client.SentMessages())
+	}
+}
+
+func TestEjectingShootsMassOutFront(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	client, state := unenteredGame(hub, &objects.Player{Radius: 50, Direction: math.Pi / 2})
+	eject := &packets.Packet_Eject{Eject: &packets.EjectMessage{}}
+
+	state.handleEject(client.Id(), eject)
+	if state.player.Radius >= 50 {
+		t.Errorf("player radius is %f after ejecting, want less than 50", state.player.Radius)
+	}
+	moving := 0
+	hub.SharedGameObjects.MovingSpores.ForEach(func(_ uint64, spore *objects.Spore) {
+		moving++
+		if spore.Y <= state.player.Radius || spore.VY <= 0 || math.Abs(spore.VX) > 1e-9 {
+			t.Errorf("ejected spore is at (%f, %f) going (%f, %f), want it in front going straight on", spore.X, spore.Y, spore.VX, spore.VY)
+		}
+		if spore.DroppedBy != state.player {
+			t.Error("the ejected spore doesn't count as dropped by the player")
+		}
+	})
+	if moving != 1 {
+		t.Fatalf("%d spores are moving after one eject, want 1", moving)
+	}
+
+	//Not for others to do, and never below the starting size
+	state.handleEject(client.Id()+1, eject)
+	state.player.Radius = startingRadius
+	state.handleEject(client.Id(), eject)
+	if count := hub.SharedGameObjects.Spores.Len(); count != 1 {
+		t.Errorf("%d spores after the ejects that shouldn't go through, want 1", count)
+	}
+	if state.player.Radius != startingRadius {
+		t.Errorf("a starting size player shrank to %f", state.player.Radius)
+	}
+}
+
+func TestTicksAndOthersEatingDontPileUpGoroutines(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	_, state := unenteredGame(hub, &objects.Player{Name: "busy", Radius: 40, Speed: 150})
+	before := runtime.NumGoroutine()
+
+	for i := range 1000 {
+		state.syncPlayer(0.05)
+		state.handleSporeConsumed(state.client.Id()+1, &packets.Packet_SporeConsumed{
+			SporeConsumed: &packets.SporeConsumedMessage{SporeId: uint64(i)},
+		})
+	}
+
+	//A couple of goroutines from elsewhere can come and go, a thousand per tick can't hide
+	if after := runtime.NumGoroutine(); after > before+5 {
+		t.Errorf("%d goroutines after a thousand ticks, there were %d before", after, before)
+	}
+}
+
+func TestPeerPlayerUpdatesAreCheckedAgainstTheServer(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig()) //50 tolerance
+	client := servertest.NewTestClient(hub)
+	const peerId = 7
+	hub.SharedGameObjects.Players.Add(&objects.Player{Name: "peer", X: 100, Y: 100, Radius: 40}, peerId)
+
+	tests := []struct {
+		name       string
+		id         uint64
+		x, radius  float64
+		passesAsIs bool
+	}{
+		{"close enough", peerId, 140, 60, true},
+		{"too far off", peerId, 200, 40, false},
+		{"grown too much", peerId, 100, 100, false},
+		{"someone else's id", peerId + 1, 100, 40, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			message := &packets.Packet_Player{Player: &packets.PlayerMessage{Id: test.id, X: test.x, Y: 100, Radius: test.radius}}
+			verified, ok := verifyPeerPlayer(client, peerId, message)
+			if !ok {
+				t.Fatal("the update was dropped for a player in the game")
+			}
+			if passed := verified == message; passed != test.passesAsIs {
+				t.Errorf("passed as is: %v, want %v", passed, test.passesAsIs)
+			}
+			if !test.passesAsIs && (verified.Player.Id != peerId || verified.Player.X != 100 || verified.Player.Radius != 40) {
+				t.Errorf("replaced with %v, want the server's version", verified.Player)
+			}
+		})
+	}
+
+	if _, ok := verifyPeerPlayer(client, peerId+1, &packets.Packet_Player{Player: &packets.PlayerMessage{Id: peerId + 1}}); ok {
+		t.Error("an update for a player that isn't in the game went through")
+	}
+}
+
+func TestChatLengthIsCountedInCharacters(t *testing.T) {
+	config := server.DefaultConfig()
+	config.ChatMaxLength = 5
+	hub, _ := servertest.NewTestHub(config)
+	client, state := unenteredGame(hub, &objects.Player{Name: "talker"})
+
+	state.HandleChat(client.Id(), &packets.Packet_Chat{Chat: &packets.ChatMessage{Msg: "héllo"}})
+	state.HandleChat(client.Id(), &packets.Packet_Chat{Chat: &packets.ChatMessage{Msg: "héllo!"}})
+	if chats := servertest.MessagesOf[*packets.Packet_Chat](client.Broadcasts()); len(chats) != 1 || chats[0].Chat.Msg != "héllo" {
+		t.Errorf("broadcast %v, want only the 5 character message", chats)
+	}
+	if len(servertest.MessagesOf[*packets.Packet_ChatError](client.SentMessages())) != 1 {
+		t.Error("wasn't told the long message was refused")
+	}
+}
+
+func TestChatIsRateLimited(t *testing.T) {
+	hub, clock := servertest.NewTestHub(server.DefaultConfig())
+	client, state := unenteredGame(hub, &objects.Player{Name: "spammer"})
+	chat := &packets.Packet_Chat{Chat: &packets.ChatMessage{Msg: "spam"}}
+	broadcasts := func() int {
+		return len(servertest.MessagesOf[*packets.Packet_Chat](client.Broadcasts()))
+	}
+
+	for range 6 {
+		state.HandleChat(client.Id(), chat)
+	}
+	if broadcasts() != 5 {
+		t.Errorf("%d of 6 messages in a row went out, want the burst of 5", broadcasts())
+	}
+	clock.Advance(time.Second)
+	state.HandleChat(client.Id(), chat)
+	if broadcasts() != 6 {
+		t.Error("couldn't chat again a second later")
+	}
+}
+
+func TestTypingOnlyGoesOutWhenItChanges(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	client, state := unenteredGame(hub, &objects.Player{Name: "typist"})
+	typing := func(typing bool) *packets.Packet_Typing {
+		return &packets.Packet_Typing{Typing: &packets.TypingMessage{Typing: typing}}
+	}
+
+	state.handleTyping(client.Id(), typing(true))
+	state.handleTyping(client.Id(), typing(true))
+	state.handleTyping(client.Id(), typing(false))
+	sent := servertest.MessagesOf[*packets.Packet_Typing](client.Broadcasts())
+	if len(sent) != 2 || !sent[0].Typing.Typing || sent[1].Typing.Typing {
+		t.Errorf("broadcast %v, want a start and a stop", sent)
+	}
+
+	//Starting over and over runs out, stopping never does
+	for range 10 {
+		state.handleTyping(client.Id(), typing(true))
+		state.handleTyping(client.Id(), typing(false))
+	}
+	starts, stops := 0, 0
+	for _, message := range servertest.MessagesOf[*packets.Packet_Typing](client.Broadcasts()) {
+		if message.Typing.Typing {
+			starts++
+		} else {
+			stops++
+		}
+	}
+	if starts != 4 || stops != 4 {
+		t.Errorf("%d starts and %d stops went out, want the burst of 4 of each", starts, stops)
+	}
+}
+
+func TestOnlyPlayersNearbySeeSomeoneTyping(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	client, state := unenteredGame(hub, &objects.Player{X: 0})
+	hub.SharedGameObjects.Players.Add(&objects.Player{X: 1000}, 10)
+	hub.SharedGameObjects.Players.Add(&objects.Player{X: 2000}, 11)
+	typing := &packets.Packet_Typing{Typing: &packets.TypingMessage{Typing: true}}
+
+	state.handleTyping(10, typing)
+	state.handleTyping(11, typing)
+	sent := client.Sent()
+	if len(sent) != 1 || sent[0].SenderId != 10 {
+		t.Errorf("sent %v, want only the nearby player typing", sent)
+	}
+}
+
+func TestDriftModes(t *testing.T) {
+	tests := []struct {
+		mode   string
+		x, y   float64
+		dx, dy float64
+	}{
+		{server.DriftNone, 300, 400, 0, 0},
+		{server.DriftCenter, 300, 400, -30, -40},
+		{server.DriftCenter, 3, 4, -3, -4}, //lands on the center instead of going past it
+		{server.DriftWind, 300, 400, 0, 50},
+	}
+	for _, test := range tests {
+		config := server.DefaultConfig()
+		config.DriftMode = test.mode
+		config.DriftStrength = 50
+		config.DriftWindAngle = math.Pi / 2
+		hub, _ := servertest.NewTestHub(config)
+		_, state := unenteredGame(hub, &objects.Player{})
+
+		dx, dy := state.drift(test.x, test.y, 1)
+		if math.Abs(dx-test.dx) > 1e-9 || math.Abs(dy-test.dy) > 1e-9 {
+			t.Errorf("%s drift from (%.0f, %.0f) is (%f, %f), want (%.0f, %.0f)", test.mode, test.x, test.y, dx, dy, test.dx, test.dy)
+		}
+	}
+}
+
+func TestPointDriftFollowsThePointAround(t *testing.T) {
+	config := server.DefaultConfig()
+	config.DriftMode = server.DriftPoint
+	config.DriftPointSpeed = math.Pi / 10 //half way around in 10 seconds
+	hub, clock := servertest.NewTestHub(config)
+	_, state := unenteredGame(hub, &objects.Player{})
+
+	//From the center the pull is always the full strength, just pointing at wherever the point is
+	beforeX, beforeY := state.drift(0, 0, 1)
+	clock.Advance(10 * time.Second)
+	afterX, afterY := state.drift(0, 0, 1)
+	if math.Abs(math.Hypot(beforeX, beforeY)-config.DriftStrength) > 1e-6 {
+		t.Errorf("drift from the center is (%f, %f), want it %.0f long", beforeX, beforeY, config.DriftStrength)
+	}
+	if math.Abs(beforeX+afterX) > 1e-3 || math.Abs(beforeY+afterY) > 1e-3 { //the angle comes from a unix time, so not exact
+		t.Errorf("drift went from (%f, %f) to (%f, %f), the point should be on the other side", beforeX, beforeY, afterX, afterY)
+	}
+}
+
+func TestPlayersAreSentTheirConnectionStats(t *testing.T) {
+	config := server.DefaultConfig()
+	config.ConnectionStatsInterval = 200 * time.Millisecond
+	hub, _ := servertest.NewTestHub(config)
+	client, _ := joinGame(t, hub, "laggy")
+	client.SetRtt(80 * time.Millisecond)
+
+	steer(client, 0) //starts the update loop, its ticks are what the tick rate counts
+
+	var stats *packets.ConnectionStatsMessage
+	waitFor(t, "connection stats with a tick rate", func() bool {
+		sent := servertest.MessagesOf[*packets.Packet_ConnectionStats](client.SentMessages())
+		if len(sent) == 0 {
+			return false
+		}
+		stats = sent[len(sent)-1].ConnectionStats
+		return stats.TickRate > 0
+	})
+	if stats.PingMs != 80 {
+		t.Errorf("ping is %dms, want 80ms", stats.PingMs)
+	}
+}
+
+func TestMatchSummaryAddsUpTheLivesOfASession(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	player := &objects.Player{Name: "regular", Radius: 30}
+
+	firstClient, firstLife := unenteredGame(hub, player)
+	firstLife.sporesEaten, firstLife.distance, firstLife.eaten = 3, 100, true
+	firstLife.maxMass = radToMass(60)
+	firstLife.sendMatchSummary()
+	if summaries := servertest.MessagesOf[*packets.Packet_MatchSummary](firstClient.SentMessages()); len(summaries) != 1 || summaries[0].MatchSummary.TimesEaten != 1 {
+		t.Fatalf("sent %v after the first life, want a summary with one death", summaries)
+	}
+
+	secondClient, secondLife := unenteredGame(hub, player)
+	secondLife.sporesEaten, secondLife.playersEaten, secondLife.distance = 2, 1, 50
+	secondLife.sendMatchSummary()
+	summaries := servertest.MessagesOf[*packets.Packet_MatchSummary](secondClient.SentMessages())
+	if len(summaries) != 1 {
+		t.Fatalf("sent %d summaries after the second life, want 1", len(summaries))
+	}
+	summary := summaries[0].MatchSummary
+	if summary.SporesEaten != 5 || summary.PlayersEaten != 1 || summary.TimesEaten != 1 || summary.Distance != 150 {
+		t.Errorf("summary is %v, want both lives added up", summary)
+	}
+	//The biggest the player got over both lives, not the size they ended the last one at
+	if summary.MaxMass != uint64(math.Round(radToMass(60))) {
+		t.Errorf("max mass is %d, want the first life's %.0f", summary.MaxMass, radToMass(60))
+	}
+}
+
+func TestConsumptionsGoOutTaggedWithHowBigTheyWere(t *testing.T) {
+	config := server.DefaultConfig()
+	config.BigSporeMass = radToMass(15)
+	config.BigPlayerMass = radToMass(15)
+	hub, _ := servertest.NewTestHub(config)
+	client, state := joinGame(t, hub, "eater")
+	victim, victimState := joinGame(t, hub, "victim")
+
+	for _, radius := range []float64{10, 20} {
+		sporeId := hub.SharedGameObjects.Spores.Add(&objects.Spore{X: state.player.X, Y: state.player.Y, Radius: radius})
+		client.ProcessMessage(client.Id(), &packets.Packet_SporeConsumed{
+			SporeConsumed: &packets.SporeConsumedMessage{SporeId: sporeId},
+		})
+	}
+	spores := servertest.MessagesOf[*packets.Packet_SporeConsumed](client.Broadcasts())
+	if len(spores) != 2 {
+		t.Fatalf("broadcast %d spore consumptions, want 2", len(spores))
+	}
+	if spores[0].SporeConsumed.Feedback != packets.ConsumeFeedback_CONSUME_FEEDBACK_SMALL_SPORE || spores[1].SporeConsumed.Feedback != packets.ConsumeFeedback_CONSUME_FEEDBACK_BIG_SPORE {
+		t.Errorf("spores were tagged %v and %v, want small then big", spores[0].SporeConsumed.Feedback, spores[1].SporeConsumed.Feedback)
+	}
+	if spores[1].SporeConsumed.Mass != radToMass(20) {
+		t.Errorf("the big spore gave %f mass, want %f", spores[1].SporeConsumed.Mass, radToMass(20))
+	}
+
+	lineUpMeal(state.player, victimState.player)
+	eatPlayer(client, victim.Id())
+	players := servertest.MessagesOf[*packets.Packet_PlayerConsumed](client.Broadcasts())
+	if len(players) != 1 || players[0].PlayerConsumed.Feedback != packets.ConsumeFeedback_CONSUME_FEEDBACK_BIG_PLAYER || players[0].PlayerConsumed.Mass <= 0 {
+		t.Errorf("broadcast %v, want a big player consumption with the mass gained", players)
+	}
+}
+
+func TestSoftCollisionOnlyPushesApartPlayersThatCantEatEachOther(t *testing.T) {
+	config := server.DefaultConfig()
+	config.SoftCollision = true
+	hub, _ := servertest.NewTestHub(config)
+	_, state := unenteredGame(hub, &objects.Player{Radius: 20})
+	neighbour := &objects.Player{X: 30, Radius: 20}
+	hub.SharedGameObjects.Players.Add(neighbour, 100)
+
+	//A whole second is more than enough to move the full half of the overlap
+	pushX, pushY := state.collisionPush(0, 0, 1)
+	scale := config.CollisionRadiusScale
+	overlap := state.player.CollisionRadius(scale) + neighbour.CollisionRadius(scale) - 30
+	if math.Abs(pushX+overlap/2) > 1e-9 || pushY != 0 {
+		t.Errorf("pushed by (%f, %f), want (%f, 0)", pushX, pushY, -overlap/2)
+	}
+
+	//Big enough to eat us, so overlapping is fine
+	neighbour.Radius = 100
+	if pushX, pushY := state.collisionPush(0, 0, 1); pushX != 0 || pushY != 0 {
+		t.Errorf("a player that could eat us pushed us by (%f, %f)", pushX, pushY)
+	}
+
+	neighbour.Radius = 20
+	config.SoftCollision = false
+	if pushX, pushY := state.collisionPush(0, 0, 1); pushX != 0 || pushY != 0 {
+		t.Errorf("pushed by (%f, %f) with soft collision off", pushX, pushY)
+	}
+}
+
+func TestFrozenPlayersStayPut(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	_, state := unenteredGame(hub, &objects.Player{X: 10, Y: 20, Radius: 30, Speed: 150})
+	state.player.Frozen.Store(true)
+
+	state.syncPlayer(0.5)
+	if state.player.X != 10 || state.player.Y != 20 {
+		t.Errorf("a frozen player moved to (%f, %f)", state.player.X, state.player.Y)
+	}
+
+	state.player.Frozen.Store(false)
+	state.syncPlayer(0.5)
+	if state.player.X == 10 {
+		t.Error("the player didn't move once it was let go")
+	}
+}
+
+func TestResyncSendsWhatsInView(t *testing.T) {
+	config := server.DefaultConfig()
+	config.ResyncRadius = 1000
+	hub, _ := servertest.NewTestHub(config)
+	client, state := unenteredGame(hub, &objects.Player{Name: "lost", X: 100, Radius: 30})
+	hub.SharedGameObjects.Players.Add(state.player, client.Id())
+	hub.SharedGameObjects.Players.Add(&objects.Player{Name: "near", X: 900}, 50)
+	hub.SharedGameObjects.Players.Add(&objects.Player{Name: "far", X: 1500}, 51)
+	nearSpore := hub.SharedGameObjects.Spores.Add(&objects.Spore{X: -800, Radius: 10})
+	hub.SharedGameObjects.Spores.Add(&objects.Spore{X: -1000, Radius: 10})
+	resync := &packets.Packet_RequestResync{RequestResync: &packets.RequestResyncMessage{}}
+
+	state.handleRequestResync(client.Id(), resync)
+	resyncs := servertest.MessagesOf[*packets.Packet_Resync](client.SentMessages())
+	if len(resyncs) != 1 {
+		t.Fatalf("sent %d resyncs, want 1", len(resyncs))
+	}
+	view := resyncs[0].Resync
+	if view.Player.Id != client.Id() || view.Player.Radius != 30 {
+		t.Errorf("own player in the resync is %v", view.Player)
+	}
+	if len(view.Players) != 1 || view.Players[0].Id != 50 {
+		t.Errorf("players in the resync are %v, want only the near one", view.Players)
+	}
+	if len(view.Spores) != 1 || view.Spores[0].Id != nearSpore {
+		t.Errorf("spores in the resync are %v, want only the near one", view.Spores)
+	}
+
+	//Couple in a row, then they have to wait
+	client.ClearSent()
+	state.handleRequestResync(client.Id(), resync)
+	state.handleRequestResync(client.Id(), resync)
+	if len(servertest.MessagesOf[*packets.Packet_Resync](client.SentMessages())) != 1 || len(servertest.MessagesOf[*packets.Packet_Error](client.SentMessages())) != 1 {
+		t.Errorf("sent %v, want one more resync and then an error", client.SentMessages())
+	}
+}
+
+func TestLeavingTheGameSendsTheGameOver(t *testing.T) {
+	hub, clock := servertest.NewTestHub(server.DefaultConfig())
+	client, _ := joinGame(t, hub, "middling")
+	hub.SharedGameObjects.Players.Add(&objects.Player{Name: "bigger", Radius: startingRadius * 2}, 50)
+	hub.SharedGameObjects.Players.Add(&objects.Player{Name: "smaller", Radius: startingRadius / 2}, 51)
+	clock.Advance(90 * time.Second)
+
+	client.SetState(&Connected{})
+	gameOvers := servertest.MessagesOf[*packets.Packet_GameOver](client.SentMessages())
+	if len(gameOvers) != 1 {
+		t.Fatalf("sent %d game overs, want 1", len(gameOvers))
+	}
+	gameOver := gameOvers[0].GameOver
+	if gameOver.Rank != 2 || gameOver.Total != 3 {
+		t.Errorf("finished %d of %d, want 2 of 3", gameOver.Rank, gameOver.Total)
+	}
+	if gameOver.FinalMass != uint64(math.Round(radToMass(startingRadius))) || gameOver.SessionLength != 90 {
+		t.Errorf("game over is %v, want the starting mass after 90 seconds", gameOver)
+	}
+}
+
+func TestSporeConsumeRatio(t *testing.T) {
+	tests := []struct {
+		ratio        float64
+		playerRadius float64
+		allowed      bool
+	}{
+		{0, 10, true},  //off, a spore bigger than the player is still fine
+		{2, 30, true},  //9 times the spore's mass
+		{2, 20, true},  //4 times
+		{2, 14, false}, //just under twice
+		{4, 20, false}, //exactly the ratio isn't enough
+	}
+	for _, test := range tests {
+		config := server.DefaultConfig()
+		config.SporeConsumeRatio = test.ratio
+		hub, _ := servertest.NewTestHub(config)
+		_, state := unenteredGame(hub, &objects.Player{Radius: test.playerRadius})
+
+		err := state.validateMassiveEnoughForSpore(&objects.Spore{Radius: 10})
+		if (err == nil) != test.allowed {
+			t.Errorf("ratio %.0f, player radius %.0f: allowed is %v, want %v", test.ratio, test.playerRadius, err == nil, test.allowed)
+		}
+	}
+}
+
+func TestChangingColorMidGame(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	client, state := unenteredGame(hub, &objects.Player{Name: "chameleon", Color: 0x112233FF})
+	changeColor := func(color int32, skinId uint32) {
+		state.handleChangeColor(client.Id(), &packets.Packet_ChangeColor{
+			ChangeColor: &packets.ChangeColorMessage{Color: color, SkinId: skinId},
+		})
+	}
+
+	changeColor(0x445566FF, 1)
+	if state.player.Color != 0x445566FF || state.player.SkinId != 1 {
+		t.Errorf("player has color %x and skin %d, want 445566ff and 1", state.player.Color, state.player.SkinId)
+	}
+	if changes := servertest.MessagesOf[*packets.Packet_ChangeColor](client.Broadcasts()); len(changes) != 1 {
+		t.Errorf("broadcast %d color changes, want 1", len(changes))
+	}
+
+	changeColor(0x44556600, 1)
+	changeColor(0x445566FF, objects.MaxSkinId+1)
+	if state.player.Color != 0x445566FF || state.player.SkinId != 1 {
+		t.Error("a see through color or a made up skin was taken")
+	}
+	if errorPackets := servertest.MessagesOf[*packets.Packet_Error](client.SentMessages()); len(errorPackets) != 2 {
+		t.Errorf("sent %d errors for the bad looks, want 2", len(errorPackets))
+	}
+
+	//Two more make the burst of 3, the one after that is too fast
+	changeColor(0x000000FF, 0)
+	changeColor(0x7F7F7FFF, 0)
+	changeColor(0x777777FF, 0)
+	if state.player.Color != 0x7F7F7FFF {
+		t.Errorf("player color is %x, want the last one before the limit", state.player.Color)
+	}
+}
+
+func TestBigSporeBatchesAreSplitToFitTheFrameSize(t *testing.T) {
+	config := server.DefaultConfig()
+	config.MaxSporeBatchBytes = 600
+	hub, _ := servertest.NewTestHub(config)
+	client, _ := unenteredGame(hub, &objects.Player{})
+	spores := make(map[uint64]*objects.Spore)
+	for id := uint64(1); id <= 100; id++ {
+		spores[id] = &objects.Spore{X: float64(id) * 10, Y: -float64(id) * 10, Radius: 10}
+	}
+
+	sendSporeBatch(client, spores)
+	batches := servertest.MessagesOf[*packets.Packet_SporesBatch](client.SentMessages())
+	if len(batches) < 2 {
+		t.Fatalf("sent %d batches, want the spores split up", len(batches))
+	}
+	seen := make(map[uint64]bool)
+	for _, batch := range batches {
+		if size := packets.Size(batch); size > config.MaxSporeBatchBytes {
+			t.Errorf("a batch is %d bytes, over the %d limit", size, config.MaxSporeBatchBytes)
+		}
+		for _, spore := range batch.SporesBatch.Spores {
+			if spore != nil { //NewSporeBatch starts its slice off with empty entries
+				seen[spore.Id] = true
+			}
+		}
+	}
+	if len(seen) != len(spores) {
+		t.Errorf("%d of the %d spores were sent", len(seen), len(spores))
+	}
+}
+
+func TestPlayersAreEatenByTheirCollisionRadius(t *testing.T) {
+	for _, test := range []struct {
+		scale float64
+		eaten bool
+	}{
+		{1, true},    //the drawn circles overlap plenty at 100 apart
+		{0.5, false}, //but a 50 and a 10 don't reach
+	} {
+		config := server.DefaultConfig()
+		config.CollisionRadiusScale = test.scale
+		hub, _ := servertest.NewTestHub(config)
+		eater, eaterState := joinGame(t, hub, "eater")
+		victim, victimState := joinGame(t, hub, "victim")
+		lineUpMeal(eaterState.player, victimState.player)
+		victimState.player.X += 100
+
+		eatPlayer(eater, victim.Id())
+		_, stillThere := hub.SharedGameObjects.Players.Get(victim.Id())
+		if stillThere == test.eaten {
+			t.Errorf("with a collision scale of %.1f the victim was eaten: %v, want %v", test.scale, !stillThere, test.eaten)
+		}
+	}
+}
+
+func TestMutedPlayersChatIsntPassedOn(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	talker, _ := joinGame(t, hub, "talker")
+	listener, listenerState := joinGame(t, hub, "listener")
+	listenerState.player.Settings.ChatEnabled = true //so only the mute keeps the chat out
+	heard := func() int {
+		return len(servertest.MessagesOf[*packets.Packet_Chat](listener.SentMessages()))
+	}
+
+	listener.ProcessMessage(listener.Id(), packets.NewMute(talker.Id()))
+	if mutes := servertest.MessagesOf[*packets.Packet_Mute](listener.SentMessages()); len(mutes) != 1 || mutes[0].Mute.TargetId != talker.Id() {
+		t.Fatalf("muting wasn't confirmed, got %v", mutes)
+	}
+	listener.ProcessMessage(talker.Id(), packets.NewChat("can you hear me?"))
+	if heard() != 0 {
+		t.Error("chat from a muted player was passed on")
+	}
+
+	//Someone else can't mute for us
+	listener.ProcessMessage(talker.Id(), packets.NewUnmute(talker.Id()))
+	listener.ProcessMessage(talker.Id(), packets.NewChat("how about now?"))
+	if heard() != 0 {
+		t.Error("another player unmuted themselves for us")
+	}
+
+	listener.ProcessMessage(listener.Id(), packets.NewUnmute(talker.Id()))
+	listener.ProcessMessage(talker.Id(), packets.NewChat("and now?"))
+	if heard() != 1 {
+		t.Error("chat still isn't passed on after unmuting")
+	}
+}
+
+func TestMutingYourselfOrNobodyIsAnError(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	client, state := joinGame(t, hub, "lonely")
+
+	for _, targetId := range []uint64{client.Id(), client.Id() + 100} {
+		client.ClearSent()
+		client.ProcessMessage(client.Id(), packets.NewMute(targetId))
+		if errors := servertest.MessagesOf[*packets.Packet_Error](client.SentMessages()); len(errors) != 1 {
+			t.Errorf("muting %d got %d errors, want 1", targetId, len(errors))
+		}
+		if state.player.Mutes.Muted(targetId) {
+			t.Errorf("%d was muted anyway", targetId)
+		}
+	}
+}
+
+func TestAPendingTeleportTakesThePlaceOfMoving(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	client, state := unenteredGame(hub, &objects.Player{Name: "jumper", Speed: 150, Radius: 20})
+	state.player.PendingTeleport.Store(&objects.Position{X: 400, Y: -200})
+
+	state.syncPlayer(0.5)
+	if state.player.X != 400 || state.player.Y != -200 {
+		t.Errorf("player ended up at (%f, %f), want (400, -200)", state.player.X, state.player.Y)
+	}
+	if state.player.PendingTeleport.Load() != nil {
+		t.Error("the teleport is still pending after it happened")
+	}
+	for name, sent := range map[string][]packets.Msg{"sent": client.SentMessages(), "broadcast": client.Broadcasts()} {
+		players := servertest.MessagesOf[*packets.Packet_Player](sent)
+		if len(players) != 1 || !players[0].Player.Teleport {
+			t.Errorf("%s %v, want one teleport", name, players)
+		}
+	}
+
+	//Back to moving as usual the tick after
+	state.syncPlayer(0.5)
+	if state.player.X == 400 && state.player.Y == -200 {
+		t.Error("player didn't move after the teleport")
+	}
+}
@@ -0,0 +1,199 @@
+package states
+
+import (
+	"fmt"
+	"log"
+	"server/internal/server"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"sync/atomic"
+	"time"
+)
+
+// State for watching the game through the eyes of another player, the spectator isn't in the
+// game itself so nobody can see or eat it
+type Spectating struct {
+	client server.ClientInterfacer
+	logger *log.Logger
+
+	//The player the camera follows, only changed on the client's goroutine but read on the hub's
+	targetId atomic.Uint64
+
+	ended  bool //no one was left to follow, the client goes back to the menu once it gets to it
+	exited bool //tasks queued before the client left this state have nothing left to do
+}
+
+// Spectating that starts out following the given player, or someone else if they aren't in the game
+func newSpectating(targetId uint64) *Spectating {
+	s := &Spectating{}
+	s.targetId.Store(targetId)
+	return s
+}
+
+func (s *Spectating) Name() string {
+	return "Spectating"
+}
+
+func (s *Spectating) SetClient(client server.ClientInterfacer) {
+	s.client = client
+	loggingPrefix := fmt.Sprintf("Client %d [%s]: ", client.Id(), s.Name())
+	s.logger = log.New(client.LogWriter(), loggingPrefix, log.LstdFlags)
+}
+
+func (s *Spectating) OnEnter() {
+	if !s.follow(s.targetId.Load()) {
+		return
+	}
+
+	sendLeaderboard(s.client)
+	sendRoster(s.client)
+	sendActiveEvents(s.client)
+	go sendAllSpores(s.client, 20, 50*time.Millisecond)
+}
+
+func (s *Spectating) HandleMessage(senderId uint64, message packets.Msg) {
+	switch message := message.(type) {
+	case *packets.Packet_Spectate:
+		if senderId == s.client.Id() && !s.ended {
+			s.switchTarget(message.Spectate.TargetId)
+		}
+	case *packets.Packet_Chat:
+		rejectChat(s.client, senderId)
+	case *packets.Packet_Heartbeat:
+		echoHeartbeat(s.client, senderId, message)
+	case *packets.Packet_SpectateEnded:
+		if senderId == s.client.Id() {
+			s.client.SetState(&Connected{})
+		}
+	case *packets.Packet_Player:
+		verified, ok := verifyPeerPlayer(s.client, senderId, message)
+		if !ok {
+			return
+		}
+		if senderId == s.targetId.Load() || s.nearTarget(verified.Player.X, verified.Player.Y) {
+			s.client.SocketSendAs(verified, senderId)
+		}
+	case *packets.Packet_PlayerConsumed:
+		s.client.SocketSendAs(message, senderId)
+		if targetId := message.PlayerConsumed.PlayerId; targetId == s.targetId.Load() {
+			s.targetGone(targetId, "the player you were watching was eaten")
+		}
+	case *packets.Packet_Disconnect:
+		s.client.SocketSendAs(message, senderId)
+		if senderId == s.targetId.Load() {
+			s.targetGone(senderId, "the player you were watching left")
+		}
+
+	//The spectator's map has to stay in sync with everyone else's, so these all go through
+	case *packets.Packet_Spore:
+		s.client.SocketSendAs(message, senderId)
+	case *packets.Packet_SporeConsumed:
+		s.client.SocketSendAs(message, senderId)
+	case *packets.Packet_BatchConsume:
+		s.client.SocketSendAs(message, senderId)
+	case *packets.Packet_SporesDespawned:
+		s.client.SocketSendAs(message, senderId)
+	case *packets.Packet_WorldBounds:
+		s.client.SocketSendAs(message, senderId)
+	case *packets.Packet_KillFeed:
+		s.client.SocketSendAs(message, senderId)
+	case *packets.Packet_PlayerJoined:
+		s.client.SocketSendAs(message, senderId)
+	case *packets.Packet_ChangeColor:
+		s.client.SocketSendAs(message, senderId)
+	case *packets.Packet_PlayerLeft:
+		s.client.SocketSendAs(message, senderId)
+	case *packets.Packet_Leaderboard:
+		s.client.SocketSendAs(message, senderId)
+	case *packets.Packet_LeaderboardDelta:
+		s.client.SocketSendAs(message, senderId)
+	case *packets.Packet_Event:
+		s.client.SocketSendAs(message, senderId)
+	case *packets.Packet_Countdown:
+		s.client.SocketSendAs(message, senderId)
+	default:
+		rejectUnsupported(s.client, senderId, message, s.Name())
+	}
+}
+
+func (s *Spectating) OnExit() {
+	s.exited = true
+}
+
+// Follows the player the client asked for, but a player that isn't in the game doesn't take the
+// camera off the one being followed
+func (s *Spectating) switchTarget(targetId uint64) {
+	players := s.client.SharedGameObjects().Players
+	if _, exists := players.Get(targetId); !exists {
+		if _, following := players.Get(s.targetId.Load()); following {
+			s.client.SocketSend(packets.NewError("That player isn't in the game"))
+			return
+		}
+	}
+	s.follow(targetId)
+}
+
+// Starts following the player with the given id, telling the client who it's following now
+// If that player isn't in the game, another one is picked. Returns false if spectating ended
+func (s *Spectating) follow(targetId uint64) bool {
+	target, exists := s.client.SharedGameObjects().Players.Get(targetId)
+	if !exists {
+		return s.followAnother(targetId, "that player isn't in the game")
+	}
+
+	s.targetId.Store(targetId)
+	s.logger.Printf("Now spectating %s", target.Name)
+	s.client.SocketSend(packets.NewSpectate(targetId))
+	s.client.SocketSendAs(packets.NewPlayer(targetId, target, s.client.SharedGameObjects().WorldBound), targetId)
+	return true
+}
+
+// Moves on from the player being followed once it's gone, on the client's goroutine since the news
+// comes from the hub's
+func (s *Spectating) targetGone(targetId uint64, reason string) {
+	s.client.RunLater(func() {
+		//The client could have picked someone else (or left) in the meantime
+		if !s.exited && s.targetId.Load() == targetId {
+			s.followAnother(targetId, reason)
+		}
+	})
+}
+
+// Switches to the biggest player in the game other than the one that's gone, or ends spectating
+// if nobody's left
+func (s *Spectating) followAnother(goneId uint64, reason string) bool {
+	if s.ended {
+		return false
+	}
+
+	ranked := objects.RankPlayers(s.client.SharedGameObjects().Players, func(id uint64, _ *objects.Player) bool {
+		return id != goneId
+	})
+	if len(ranked) == 0 {
+		s.logger.Printf("Nobody left to spectate (%s)", reason)
+		s.client.SocketSend(packets.NewSpectateEnded(reason))
+		//This can run in the middle of OnEnter, so the state changes once that's done
+		s.ended = true
+		s.client.RunLater(func() {
+			if !s.exited {
+				s.client.SetState(&Connected{})
+			}
+		})
+		return false
+	}
+
+	return s.follow(ranked[0].Id)
+}
+
+// Whether the position is within view of the player being followed
+func (s *Spectating) nearTarget(x, y float64) bool {
+	target, exists := s.client.SharedGameObjects().Players.Get(s.targetId.Load())
+	if !exists {
+		return false
+	}
+
+	radius := s.client.Config().SpectateViewRadius
+	dx := x - target.X
+	dy := y - target.Y
+	return dx*dx+dy*dy <= radius*radius
+}
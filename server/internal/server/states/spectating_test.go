@@ -0,0 +1,68 @@
+package states
+
+import (
+	"server/internal/server"
+	"server/internal/servertest"
+	"server/pkg/packets"
+	"testing"
+)
+
+// A client spectating the given player
+func spectate(t *testing.T, hub *server.Hub, targetId uint64) (*servertest.TestClient, *Spectating) {
+	t.Helper()
+	client := servertest.NewTestClient(hub)
+	state := newSpectating(targetId)
+	client.SetState(state)
+	t.Cleanup(func() { client.Close("test over") })
+	return client, state
+}
+
+func TestSpectateKeepsTheTargetWhenAskedForNobody(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	watched, _ := joinGame(t, hub, "watched")
+	joinGame(t, hub, "bigger")
+	client, state := spectate(t, hub, watched.Id())
+
+	client.ProcessMessage(client.Id(), packets.NewSpectate(12345))
+	if state.targetId.Load() != watched.Id() {
+		t.Errorf("spectator switched to %d, want to stay on %d", state.targetId.Load(), watched.Id())
+	}
+	if len(servertest.MessagesOf[*packets.Packet_Error](client.SentMessages())) != 1 {
+		t.Error("spectator wasn't told the player isn't in the game")
+	}
+}
+
+func TestSpectateEndsOnceEnterIsDone(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	client, _ := spectate(t, hub, 12345)
+
+	//Nobody to watch, but the state can't change in the middle of entering it
+	if client.StateName() != "Spectating" {
+		t.Fatalf("spectator is in %s before its queued tasks ran", client.StateName())
+	}
+	if len(servertest.MessagesOf[*packets.Packet_SpectateEnded](client.SentMessages())) != 1 {
+		t.Error("spectator wasn't told spectating ended")
+	}
+
+	client.RunQueued()
+	if client.StateName() != "Connected" {
+		t.Errorf("spectator is in %s, want Connected", client.StateName())
+	}
+}
+
+func TestSpectateFollowsAnotherWhenTheTargetIsEaten(t *testing.T) {
+	hub, _ := servertest.NewTestHub(server.DefaultConfig())
+	eater, eaterState := joinGame(t, hub, "eater")
+	victim, victimState := joinGame(t, hub, "victim")
+	client, state := spectate(t, hub, victim.Id())
+	lineUpMeal(eaterState.player, victimState.player)
+
+	eatPlayer(eater, victim.Id())
+	client.ProcessMessage(eater.Id(), &packets.Packet_PlayerConsumed{
+		PlayerConsumed: &packets.PlayerConsumedMessage{PlayerId: victim.Id()},
+	})
+	client.RunQueued()
+	if state.targetId.Load() != eater.Id() {
+		t.Errorf("spectator follows %d, want the eater %d", state.targetId.Load(), eater.Id())
+	}
+}
@@ -0,0 +1,382 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"server/internal/server/db"
+	"sync"
+	"time"
+
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// A database write waiting in the queue, only one of the params is set
+// A write with neither is a checkpoint left behind when the file gets compacted, so the sequence
+// numbers keep going up after a restart
+type queuedWrite struct {
+	Seq          int64                           `json:"seq"`
+	BestScore    *db.UpdatePlayerBestScoreParams `json:"best_score,omitempty"`
+	MatchHistory *db.CreateMatchHistoryParams    `json:"match_history,omitempty"`
+	SeasonReset  *string                         `json:"season_reset,omitempty"` //the label the season gets archived under
+}
+
+// Database writes (best scores, match history) go to a file on disk first and get applied to the
+// database in order in the background, so the game never waits on the database and a write that
+// couldn't be applied yet isn't lost if the server goes down. Whatever is left in the file is
+// replayed on the next start. Each write is applied together with its sequence number in one
+// transaction, so replaying one that already made it in does nothing
+type WriteQueue struct {
+	path    string
+	mu      sync.Mutex
+	file    *os.File
+	pending []queuedWrite
+	nextSeq int64
+	wake    chan struct{}
+
+	firstNewSeq int64 //the first sequence number this run handed out, anything below came from the file
+	started     bool
+
+	//Called from the apply loop once a season reset made it to the database
+	OnSeasonReset func(season string)
+}
+
+// How long to wait before trying a write that failed again
+const writeQueueRetry = 5 * time.Second
+
+// How many times a write that fails for no reason we recognise is tried before it's given up on
+const maxWriteAttempts = 10
+
+// A write that was given up on, kept in the dead letter file next to the queue with the reason
+// so it can be looked at (and fixed up and applied by hand if it's worth it)
+type deadWrite struct {
+	queuedWrite
+	Error string `json:"error"`
+}
+
+// Opens the queue file at the given path, creating it if needed, and loads the writes left in it
+func OpenWriteQueue(path string) (*WriteQueue, error) {
+	q := &WriteQueue{
+		path:    path,
+		nextSeq: 1,
+		wake:    make(chan struct{}, 1),
+	}
+
+	if err := q.load(); err != nil {
+		return nil, err
+	}
+	q.firstNewSeq = q.nextSeq
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("opening the write queue: %w", err)
+	}
+	q.file = file
+
+	if len(q.pending) > 0 {
+		log.Printf("Write queue has %d writes to replay", len(q.pending))
+	}
+	return q, nil
+}
+
+func (q *WriteQueue) load() error {
+	file, err := os.Open(q.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("opening the write queue: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var write queuedWrite
+		if err := json.Unmarshal(scanner.Bytes(), &write); err != nil {
+			//Only the last line can be cut off, by the server going down in the middle of writing it
+			log.Printf("WARNING: skipping a broken line in the write queue: %v", err)
+			continue
+		}
+
+		q.nextSeq = max(q.nextSeq, write.Seq+1)
+		if write.BestScore != nil || write.MatchHistory != nil || write.SeasonReset != nil {
+			q.pending = append(q.pending, write)
+		}
+	}
+	return scanner.Err()
+}
+
+// Queues an update of a player's best score
+func (q *WriteQueue) UpdatePlayerBestScore(params db.UpdatePlayerBestScoreParams) {
+	q.push(queuedWrite{BestScore: &params})
+}
+
+// Queues a finished match for a player's history
+func (q *WriteQueue) CreateMatchHistory(params db.CreateMatchHistoryParams) {
+	q.push(queuedWrite{MatchHistory: &params})
+}
+
+// Queues the end of a season, the best scores queued before it are archived with it
+func (q *WriteQueue) ResetSeason(season string) {
+	q.push(queuedWrite{SeasonReset: &season})
+}
+
+func (q *WriteQueue) push(write queuedWrite) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	write.Seq = q.nextSeq
+	q.nextSeq++
+
+	//If it can't get to the disk it can still get to the database, it just won't survive a restart
+	if err := q.appendLine(write); err != nil {
+		log.Printf("Error writing to the write queue: %v", err)
+	}
+	q.pending = append(q.pending, write)
+
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (q *WriteQueue) appendLine(write queuedWrite) error {
+	line, err := json.Marshal(write)
+	if err != nil {
+		return err
+	}
+	if _, err := q.file.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	return q.file.Sync()
+}
+
+// How many writes haven't made it to the database yet
+func (q *WriteQueue) Pending() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.pending)
+}
+
+// Starts applying the queued writes to the database in the background, calls after the first
+// one that worked do nothing. The sequence numbers carry on from the database's if it's ahead of
+// the file (the file was lost or swapped), or the new writes would be skipped as applied already
+func (q *WriteQueue) Start(dbPool *sql.DB) error {
+	appliedSeq, err := db.New(dbPool).GetWriteQueueSeq(context.Background())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("reading the last applied write: %w", err)
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.started {
+		return nil
+	}
+	if err := q.catchUpTo(appliedSeq); err != nil {
+		return fmt.Errorf("renumbering the write queue: %w", err)
+	}
+	q.started = true
+	go q.applyLoop(dbPool)
+	return nil
+}
+
+// Anything queued since the start, before we could ask the database, is numbered again to come
+// after the last write the database has and the file gets rewritten with the new numbers
+// Has to be called with the lock held
+func (q *WriteQueue) catchUpTo(appliedSeq int64) error {
+	if appliedSeq < q.firstNewSeq {
+		q.nextSeq = max(q.nextSeq, appliedSeq+1)
+		return nil
+	}
+
+	log.Printf("Database has applied up to write %d but the write queue file only got to %d, numbering the new writes after the database's", appliedSeq, q.firstNewSeq-1)
+	q.nextSeq = appliedSeq + 1
+	kept := q.pending[:0]
+	for _, write := range q.pending {
+		//Left in the file from before, the database says they're in already
+		if write.Seq < q.firstNewSeq {
+			continue
+		}
+		write.Seq = q.nextSeq
+		q.nextSeq++
+		kept = append(kept, write)
+	}
+	q.pending = kept
+	q.firstNewSeq = q.nextSeq
+
+	if len(q.pending) == 0 {
+		return q.rewrite([]queuedWrite{{Seq: appliedSeq}})
+	}
+	return q.rewrite(q.pending)
+}
+
+// Applies the queued writes to the database in order, for as long as the server runs
+// A write that fails holds up the ones after it and gets tried again. While the database is
+// down that's for as long as it takes, but a write the database rejects goes to the dead letter
+// file straight away, and one that keeps failing for some other reason after maxWriteAttempts
+func (q *WriteQueue) applyLoop(dbPool *sql.DB) {
+	attempts := 0
+	for {
+		q.mu.Lock()
+		if len(q.pending) == 0 {
+			q.mu.Unlock()
+			<-q.wake
+			continue
+		}
+		write := q.pending[0]
+		q.mu.Unlock()
+
+		err := q.apply(dbPool, write)
+		if err != nil {
+			attempts++
+			if !isPermanentWriteError(err) && (isTransientWriteError(err) || attempts < maxWriteAttempts) {
+				log.Printf("Error applying write %d from the write queue, trying again in %v: %v", write.Seq, writeQueueRetry, err)
+				time.Sleep(writeQueueRetry)
+				continue
+			}
+
+			log.Printf("Giving up on write %d from the write queue after %d tries, moving it to %s: %v", write.Seq, attempts, q.deadPath(), err)
+			if err := q.deadLetter(write, err); err != nil {
+				log.Printf("Error writing to the dead letter file, write %d is lost: %v", write.Seq, err)
+			}
+		}
+		attempts = 0
+
+		if err == nil && write.SeasonReset != nil && q.OnSeasonReset != nil {
+			q.OnSeasonReset(*write.SeasonReset)
+		}
+
+		q.mu.Lock()
+		q.pending = q.pending[1:]
+		if len(q.pending) == 0 {
+			if err := q.compact(write.Seq); err != nil {
+				log.Printf("Error compacting the write queue: %v", err)
+			}
+		}
+		q.mu.Unlock()
+	}
+}
+
+// Applies a single write along with its sequence number, skipping it if it was already applied
+func (q *WriteQueue) apply(dbPool *sql.DB, write queuedWrite) error {
+	ctx := context.Background()
+	tx, err := dbPool.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	queries := db.New(dbPool).WithTx(tx)
+
+	appliedSeq, err := queries.GetWriteQueueSeq(ctx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	if write.Seq <= appliedSeq {
+		return nil
+	}
+
+	switch {
+	case write.BestScore != nil:
+		err = queries.UpdatePlayerBestScore(ctx, *write.BestScore)
+	case write.MatchHistory != nil:
+		err = queries.CreateMatchHistory(ctx, *write.MatchHistory)
+	case write.SeasonReset != nil:
+		err = archiveSeason(ctx, queries, *write.SeasonReset)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := queries.SetWriteQueueSeq(ctx, write.Seq); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// The database can't be used right now (it's locked, gone or out of space), the write is fine
+func isTransientWriteError(err error) bool {
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) {
+		switch sqliteErr.Code() & 0xff { //the extended codes keep the primary one in the low byte
+		case sqlite3.SQLITE_BUSY, sqlite3.SQLITE_LOCKED, sqlite3.SQLITE_CANTOPEN, sqlite3.SQLITE_IOERR, sqlite3.SQLITE_FULL, sqlite3.SQLITE_READONLY:
+			return true
+		}
+	}
+	return errors.Is(err, sql.ErrConnDone) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// The database rejected the write itself, it would fail the same way every time
+func isPermanentWriteError(err error) bool {
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) {
+		switch sqliteErr.Code() & 0xff {
+		case sqlite3.SQLITE_CONSTRAINT, sqlite3.SQLITE_MISMATCH, sqlite3.SQLITE_TOOBIG:
+			return true
+		}
+	}
+	return false
+}
+
+func (q *WriteQueue) deadPath() string {
+	return q.path + ".dead"
+}
+
+// Appends a write that was given up on to the dead letter file
+func (q *WriteQueue) deadLetter(write queuedWrite, reason error) error {
+	line, err := json.Marshal(deadWrite{queuedWrite: write, Error: reason.Error()})
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(q.deadPath(), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	return file.Sync()
+}
+
+// Once everything is applied the file is swapped for one with just a checkpoint, so it doesn't grow
+// forever
+// Has to be called with the lock held
+func (q *WriteQueue) compact(lastSeq int64) error {
+	return q.rewrite([]queuedWrite{{Seq: lastSeq}})
+}
+
+// Swaps the file for one with just the given writes. The swap is a rename, so going down halfway
+// leaves either the old file or the new one
+// Has to be called with the lock held
+func (q *WriteQueue) rewrite(writes []queuedWrite) error {
+	var data []byte
+	for _, write := range writes {
+		line, err := json.Marshal(write)
+		if err != nil {
+			return err
+		}
+		data = append(append(data, line...), '\n')
+	}
+
+	tmpPath := q.path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, q.path); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(q.path, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	q.file.Close()
+	q.file = file
+	return nil
+}
@@ -0,0 +1,183 @@
+package server_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"server/internal/server"
+	"server/internal/server/db"
+	"server/internal/servertest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// A migrated database with one player in it, in a temporary file
+func openQueueTestDb(t *testing.T) (*sql.DB, int64) {
+	t.Helper()
+	ctx := context.Background()
+	dbPool := servertest.NewTestDb(t)
+
+	queries := db.New(dbPool)
+	user, err := queries.CreateUser(ctx, db.CreateUserParams{Username: "saver", PasswordHash: "hash"})
+	if err != nil {
+		t.Fatalf("creating the user: %v", err)
+	}
+	player, err := queries.CreatePlayer(ctx, db.CreatePlayerParams{UserID: user.ID, Name: "saver"})
+	if err != nil {
+		t.Fatalf("creating the player: %v", err)
+	}
+	return dbPool, player.ID
+}
+
+func openTestQueue(t *testing.T, path string) *server.WriteQueue {
+	t.Helper()
+	q, err := server.OpenWriteQueue(path)
+	if err != nil {
+		t.Fatalf("opening the write queue: %v", err)
+	}
+	return q
+}
+
+// Waits for the queue to get everything into the database, then checks the player's best score
+func expectBestScore(t *testing.T, q *server.WriteQueue, dbPool *sql.DB, want int64) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for q.Pending() > 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d writes still pending", q.Pending())
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	player, err := db.New(dbPool).GetPlayerByName(context.Background(), "saver")
+	if err != nil {
+		t.Fatalf("reading the player: %v", err)
+	}
+	if player.BestScore != want {
+		t.Errorf("best score is %d, want %d", player.BestScore, want)
+	}
+}
+
+func TestWriteQueueReplaysAfterARestart(t *testing.T) {
+	dbPool, playerId := openQueueTestDb(t)
+	path := filepath.Join(t.TempDir(), "writes.queue")
+
+	//The server goes down before the database ever sees the write
+	q := openTestQueue(t, path)
+	q.UpdatePlayerBestScore(db.UpdatePlayerBestScoreParams{ID: playerId, BestScore: 100})
+
+	q = openTestQueue(t, path)
+	if q.Pending() != 1 {
+		t.Fatalf("%d writes to replay after the restart, want 1", q.Pending())
+	}
+	if err := q.Start(dbPool); err != nil {
+		t.Fatal(err)
+	}
+	expectBestScore(t, q, dbPool, 100)
+
+	//Once applied it's not replayed again, and the next write still makes it in
+	q = openTestQueue(t, path)
+	if q.Pending() != 0 {
+		t.Fatalf("%d writes to replay after everything was applied, want none", q.Pending())
+	}
+	q.UpdatePlayerBestScore(db.UpdatePlayerBestScoreParams{ID: playerId, BestScore: 200})
+	if err := q.Start(dbPool); err != nil {
+		t.Fatal(err)
+	}
+	expectBestScore(t, q, dbPool, 200)
+}
+
+// The sequence number the database says it has applied up to
+func appliedSeq(t *testing.T, dbPool *sql.DB) int64 {
+	t.Helper()
+	seq, err := db.New(dbPool).GetWriteQueueSeq(context.Background())
+	if err != nil {
+		t.Fatalf("reading the applied sequence number: %v", err)
+	}
+	return seq
+}
+
+func TestWriteQueueRenumbersAfterTheDatabase(t *testing.T) {
+	dbPool, playerId := openQueueTestDb(t)
+	path := filepath.Join(t.TempDir(), "writes.queue")
+
+	q := openTestQueue(t, path)
+	if err := q.Start(dbPool); err != nil {
+		t.Fatal(err)
+	}
+	for score := int64(1); score <= 5; score++ {
+		q.UpdatePlayerBestScore(db.UpdatePlayerBestScoreParams{ID: playerId, BestScore: score})
+	}
+	expectBestScore(t, q, dbPool, 5)
+
+	//The file is lost, so it starts over from 1 while the database is at 5. A write queued before
+	//the database is reached, and one after, both have to make it in
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	q = openTestQueue(t, path)
+	q.UpdatePlayerBestScore(db.UpdatePlayerBestScoreParams{ID: playerId, BestScore: 50})
+	if err := q.Start(dbPool); err != nil {
+		t.Fatal(err)
+	}
+	expectBestScore(t, q, dbPool, 50)
+	if seq := appliedSeq(t, dbPool); seq != 6 {
+		t.Errorf("the write queued before the database was reached went in as %d, want it renumbered to 6", seq)
+	}
+
+	q.UpdatePlayerBestScore(db.UpdatePlayerBestScoreParams{ID: playerId, BestScore: 60})
+	expectBestScore(t, q, dbPool, 60)
+	if seq := appliedSeq(t, dbPool); seq != 7 {
+		t.Errorf("the next write went in as %d, want 7", seq)
+	}
+}
+
+func TestWriteQueueMovesRejectedWritesAside(t *testing.T) {
+	dbPool, playerId := openQueueTestDb(t)
+	path := filepath.Join(t.TempDir(), "writes.queue")
+
+	//Stands in for any write the database will never take
+	_, err := dbPool.Exec(`CREATE TRIGGER reject_negative_scores BEFORE INSERT ON match_history
+		WHEN NEW.final_score < 0 BEGIN SELECT RAISE(ABORT, 'negative score'); END`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	q := openTestQueue(t, path)
+	q.CreateMatchHistory(db.CreateMatchHistoryParams{PlayerID: playerId, FinalScore: -1})
+	q.UpdatePlayerBestScore(db.UpdatePlayerBestScoreParams{ID: playerId, BestScore: 70})
+	if err := q.Start(dbPool); err != nil {
+		t.Fatal(err)
+	}
+
+	//Doesn't hold up the write behind it, it's not retried at all so this doesn't wait on the retry
+	expectBestScore(t, q, dbPool, 70)
+
+	data, err := os.ReadFile(path + ".dead")
+	if err != nil {
+		t.Fatalf("reading the dead letter file: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("%d writes in the dead letter file, want 1: %s", len(lines), data)
+	}
+	var dead struct {
+		Seq          int64                        `json:"seq"`
+		MatchHistory *db.CreateMatchHistoryParams `json:"match_history"`
+		Error        string                       `json:"error"`
+	}
+	if err := json.Unmarshal([]byte(lines[0]), &dead); err != nil {
+		t.Fatalf("reading the dead write: %v", err)
+	}
+	if dead.Seq != 1 || dead.MatchHistory == nil || dead.MatchHistory.FinalScore != -1 || !strings.Contains(dead.Error, "negative score") {
+		t.Errorf("dead letter file has %s, want the rejected match with its reason", lines[0])
+	}
+
+	//Nor is it replayed after a restart
+	if q := openTestQueue(t, path); q.Pending() != 0 {
+		t.Errorf("%d writes to replay after a restart, want none", q.Pending())
+	}
+}
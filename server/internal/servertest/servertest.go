@@ -0,0 +1,418 @@
+// Helpers for testing the states and the hub without sockets or waiting on real time, with a
+// throwaway database for the tests that save things
+package servertest
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"path/filepath"
+	"server/internal/server"
+	"server/internal/server/db"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// A clock that only moves when it's told to
+type FakeClock struct {
+	mux     sync.Mutex
+	now     time.Time
+	waiters []fakeWaiter
+}
+
+type fakeWaiter struct {
+	at time.Time
+	ch chan time.Time
+}
+
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+func (c *FakeClock) Now() time.Time {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return c.now
+}
+
+// Fires right away for d <= 0, otherwise once Advance gets the clock there
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- c.now
+		return ch
+	}
+	c.waiters = append(c.waiters, fakeWaiter{c.now.Add(d), ch})
+	return ch
+}
+
+// Moves the clock on and fires everything waiting on a time it got to
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.now = c.now.Add(d)
+
+	waiting := c.waiters[:0]
+	for _, waiter := range c.waiters {
+		if waiter.at.After(c.now) {
+			waiting = append(waiting, waiter)
+			continue
+		}
+		waiter.ch <- c.now
+	}
+	c.waiters = waiting
+}
+
+// How many After calls haven't fired yet, so a test can wait for a loop to get to its next wait
+// before moving the clock
+func (c *FakeClock) Waiters() int {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return len(c.waiters)
+}
+
+// An empty map with the default world bound
+func NewTestSharedGameObjects() *server.SharedGameObjects {
+	return server.NewSharedGameObjects(objects.NewWorldBound(server.DefaultConfig().WorldBound))
+}
+
+// A hub that runs without a database, throws its logs away and gets its time from the returned
+// FakeClock. Nothing runs in the background unless the test starts it
+func NewTestHub(config *server.Config) (*server.Hub, *FakeClock) {
+	clock := NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	return server.NewHubWithClock(config, io.Discard, clock), clock
+}
+
+// A migrated database in a temporary file, closed when the test is over. Give it to a hub with
+// UseDb before making any clients
+func NewTestDb(t testing.TB) *sql.DB {
+	t.Helper()
+	dbPool, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "test.sqlite"))
+	if err != nil {
+		t.Fatalf("opening the database: %v", err)
+	}
+	t.Cleanup(func() { dbPool.Close() })
+	if err := db.Migrate(context.Background(), dbPool); err != nil {
+		t.Fatalf("migrating the database: %v", err)
+	}
+	return dbPool
+}
+
+// A ClientInterfacer without a socket. Everything it would write to the socket or broadcast is
+// kept instead, so tests can check what went out. Its messages go straight to its state
+type TestClient struct {
+	hub   *server.Hub
+	id    uint64
+	state server.ClientStateHandler
+	dbTx  *server.DbTx
+
+	mux        sync.Mutex
+	sent       []*packets.Packet
+	broadcasts []packets.Msg
+	kicked     string
+	tasks      []func()
+
+	stateName      atomic.Value
+	stateEnteredAt atomic.Int64
+	lastActivity   atomic.Int64
+	closing        atomic.Bool
+	rtt            atomic.Int64
+	version        atomic.Value
+}
+
+// Makes a client and registers it with the hub under the next free id, it has no state until
+// the test gives it one
+func NewTestClient(hub *server.Hub) *TestClient {
+	c := &TestClient{
+		hub:  hub,
+		dbTx: hub.NewDbTx(),
+	}
+	c.id = hub.Clients.Add(c)
+	return c
+}
+
+func (c *TestClient) Id() uint64 {
+	return c.id
+}
+
+// A message from the client itself counts as activity, like one off its socket would
+func (c *TestClient) ProcessMessage(senderId uint64, message packets.Msg) {
+	if _, heartbeat := message.(*packets.Packet_Heartbeat); senderId == c.id && !heartbeat {
+		c.lastActivity.Store(c.hub.Clock.Now().UnixNano())
+	}
+	c.state.HandleMessage(senderId, message)
+}
+
+func (c *TestClient) Initialize(id uint64) {
+	c.id = id
+}
+
+func (c *TestClient) SetState(state server.ClientStateHandler) {
+	if c.state != nil {
+		c.state.OnExit()
+	}
+
+	newStateName := "None"
+	if state != nil {
+		newStateName = state.Name()
+	}
+	c.state = state
+	c.stateName.Store(newStateName)
+	c.stateEnteredAt.Store(c.hub.Clock.Now().UnixNano())
+
+	if c.state != nil {
+		c.state.SetClient(c)
+		c.state.OnEnter()
+	}
+}
+
+// The state the client is in right now
+func (c *TestClient) State() server.ClientStateHandler {
+	return c.state
+}
+
+func (c *TestClient) StateName() string {
+	name, _ := c.stateName.Load().(string)
+	return name
+}
+
+func (c *TestClient) StateEnteredAt() time.Time {
+	return time.Unix(0, c.stateEnteredAt.Load())
+}
+
+func (c *TestClient) LastActivity() time.Time {
+	if nanos := c.lastActivity.Load(); nanos != 0 {
+		return time.Unix(0, nanos)
+	}
+	return time.Time{}
+}
+
+func (c *TestClient) SocketSend(message packets.Msg) {
+	c.SocketSendAs(message, c.id)
+}
+
+func (c *TestClient) SocketSendAs(message packets.Msg, senderId uint64) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.sent = append(c.sent, &packets.Packet{SenderId: senderId, Msg: message})
+}
+
+// Raw packets are already marshaled, there's nothing useful to keep
+func (c *TestClient) SocketSendRaw(data []byte) {}
+
+func (c *TestClient) SocketSendReliable(message packets.Msg) {
+	c.SocketSend(message)
+}
+
+func (c *TestClient) SetSupportsAcks(supportsAcks bool) {}
+
+func (c *TestClient) PassToPeer(message packets.Msg, peerId uint64) {
+	if peer, exists := c.hub.Clients.Get(peerId); exists {
+		peer.ProcessMessage(c.id, message)
+	}
+}
+
+// Broadcasts are only kept, tests hand them to other clients themselves if they need to
+func (c *TestClient) Broadcast(message packets.Msg) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.broadcasts = append(c.broadcasts, message)
+}
+
+// Everything sent to the client's socket so far, oldest first
+func (c *TestClient) Sent() []*packets.Packet {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return append([]*packets.Packet(nil), c.sent...)
+}
+
+// Everything the client broadcast so far, oldest first
+func (c *TestClient) Broadcasts() []packets.Msg {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return append([]packets.Msg(nil), c.broadcasts...)
+}
+
+// Forgets what was sent and broadcast so far
+func (c *TestClient) ClearSent() {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.sent = nil
+	c.broadcasts = nil
+}
+
+// The messages of type T in the list, like MessagesOf[*packets.Packet_Kick](client.Broadcasts())
+func MessagesOf[T packets.Msg](messages []packets.Msg) []T {
+	var found []T
+	for _, message := range messages {
+		if m, ok := message.(T); ok {
+			found = append(found, m)
+		}
+	}
+	return found
+}
+
+// The messages sent to the client's socket, without the sender ids
+func (c *TestClient) SentMessages() []packets.Msg {
+	sent := c.Sent()
+	messages := make([]packets.Msg, len(sent))
+	for i, packet := range sent {
+		messages[i] = packet.Msg
+	}
+	return messages
+}
+
+func (c *TestClient) ReadPump()  {}
+func (c *TestClient) WritePump() {}
+
+func (c *TestClient) BytesSent() uint64 {
+	return 0
+}
+
+func (c *TestClient) DroppedPackets() uint64 {
+	return 0
+}
+
+func (c *TestClient) Rtt() time.Duration {
+	return time.Duration(c.rtt.Load())
+}
+
+func (c *TestClient) SetRtt(rtt time.Duration) {
+	c.rtt.Store(int64(rtt))
+}
+
+func (c *TestClient) Config() *server.Config {
+	return c.hub.Config()
+}
+
+func (c *TestClient) LogWriter() io.Writer {
+	return c.hub.LogWriter
+}
+
+func (c *TestClient) ClientVersion() string {
+	version, _ := c.version.Load().(string)
+	return version
+}
+
+func (c *TestClient) SetClientVersion(version string) {
+	c.version.Store(version)
+}
+
+func (c *TestClient) DbTx() *server.DbTx {
+	return c.dbTx
+}
+
+func (c *TestClient) SharedGameObjects() *server.SharedGameObjects {
+	return c.hub.SharedGameObjects
+}
+
+func (c *TestClient) AntiCheat() *server.AntiCheat {
+	return c.hub.AntiCheat
+}
+
+func (c *TestClient) Clock() server.Clock {
+	return c.hub.Clock
+}
+
+func (c *TestClient) ChatHistory() *objects.ChatHistory {
+	return c.hub.ChatHistory
+}
+
+func (c *TestClient) Round() *server.Round {
+	return c.hub.Round
+}
+
+func (c *TestClient) Leaderboard() *server.Leaderboard {
+	return c.hub.Leaderboard
+}
+
+func (c *TestClient) Events() *server.Events {
+	return c.hub.Events
+}
+
+func (c *TestClient) EventBus() *server.EventBus {
+	return c.hub.EventBus
+}
+
+func (c *TestClient) SporeValue() *server.SporeValue {
+	return c.hub.SporeValue
+}
+
+func (c *TestClient) ServerInfo() server.ServerInfo {
+	return c.hub.Info()
+}
+
+func (c *TestClient) GameFull() bool {
+	return c.hub.Full()
+}
+
+func (c *TestClient) ReconnectSlots() *server.ReconnectSlots {
+	return c.hub.ReconnectSlots
+}
+
+func (c *TestClient) ReclaimSlot(oldClientId uint64, secret string) (*objects.Player, bool) {
+	return c.hub.ReclaimSlot(oldClientId, secret)
+}
+
+// Tasks only run when the test calls RunQueued, standing in for the read pump getting to them
+func (c *TestClient) RunLater(task func()) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.tasks = append(c.tasks, task)
+}
+
+// How many tasks are waiting for RunQueued
+func (c *TestClient) QueuedTasks() int {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return len(c.tasks)
+}
+
+// Runs the tasks queued so far, in order, and returns how many there were
+func (c *TestClient) RunQueued() int {
+	c.mux.Lock()
+	tasks := c.tasks
+	c.tasks = nil
+	c.mux.Unlock()
+
+	for _, task := range tasks {
+		task()
+	}
+	return len(tasks)
+}
+
+func (c *TestClient) Closing() bool {
+	return c.closing.Load()
+}
+
+// Leaves the state like a dropped connection would and takes the client off the hub
+func (c *TestClient) Close(reason string) {
+	if !c.closing.CompareAndSwap(false, true) {
+		return
+	}
+	c.SetState(nil)
+	c.hub.Clients.Remove(c.id)
+}
+
+// Like a real kick the connection drops, and the client closes once RunQueued gets to it
+func (c *TestClient) Kick(reason string) {
+	c.mux.Lock()
+	c.kicked = reason
+	c.mux.Unlock()
+	c.SocketSend(packets.NewKick(reason))
+	c.RunLater(func() { c.Close(reason) })
+}
+
+// Why the client was kicked, empty if it wasn't
+func (c *TestClient) Kicked() string {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return c.kicked
+}
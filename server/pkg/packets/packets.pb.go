@@ -21,6 +21,217 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// How big a deal a consumption was, so the client can pick the right sound and effect
+// The server fills it in (with the mass gained) before the consumption goes out to anyone
+type ConsumeFeedback int32
+
+const (
+	ConsumeFeedback_CONSUME_FEEDBACK_NONE         ConsumeFeedback = 0
+	ConsumeFeedback_CONSUME_FEEDBACK_SMALL_SPORE  ConsumeFeedback = 1
+	ConsumeFeedback_CONSUME_FEEDBACK_BIG_SPORE    ConsumeFeedback = 2
+	ConsumeFeedback_CONSUME_FEEDBACK_SMALL_PLAYER ConsumeFeedback = 3
+	ConsumeFeedback_CONSUME_FEEDBACK_BIG_PLAYER   ConsumeFeedback = 4
+)
+
+// Enum value maps for ConsumeFeedback.
+var (
+	ConsumeFeedback_name = map[int32]string{
+		0: "CONSUME_FEEDBACK_NONE",
+		1: "CONSUME_FEEDBACK_SMALL_SPORE",
+		2: "CONSUME_FEEDBACK_BIG_SPORE",
+		3: "CONSUME_FEEDBACK_SMALL_PLAYER",
+		4: "CONSUME_FEEDBACK_BIG_PLAYER",
+	}
+	ConsumeFeedback_value = map[string]int32{
+		"CONSUME_FEEDBACK_NONE":         0,
+		"CONSUME_FEEDBACK_SMALL_SPORE":  1,
+		"CONSUME_FEEDBACK_BIG_SPORE":    2,
+		"CONSUME_FEEDBACK_SMALL_PLAYER": 3,
+		"CONSUME_FEEDBACK_BIG_PLAYER":   4,
+	}
+)
+
+func (x ConsumeFeedback) Enum() *ConsumeFeedback {
+	p := new(ConsumeFeedback)
+	*p = x
+	return p
+}
+
+func (x ConsumeFeedback) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConsumeFeedback) Descriptor() protoreflect.EnumDescriptor {
+	return file_packets_proto_enumTypes[0].Descriptor()
+}
+
+func (ConsumeFeedback) Type() protoreflect.EnumType {
+	return &file_packets_proto_enumTypes[0]
+}
+
+func (x ConsumeFeedback) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConsumeFeedback.Descriptor instead.
+func (ConsumeFeedback) EnumDescriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{0}
+}
+
+// Small set of quick reactions a player can show over their blob
+type EmoteType int32
+
+const (
+	EmoteType_EMOTE_WAVE  EmoteType = 0
+	EmoteType_EMOTE_LAUGH EmoteType = 1
+	EmoteType_EMOTE_ANGRY EmoteType = 2
+	EmoteType_EMOTE_SAD   EmoteType = 3
+	EmoteType_EMOTE_GG    EmoteType = 4
+)
+
+// Enum value maps for EmoteType.
+var (
+	EmoteType_name = map[int32]string{
+		0: "EMOTE_WAVE",
+		1: "EMOTE_LAUGH",
+		2: "EMOTE_ANGRY",
+		3: "EMOTE_SAD",
+		4: "EMOTE_GG",
+	}
+	EmoteType_value = map[string]int32{
+		"EMOTE_WAVE":  0,
+		"EMOTE_LAUGH": 1,
+		"EMOTE_ANGRY": 2,
+		"EMOTE_SAD":   3,
+		"EMOTE_GG":    4,
+	}
+)
+
+func (x EmoteType) Enum() *EmoteType {
+	p := new(EmoteType)
+	*p = x
+	return p
+}
+
+func (x EmoteType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EmoteType) Descriptor() protoreflect.EnumDescriptor {
+	return file_packets_proto_enumTypes[1].Descriptor()
+}
+
+func (EmoteType) Type() protoreflect.EnumType {
+	return &file_packets_proto_enumTypes[1]
+}
+
+func (x EmoteType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EmoteType.Descriptor instead.
+func (EmoteType) EnumDescriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{1}
+}
+
+// Whether the server sends players their own position updates, clients doing their own prediction
+// might only want them when they're off
+type SelfEcho int32
+
+const (
+	SelfEcho_SELF_ECHO_ALWAYS        SelfEcho = 0
+	SelfEcho_SELF_ECHO_OFF           SelfEcho = 1
+	SelfEcho_SELF_ECHO_ON_DIVERGENCE SelfEcho = 2 //only when the server's position is too far from the last one the client reported
+)
+
+// Enum value maps for SelfEcho.
+var (
+	SelfEcho_name = map[int32]string{
+		0: "SELF_ECHO_ALWAYS",
+		1: "SELF_ECHO_OFF",
+		2: "SELF_ECHO_ON_DIVERGENCE",
+	}
+	SelfEcho_value = map[string]int32{
+		"SELF_ECHO_ALWAYS":        0,
+		"SELF_ECHO_OFF":           1,
+		"SELF_ECHO_ON_DIVERGENCE": 2,
+	}
+)
+
+func (x SelfEcho) Enum() *SelfEcho {
+	p := new(SelfEcho)
+	*p = x
+	return p
+}
+
+func (x SelfEcho) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SelfEcho) Descriptor() protoreflect.EnumDescriptor {
+	return file_packets_proto_enumTypes[2].Descriptor()
+}
+
+func (SelfEcho) Type() protoreflect.EnumType {
+	return &file_packets_proto_enumTypes[2]
+}
+
+func (x SelfEcho) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SelfEcho.Descriptor instead.
+func (SelfEcho) EnumDescriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{2}
+}
+
+// A hint for mobile clients to vibrate, they're free to ignore it
+type HapticKind int32
+
+const (
+	HapticKind_HAPTIC_ATE_PLAYER HapticKind = 0
+	HapticKind_HAPTIC_EATEN      HapticKind = 1
+)
+
+// Enum value maps for HapticKind.
+var (
+	HapticKind_name = map[int32]string{
+		0: "HAPTIC_ATE_PLAYER",
+		1: "HAPTIC_EATEN",
+	}
+	HapticKind_value = map[string]int32{
+		"HAPTIC_ATE_PLAYER": 0,
+		"HAPTIC_EATEN":      1,
+	}
+)
+
+func (x HapticKind) Enum() *HapticKind {
+	p := new(HapticKind)
+	*p = x
+	return p
+}
+
+func (x HapticKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HapticKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_packets_proto_enumTypes[3].Descriptor()
+}
+
+func (HapticKind) Type() protoreflect.EnumType {
+	return &file_packets_proto_enumTypes[3]
+}
+
+func (x HapticKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HapticKind.Descriptor instead.
+func (HapticKind) EnumDescriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{3}
+}
+
 type ChatMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Msg           string                 `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
@@ -311,6 +522,8 @@ type PlayerMessage struct {
 	Direction     float64                `protobuf:"fixed64,6,opt,name=direction,proto3" json:"direction,omitempty"`
 	Speed         float64                `protobuf:"fixed64,7,opt,name=speed,proto3" json:"speed,omitempty"`
 	Color         int32                  `protobuf:"varint,8,opt,name=color,proto3" json:"color,omitempty"`
+	SkinId        uint32                 `protobuf:"varint,9,opt,name=skin_id,json=skinId,proto3" json:"skin_id,omitempty"`
+	Teleport      bool                   `protobuf:"varint,10,opt,name=teleport,proto3" json:"teleport,omitempty"` //the player jumped here (an admin moved it), so snap to it instead of interpolating
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -401,6 +614,20 @@ func (x *PlayerMessage) GetColor() int32 {
 	return 0
 }
 
+func (x *PlayerMessage) GetSkinId() uint32 {
+	if x != nil {
+		return x.SkinId
+	}
+	return 0
+}
+
+func (x *PlayerMessage) GetTeleport() bool {
+	if x != nil {
+		return x.Teleport
+	}
+	return false
+}
+
 type PlayerDirectionMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Direction     float64                `protobuf:"fixed64,1,opt,name=direction,proto3" json:"direction,omitempty"`
@@ -517,6 +744,8 @@ func (x *SporeMessage) GetRadius() float64 {
 type SporeConsumedMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SporeId       uint64                 `protobuf:"varint,1,opt,name=spore_id,json=sporeId,proto3" json:"spore_id,omitempty"`
+	Feedback      ConsumeFeedback        `protobuf:"varint,2,opt,name=feedback,proto3,enum=packets.ConsumeFeedback" json:"feedback,omitempty"`
+	Mass          float64                `protobuf:"fixed64,3,opt,name=mass,proto3" json:"mass,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -558,6 +787,83 @@ func (x *SporeConsumedMessage) GetSporeId() uint64 {
 	return 0
 }
 
+func (x *SporeConsumedMessage) GetFeedback() ConsumeFeedback {
+	if x != nil {
+		return x.Feedback
+	}
+	return ConsumeFeedback_CONSUME_FEEDBACK_NONE
+}
+
+func (x *SporeConsumedMessage) GetMass() float64 {
+	if x != nil {
+		return x.Mass
+	}
+	return 0
+}
+
+// Several spores eaten at once, when a big player sweeps over a bunch of them in one go
+// The client sends the ids it thinks it ate, the server broadcasts the ones that actually were
+// along with the total mass gained, the feedback is the biggest of the spores eaten
+type BatchConsumeMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SporeIds      []uint64               `protobuf:"varint,1,rep,packed,name=spore_ids,json=sporeIds,proto3" json:"spore_ids,omitempty"`
+	Mass          float64                `protobuf:"fixed64,2,opt,name=mass,proto3" json:"mass,omitempty"`
+	Feedback      ConsumeFeedback        `protobuf:"varint,3,opt,name=feedback,proto3,enum=packets.ConsumeFeedback" json:"feedback,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchConsumeMessage) Reset() {
+	*x = BatchConsumeMessage{}
+	mi := &file_packets_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchConsumeMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchConsumeMessage) ProtoMessage() {}
+
+func (x *BatchConsumeMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchConsumeMessage.ProtoReflect.Descriptor instead.
+func (*BatchConsumeMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BatchConsumeMessage) GetSporeIds() []uint64 {
+	if x != nil {
+		return x.SporeIds
+	}
+	return nil
+}
+
+func (x *BatchConsumeMessage) GetMass() float64 {
+	if x != nil {
+		return x.Mass
+	}
+	return 0
+}
+
+func (x *BatchConsumeMessage) GetFeedback() ConsumeFeedback {
+	if x != nil {
+		return x.Feedback
+	}
+	return ConsumeFeedback_CONSUME_FEEDBACK_NONE
+}
+
 type SporeBatchMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Spores        []*SporeMessage        `protobuf:"bytes,1,rep,name=spores,proto3" json:"spores,omitempty"`
@@ -567,7 +873,7 @@ type SporeBatchMessage struct {
 
 func (x *SporeBatchMessage) Reset() {
 	*x = SporeBatchMessage{}
-	mi := &file_packets_proto_msgTypes[10]
+	mi := &file_packets_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -579,7 +885,7 @@ func (x *SporeBatchMessage) String() string {
 func (*SporeBatchMessage) ProtoMessage() {}
 
 func (x *SporeBatchMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[10]
+	mi := &file_packets_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -592,7 +898,7 @@ func (x *SporeBatchMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SporeBatchMessage.ProtoReflect.Descriptor instead.
 func (*SporeBatchMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{10}
+	return file_packets_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *SporeBatchMessage) GetSpores() []*SporeMessage {
@@ -605,13 +911,15 @@ func (x *SporeBatchMessage) GetSpores() []*SporeMessage {
 type PlayerConsumedMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerId      uint64                 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Feedback      ConsumeFeedback        `protobuf:"varint,2,opt,name=feedback,proto3,enum=packets.ConsumeFeedback" json:"feedback,omitempty"`
+	Mass          float64                `protobuf:"fixed64,3,opt,name=mass,proto3" json:"mass,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PlayerConsumedMessage) Reset() {
 	*x = PlayerConsumedMessage{}
-	mi := &file_packets_proto_msgTypes[11]
+	mi := &file_packets_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -623,7 +931,7 @@ func (x *PlayerConsumedMessage) String() string {
 func (*PlayerConsumedMessage) ProtoMessage() {}
 
 func (x *PlayerConsumedMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[11]
+	mi := &file_packets_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -636,7 +944,7 @@ func (x *PlayerConsumedMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerConsumedMessage.ProtoReflect.Descriptor instead.
 func (*PlayerConsumedMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{11}
+	return file_packets_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *PlayerConsumedMessage) GetPlayerId() uint64 {
@@ -646,6 +954,20 @@ func (x *PlayerConsumedMessage) GetPlayerId() uint64 {
 	return 0
 }
 
+func (x *PlayerConsumedMessage) GetFeedback() ConsumeFeedback {
+	if x != nil {
+		return x.Feedback
+	}
+	return ConsumeFeedback_CONSUME_FEEDBACK_NONE
+}
+
+func (x *PlayerConsumedMessage) GetMass() float64 {
+	if x != nil {
+		return x.Mass
+	}
+	return 0
+}
+
 type HiscoreBoardRequestMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -654,7 +976,7 @@ type HiscoreBoardRequestMessage struct {
 
 func (x *HiscoreBoardRequestMessage) Reset() {
 	*x = HiscoreBoardRequestMessage{}
-	mi := &file_packets_proto_msgTypes[12]
+	mi := &file_packets_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -666,7 +988,7 @@ func (x *HiscoreBoardRequestMessage) String() string {
 func (*HiscoreBoardRequestMessage) ProtoMessage() {}
 
 func (x *HiscoreBoardRequestMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[12]
+	mi := &file_packets_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -679,7 +1001,7 @@ func (x *HiscoreBoardRequestMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HiscoreBoardRequestMessage.ProtoReflect.Descriptor instead.
 func (*HiscoreBoardRequestMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{12}
+	return file_packets_proto_rawDescGZIP(), []int{13}
 }
 
 type HiscoreMessage struct {
@@ -693,7 +1015,7 @@ type HiscoreMessage struct {
 
 func (x *HiscoreMessage) Reset() {
 	*x = HiscoreMessage{}
-	mi := &file_packets_proto_msgTypes[13]
+	mi := &file_packets_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -705,7 +1027,7 @@ func (x *HiscoreMessage) String() string {
 func (*HiscoreMessage) ProtoMessage() {}
 
 func (x *HiscoreMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[13]
+	mi := &file_packets_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -718,7 +1040,7 @@ func (x *HiscoreMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HiscoreMessage.ProtoReflect.Descriptor instead.
 func (*HiscoreMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{13}
+	return file_packets_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *HiscoreMessage) GetRank() uint64 {
@@ -751,7 +1073,7 @@ type HiscoreBoardMessage struct {
 
 func (x *HiscoreBoardMessage) Reset() {
 	*x = HiscoreBoardMessage{}
-	mi := &file_packets_proto_msgTypes[14]
+	mi := &file_packets_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -763,7 +1085,7 @@ func (x *HiscoreBoardMessage) String() string {
 func (*HiscoreBoardMessage) ProtoMessage() {}
 
 func (x *HiscoreBoardMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[14]
+	mi := &file_packets_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -776,7 +1098,7 @@ func (x *HiscoreBoardMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HiscoreBoardMessage.ProtoReflect.Descriptor instead.
 func (*HiscoreBoardMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{14}
+	return file_packets_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *HiscoreBoardMessage) GetHiscores() []*HiscoreMessage {
@@ -794,7 +1116,7 @@ type FinishedBrowsingHiscoresMessage struct {
 
 func (x *FinishedBrowsingHiscoresMessage) Reset() {
 	*x = FinishedBrowsingHiscoresMessage{}
-	mi := &file_packets_proto_msgTypes[15]
+	mi := &file_packets_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -806,7 +1128,7 @@ func (x *FinishedBrowsingHiscoresMessage) String() string {
 func (*FinishedBrowsingHiscoresMessage) ProtoMessage() {}
 
 func (x *FinishedBrowsingHiscoresMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[15]
+	mi := &file_packets_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -819,7 +1141,7 @@ func (x *FinishedBrowsingHiscoresMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinishedBrowsingHiscoresMessage.ProtoReflect.Descriptor instead.
 func (*FinishedBrowsingHiscoresMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{15}
+	return file_packets_proto_rawDescGZIP(), []int{16}
 }
 
 type SearchHiscoreMessage struct {
@@ -831,7 +1153,7 @@ type SearchHiscoreMessage struct {
 
 func (x *SearchHiscoreMessage) Reset() {
 	*x = SearchHiscoreMessage{}
-	mi := &file_packets_proto_msgTypes[16]
+	mi := &file_packets_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -843,7 +1165,7 @@ func (x *SearchHiscoreMessage) String() string {
 func (*SearchHiscoreMessage) ProtoMessage() {}
 
 func (x *SearchHiscoreMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[16]
+	mi := &file_packets_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -856,7 +1178,7 @@ func (x *SearchHiscoreMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchHiscoreMessage.ProtoReflect.Descriptor instead.
 func (*SearchHiscoreMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{16}
+	return file_packets_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *SearchHiscoreMessage) GetName() string {
@@ -867,15 +1189,19 @@ func (x *SearchHiscoreMessage) GetName() string {
 }
 
 type DisconnectMessage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Reason string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	//Set by the server if the client was in the game, with where its player was when it left
+	InGame        bool    `protobuf:"varint,2,opt,name=in_game,json=inGame,proto3" json:"in_game,omitempty"`
+	X             float64 `protobuf:"fixed64,3,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64 `protobuf:"fixed64,4,opt,name=y,proto3" json:"y,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DisconnectMessage) Reset() {
 	*x = DisconnectMessage{}
-	mi := &file_packets_proto_msgTypes[17]
+	mi := &file_packets_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -887,7 +1213,7 @@ func (x *DisconnectMessage) String() string {
 func (*DisconnectMessage) ProtoMessage() {}
 
 func (x *DisconnectMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[17]
+	mi := &file_packets_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -900,7 +1226,7 @@ func (x *DisconnectMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DisconnectMessage.ProtoReflect.Descriptor instead.
 func (*DisconnectMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{17}
+	return file_packets_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *DisconnectMessage) GetReason() string {
@@ -910,50 +1236,2695 @@ func (x *DisconnectMessage) GetReason() string {
 	return ""
 }
 
-// Creating a wrapper named Packet that packs any message with the sender id
-type Packet struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	SenderId uint64                 `protobuf:"varint,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
-	// Types that are valid to be assigned to Msg:
-	//
-	//	*Packet_Chat
-	//	*Packet_Id
-	//	*Packet_LoginRequest
-	//	*Packet_RegisterRequest
-	//	*Packet_OkResponse
-	//	*Packet_DenyResponse
-	//	*Packet_Player
-	//	*Packet_PlayerDirection
-	//	*Packet_Spore
-	//	*Packet_SporeConsumed
-	//	*Packet_SporesBatch
-	//	*Packet_PlayerConsumed
-	//	*Packet_HiscoreBoardRequest
-	//	*Packet_Hiscore
-	//	*Packet_HiscoreBoard
-	//	*Packet_FinishedBrowsingHiscores
-	//	*Packet_SearchHiscore
-	//	*Packet_Disconnect
-	Msg           isPacket_Msg `protobuf_oneof:"msg"`
+func (x *DisconnectMessage) GetInGame() bool {
+	if x != nil {
+		return x.InGame
+	}
+	return false
+}
+
+func (x *DisconnectMessage) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *DisconnectMessage) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type EmoteMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Emote         EmoteType              `protobuf:"varint,1,opt,name=emote,proto3,enum=packets.EmoteType" json:"emote,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Packet) Reset() {
-	*x = Packet{}
-	mi := &file_packets_proto_msgTypes[18]
+func (x *EmoteMessage) Reset() {
+	*x = EmoteMessage{}
+	mi := &file_packets_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Packet) String() string {
+func (x *EmoteMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Packet) ProtoMessage() {}
+func (*EmoteMessage) ProtoMessage() {}
 
-func (x *Packet) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[18]
+func (x *EmoteMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmoteMessage.ProtoReflect.Descriptor instead.
+func (*EmoteMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *EmoteMessage) GetEmote() EmoteType {
+	if x != nil {
+		return x.Emote
+	}
+	return EmoteType_EMOTE_WAVE
+}
+
+type RequestStatsMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestStatsMessage) Reset() {
+	*x = RequestStatsMessage{}
+	mi := &file_packets_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestStatsMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestStatsMessage) ProtoMessage() {}
+
+func (x *RequestStatsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestStatsMessage.ProtoReflect.Descriptor instead.
+func (*RequestStatsMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RequestStatsMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type EnterGameMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnterGameMessage) Reset() {
+	*x = EnterGameMessage{}
+	mi := &file_packets_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnterGameMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnterGameMessage) ProtoMessage() {}
+
+func (x *EnterGameMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnterGameMessage.ProtoReflect.Descriptor instead.
+func (*EnterGameMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *EnterGameMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type WorldBoundsMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bound         float64                `protobuf:"fixed64,1,opt,name=bound,proto3" json:"bound,omitempty"` //the world is the square from -bound to bound on both axes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorldBoundsMessage) Reset() {
+	*x = WorldBoundsMessage{}
+	mi := &file_packets_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldBoundsMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldBoundsMessage) ProtoMessage() {}
+
+func (x *WorldBoundsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldBoundsMessage.ProtoReflect.Descriptor instead.
+func (*WorldBoundsMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *WorldBoundsMessage) GetBound() float64 {
+	if x != nil {
+		return x.Bound
+	}
+	return 0
+}
+
+type KillFeedMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConsumerId    uint64                 `protobuf:"varint,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	ConsumerName  string                 `protobuf:"bytes,2,opt,name=consumer_name,json=consumerName,proto3" json:"consumer_name,omitempty"`
+	VictimId      uint64                 `protobuf:"varint,3,opt,name=victim_id,json=victimId,proto3" json:"victim_id,omitempty"`
+	VictimName    string                 `protobuf:"bytes,4,opt,name=victim_name,json=victimName,proto3" json:"victim_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KillFeedMessage) Reset() {
+	*x = KillFeedMessage{}
+	mi := &file_packets_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KillFeedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillFeedMessage) ProtoMessage() {}
+
+func (x *KillFeedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillFeedMessage.ProtoReflect.Descriptor instead.
+func (*KillFeedMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *KillFeedMessage) GetConsumerId() uint64 {
+	if x != nil {
+		return x.ConsumerId
+	}
+	return 0
+}
+
+func (x *KillFeedMessage) GetConsumerName() string {
+	if x != nil {
+		return x.ConsumerName
+	}
+	return ""
+}
+
+func (x *KillFeedMessage) GetVictimId() uint64 {
+	if x != nil {
+		return x.VictimId
+	}
+	return 0
+}
+
+func (x *KillFeedMessage) GetVictimName() string {
+	if x != nil {
+		return x.VictimName
+	}
+	return ""
+}
+
+// Everything in the game uses world coordinates, the world is the square from -world_bound
+// to world_bound on both axes no matter how zoomed in or out the client is
+type GameConfigMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorldBound    float64                `protobuf:"fixed64,1,opt,name=world_bound,json=worldBound,proto3" json:"world_bound,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameConfigMessage) Reset() {
+	*x = GameConfigMessage{}
+	mi := &file_packets_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameConfigMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameConfigMessage) ProtoMessage() {}
+
+func (x *GameConfigMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameConfigMessage.ProtoReflect.Descriptor instead.
+func (*GameConfigMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GameConfigMessage) GetWorldBound() float64 {
+	if x != nil {
+		return x.WorldBound
+	}
+	return 0
+}
+
+type LeaderboardEntryMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Mass          uint64                 `protobuf:"varint,3,opt,name=mass,proto3" json:"mass,omitempty"`
+	Rank          uint32                 `protobuf:"varint,4,opt,name=rank,proto3" json:"rank,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaderboardEntryMessage) Reset() {
+	*x = LeaderboardEntryMessage{}
+	mi := &file_packets_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardEntryMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardEntryMessage) ProtoMessage() {}
+
+func (x *LeaderboardEntryMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardEntryMessage.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntryMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *LeaderboardEntryMessage) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LeaderboardEntryMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LeaderboardEntryMessage) GetMass() uint64 {
+	if x != nil {
+		return x.Mass
+	}
+	return 0
+}
+
+func (x *LeaderboardEntryMessage) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+type LeaderboardMessage struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Entries       []*LeaderboardEntryMessage `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaderboardMessage) Reset() {
+	*x = LeaderboardMessage{}
+	mi := &file_packets_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardMessage) ProtoMessage() {}
+
+func (x *LeaderboardMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardMessage.ProtoReflect.Descriptor instead.
+func (*LeaderboardMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *LeaderboardMessage) GetEntries() []*LeaderboardEntryMessage {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// Only what changed since the last leaderboard: entries whose rank or mass changed (or that are new),
+// and the ids of players that dropped off it
+type LeaderboardDeltaMessage struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Changed       []*LeaderboardEntryMessage `protobuf:"bytes,1,rep,name=changed,proto3" json:"changed,omitempty"`
+	RemovedIds    []uint64                   `protobuf:"varint,2,rep,packed,name=removed_ids,json=removedIds,proto3" json:"removed_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaderboardDeltaMessage) Reset() {
+	*x = LeaderboardDeltaMessage{}
+	mi := &file_packets_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardDeltaMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardDeltaMessage) ProtoMessage() {}
+
+func (x *LeaderboardDeltaMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardDeltaMessage.ProtoReflect.Descriptor instead.
+func (*LeaderboardDeltaMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *LeaderboardDeltaMessage) GetChanged() []*LeaderboardEntryMessage {
+	if x != nil {
+		return x.Changed
+	}
+	return nil
+}
+
+func (x *LeaderboardDeltaMessage) GetRemovedIds() []uint64 {
+	if x != nil {
+		return x.RemovedIds
+	}
+	return nil
+}
+
+type ClientInfoMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`                                //like "1.2.0"
+	SupportsAcks  bool                   `protobuf:"varint,2,opt,name=supports_acks,json=supportsAcks,proto3" json:"supports_acks,omitempty"` //the client will answer packets that have a seq with an AckMessage
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClientInfoMessage) Reset() {
+	*x = ClientInfoMessage{}
+	mi := &file_packets_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientInfoMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientInfoMessage) ProtoMessage() {}
+
+func (x *ClientInfoMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientInfoMessage.ProtoReflect.Descriptor instead.
+func (*ClientInfoMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ClientInfoMessage) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ClientInfoMessage) GetSupportsAcks() bool {
+	if x != nil {
+		return x.SupportsAcks
+	}
+	return false
+}
+
+// Sent by the client when it gets a packet with a seq, so the server stops resending it
+type AckMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seq           uint64                 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AckMessage) Reset() {
+	*x = AckMessage{}
+	mi := &file_packets_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AckMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckMessage) ProtoMessage() {}
+
+func (x *AckMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckMessage.ProtoReflect.Descriptor instead.
+func (*AckMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AckMessage) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+type UpdateRequiredMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinVersion    string                 `protobuf:"bytes,1,opt,name=min_version,json=minVersion,proto3" json:"min_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRequiredMessage) Reset() {
+	*x = UpdateRequiredMessage{}
+	mi := &file_packets_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRequiredMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRequiredMessage) ProtoMessage() {}
+
+func (x *UpdateRequiredMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRequiredMessage.ProtoReflect.Descriptor instead.
+func (*UpdateRequiredMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateRequiredMessage) GetMinVersion() string {
+	if x != nil {
+		return x.MinVersion
+	}
+	return ""
+}
+
+type MinimapPlayerMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CellX         uint32                 `protobuf:"varint,2,opt,name=cell_x,json=cellX,proto3" json:"cell_x,omitempty"`
+	CellY         uint32                 `protobuf:"varint,3,opt,name=cell_y,json=cellY,proto3" json:"cell_y,omitempty"`
+	Mass          uint64                 `protobuf:"varint,4,opt,name=mass,proto3" json:"mass,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MinimapPlayerMessage) Reset() {
+	*x = MinimapPlayerMessage{}
+	mi := &file_packets_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MinimapPlayerMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MinimapPlayerMessage) ProtoMessage() {}
+
+func (x *MinimapPlayerMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MinimapPlayerMessage.ProtoReflect.Descriptor instead.
+func (*MinimapPlayerMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MinimapPlayerMessage) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MinimapPlayerMessage) GetCellX() uint32 {
+	if x != nil {
+		return x.CellX
+	}
+	return 0
+}
+
+func (x *MinimapPlayerMessage) GetCellY() uint32 {
+	if x != nil {
+		return x.CellY
+	}
+	return 0
+}
+
+func (x *MinimapPlayerMessage) GetMass() uint64 {
+	if x != nil {
+		return x.Mass
+	}
+	return 0
+}
+
+// Rough overview of the whole map, the world is split into a grid_size x grid_size grid
+// spore_density has the number of spores in each cell, row by row
+type MinimapMessage struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	GridSize      uint32                  `protobuf:"varint,1,opt,name=grid_size,json=gridSize,proto3" json:"grid_size,omitempty"`
+	Players       []*MinimapPlayerMessage `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`
+	SporeDensity  []uint32                `protobuf:"varint,3,rep,packed,name=spore_density,json=sporeDensity,proto3" json:"spore_density,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MinimapMessage) Reset() {
+	*x = MinimapMessage{}
+	mi := &file_packets_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MinimapMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MinimapMessage) ProtoMessage() {}
+
+func (x *MinimapMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MinimapMessage.ProtoReflect.Descriptor instead.
+func (*MinimapMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *MinimapMessage) GetGridSize() uint32 {
+	if x != nil {
+		return x.GridSize
+	}
+	return 0
+}
+
+func (x *MinimapMessage) GetPlayers() []*MinimapPlayerMessage {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *MinimapMessage) GetSporeDensity() []uint32 {
+	if x != nil {
+		return x.SporeDensity
+	}
+	return nil
+}
+
+// Sent back when the server can't do what the client asked for
+type ErrorMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ErrorMessage) Reset() {
+	*x = ErrorMessage{}
+	mi := &file_packets_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErrorMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorMessage) ProtoMessage() {}
+
+func (x *ErrorMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorMessage.ProtoReflect.Descriptor instead.
+func (*ErrorMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ErrorMessage) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type KickMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickMessage) Reset() {
+	*x = KickMessage{}
+	mi := &file_packets_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickMessage) ProtoMessage() {}
+
+func (x *KickMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickMessage.ProtoReflect.Descriptor instead.
+func (*KickMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *KickMessage) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Seconds left before the round starts, 0 means it just started
+type CountdownMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seconds       uint32                 `protobuf:"varint,1,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountdownMessage) Reset() {
+	*x = CountdownMessage{}
+	mi := &file_packets_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountdownMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountdownMessage) ProtoMessage() {}
+
+func (x *CountdownMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountdownMessage.ProtoReflect.Descriptor instead.
+func (*CountdownMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CountdownMessage) GetSeconds() uint32 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+// The server sends this when entering the game, a client that lost its connection sends it back
+// to take over its old player
+type ReconnectMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      uint64                 `protobuf:"varint,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconnectMessage) Reset() {
+	*x = ReconnectMessage{}
+	mi := &file_packets_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconnectMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconnectMessage) ProtoMessage() {}
+
+func (x *ReconnectMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconnectMessage.ProtoReflect.Descriptor instead.
+func (*ReconnectMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReconnectMessage) GetClientId() uint64 {
+	if x != nil {
+		return x.ClientId
+	}
+	return 0
+}
+
+func (x *ReconnectMessage) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+// A player's preferences, the client sends it to change them and the server sends back what got saved
+// color is rgba like in RegisterRequestMessage and has to be fully opaque
+type SettingsMessage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ChatEnabled    bool                   `protobuf:"varint,1,opt,name=chat_enabled,json=chatEnabled,proto3" json:"chat_enabled,omitempty"`
+	MinimapEnabled bool                   `protobuf:"varint,2,opt,name=minimap_enabled,json=minimapEnabled,proto3" json:"minimap_enabled,omitempty"`
+	AutoCollect    bool                   `protobuf:"varint,3,opt,name=auto_collect,json=autoCollect,proto3" json:"auto_collect,omitempty"`
+	Color          int32                  `protobuf:"varint,4,opt,name=color,proto3" json:"color,omitempty"`
+	SkinId         uint32                 `protobuf:"varint,5,opt,name=skin_id,json=skinId,proto3" json:"skin_id,omitempty"`
+	SelfEcho       SelfEcho               `protobuf:"varint,6,opt,name=self_echo,json=selfEcho,proto3,enum=packets.SelfEcho" json:"self_echo,omitempty"`
+	HapticsEnabled bool                   `protobuf:"varint,7,opt,name=haptics_enabled,json=hapticsEnabled,proto3" json:"haptics_enabled,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SettingsMessage) Reset() {
+	*x = SettingsMessage{}
+	mi := &file_packets_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettingsMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettingsMessage) ProtoMessage() {}
+
+func (x *SettingsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettingsMessage.ProtoReflect.Descriptor instead.
+func (*SettingsMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SettingsMessage) GetChatEnabled() bool {
+	if x != nil {
+		return x.ChatEnabled
+	}
+	return false
+}
+
+func (x *SettingsMessage) GetMinimapEnabled() bool {
+	if x != nil {
+		return x.MinimapEnabled
+	}
+	return false
+}
+
+func (x *SettingsMessage) GetAutoCollect() bool {
+	if x != nil {
+		return x.AutoCollect
+	}
+	return false
+}
+
+func (x *SettingsMessage) GetColor() int32 {
+	if x != nil {
+		return x.Color
+	}
+	return 0
+}
+
+func (x *SettingsMessage) GetSkinId() uint32 {
+	if x != nil {
+		return x.SkinId
+	}
+	return 0
+}
+
+func (x *SettingsMessage) GetSelfEcho() SelfEcho {
+	if x != nil {
+		return x.SelfEcho
+	}
+	return SelfEcho_SELF_ECHO_ALWAYS
+}
+
+func (x *SettingsMessage) GetHapticsEnabled() bool {
+	if x != nil {
+		return x.HapticsEnabled
+	}
+	return false
+}
+
+// Spores that went away on their own (like expired trail spores), not eaten by anyone
+type SporesDespawnedMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SporeIds      []uint64               `protobuf:"varint,1,rep,packed,name=spore_ids,json=sporeIds,proto3" json:"spore_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SporesDespawnedMessage) Reset() {
+	*x = SporesDespawnedMessage{}
+	mi := &file_packets_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SporesDespawnedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SporesDespawnedMessage) ProtoMessage() {}
+
+func (x *SporesDespawnedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SporesDespawnedMessage.ProtoReflect.Descriptor instead.
+func (*SporesDespawnedMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SporesDespawnedMessage) GetSporeIds() []uint64 {
+	if x != nil {
+		return x.SporeIds
+	}
+	return nil
+}
+
+// Sent by the client every so often to say it's still there, it's echoed back as is
+// It doesn't count as playing, so it doesn't keep a player from going AFK
+type HeartbeatMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SentAt        int64                  `protobuf:"varint,1,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HeartbeatMessage) Reset() {
+	*x = HeartbeatMessage{}
+	mi := &file_packets_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeartbeatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatMessage) ProtoMessage() {}
+
+func (x *HeartbeatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatMessage.ProtoReflect.Descriptor instead.
+func (*HeartbeatMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *HeartbeatMessage) GetSentAt() int64 {
+	if x != nil {
+		return x.SentAt
+	}
+	return 0
+}
+
+// The client sends it to start following a player (or switch to another one), the server sends
+// it back with the player actually being followed, which can change if the old one is gone
+type SpectateMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetId      uint64                 `protobuf:"varint,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpectateMessage) Reset() {
+	*x = SpectateMessage{}
+	mi := &file_packets_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpectateMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectateMessage) ProtoMessage() {}
+
+func (x *SpectateMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectateMessage.ProtoReflect.Descriptor instead.
+func (*SpectateMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SpectateMessage) GetTargetId() uint64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+// Spectating is over, either because the client asked or because there's nobody left to follow
+type SpectateEndedMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpectateEndedMessage) Reset() {
+	*x = SpectateEndedMessage{}
+	mi := &file_packets_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpectateEndedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectateEndedMessage) ProtoMessage() {}
+
+func (x *SpectateEndedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectateEndedMessage.ProtoReflect.Descriptor instead.
+func (*SpectateEndedMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SpectateEndedMessage) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ChatHistoryEntryMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SenderId      uint64                 `protobuf:"varint,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	SenderName    string                 `protobuf:"bytes,2,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	SentAt        int64                  `protobuf:"varint,4,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"` //unix time in milliseconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatHistoryEntryMessage) Reset() {
+	*x = ChatHistoryEntryMessage{}
+	mi := &file_packets_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatHistoryEntryMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatHistoryEntryMessage) ProtoMessage() {}
+
+func (x *ChatHistoryEntryMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatHistoryEntryMessage.ProtoReflect.Descriptor instead.
+func (*ChatHistoryEntryMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ChatHistoryEntryMessage) GetSenderId() uint64 {
+	if x != nil {
+		return x.SenderId
+	}
+	return 0
+}
+
+func (x *ChatHistoryEntryMessage) GetSenderName() string {
+	if x != nil {
+		return x.SenderName
+	}
+	return ""
+}
+
+func (x *ChatHistoryEntryMessage) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ChatHistoryEntryMessage) GetSentAt() int64 {
+	if x != nil {
+		return x.SentAt
+	}
+	return 0
+}
+
+// The last few chat messages, oldest first, sent when entering the game
+type ChatHistoryMessage struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Entries       []*ChatHistoryEntryMessage `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatHistoryMessage) Reset() {
+	*x = ChatHistoryMessage{}
+	mi := &file_packets_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatHistoryMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatHistoryMessage) ProtoMessage() {}
+
+func (x *ChatHistoryMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatHistoryMessage.ProtoReflect.Descriptor instead.
+func (*ChatHistoryMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ChatHistoryMessage) GetEntries() []*ChatHistoryEntryMessage {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// A player went away without anything to show for it (like leaving too far away to matter)
+type PlayerDespawnedMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      uint64                 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerDespawnedMessage) Reset() {
+	*x = PlayerDespawnedMessage{}
+	mi := &file_packets_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerDespawnedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerDespawnedMessage) ProtoMessage() {}
+
+func (x *PlayerDespawnedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerDespawnedMessage.ProtoReflect.Descriptor instead.
+func (*PlayerDespawnedMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PlayerDespawnedMessage) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+// Sent by the client to shoot some of its mass out in the direction it's moving
+type EjectMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EjectMessage) Reset() {
+	*x = EjectMessage{}
+	mi := &file_packets_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EjectMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EjectMessage) ProtoMessage() {}
+
+func (x *EjectMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EjectMessage.ProtoReflect.Descriptor instead.
+func (*EjectMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{45}
+}
+
+// A map wide event starting or ending, the multipliers are 0 when the event doesn't change that
+type EventMessage struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Name                string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Active              bool                   `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	SecondsLeft         uint32                 `protobuf:"varint,3,opt,name=seconds_left,json=secondsLeft,proto3" json:"seconds_left,omitempty"` //0 once it's ended
+	MassMultiplier      float64                `protobuf:"fixed64,4,opt,name=mass_multiplier,json=massMultiplier,proto3" json:"mass_multiplier,omitempty"`
+	SporeRateMultiplier float64                `protobuf:"fixed64,5,opt,name=spore_rate_multiplier,json=sporeRateMultiplier,proto3" json:"spore_rate_multiplier,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *EventMessage) Reset() {
+	*x = EventMessage{}
+	mi := &file_packets_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventMessage) ProtoMessage() {}
+
+func (x *EventMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventMessage.ProtoReflect.Descriptor instead.
+func (*EventMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *EventMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EventMessage) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *EventMessage) GetSecondsLeft() uint32 {
+	if x != nil {
+		return x.SecondsLeft
+	}
+	return 0
+}
+
+func (x *EventMessage) GetMassMultiplier() float64 {
+	if x != nil {
+		return x.MassMultiplier
+	}
+	return 0
+}
+
+func (x *EventMessage) GetSporeRateMultiplier() float64 {
+	if x != nil {
+		return x.SporeRateMultiplier
+	}
+	return 0
+}
+
+// Sent to a player the first time they unlock an achievement
+type AchievementUnlockedMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AchievementUnlockedMessage) Reset() {
+	*x = AchievementUnlockedMessage{}
+	mi := &file_packets_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AchievementUnlockedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AchievementUnlockedMessage) ProtoMessage() {}
+
+func (x *AchievementUnlockedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AchievementUnlockedMessage.ProtoReflect.Descriptor instead.
+func (*AchievementUnlockedMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AchievementUnlockedMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AchievementUnlockedMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Sent back to a player whose chat message wasn't sent, like when it's too long
+type ChatErrorMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatErrorMessage) Reset() {
+	*x = ChatErrorMessage{}
+	mi := &file_packets_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatErrorMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatErrorMessage) ProtoMessage() {}
+
+func (x *ChatErrorMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatErrorMessage.ProtoReflect.Descriptor instead.
+func (*ChatErrorMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ChatErrorMessage) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// The client sends this when the player starts or stops writing a chat message, and it's passed on
+// to the players close by so they can show it
+type TypingMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      uint64                 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` //filled in by the server
+	Typing        bool                   `protobuf:"varint,2,opt,name=typing,proto3" json:"typing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TypingMessage) Reset() {
+	*x = TypingMessage{}
+	mi := &file_packets_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TypingMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypingMessage) ProtoMessage() {}
+
+func (x *TypingMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypingMessage.ProtoReflect.Descriptor instead.
+func (*TypingMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *TypingMessage) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *TypingMessage) GetTyping() bool {
+	if x != nil {
+		return x.Typing
+	}
+	return false
+}
+
+// Sent by the client to get back in the game after being eaten
+type RespawnMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespawnMessage) Reset() {
+	*x = RespawnMessage{}
+	mi := &file_packets_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespawnMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespawnMessage) ProtoMessage() {}
+
+func (x *RespawnMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespawnMessage.ProtoReflect.Descriptor instead.
+func (*RespawnMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{50}
+}
+
+// Where the player is in the ranking of everyone in the game, sent with every leaderboard since
+// most players won't be on it
+type MyRankMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          uint32                 `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	TotalPlayers  uint32                 `protobuf:"varint,2,opt,name=total_players,json=totalPlayers,proto3" json:"total_players,omitempty"`
+	Mass          uint64                 `protobuf:"varint,3,opt,name=mass,proto3" json:"mass,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MyRankMessage) Reset() {
+	*x = MyRankMessage{}
+	mi := &file_packets_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MyRankMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MyRankMessage) ProtoMessage() {}
+
+func (x *MyRankMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MyRankMessage.ProtoReflect.Descriptor instead.
+func (*MyRankMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *MyRankMessage) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *MyRankMessage) GetTotalPlayers() uint32 {
+	if x != nil {
+		return x.TotalPlayers
+	}
+	return 0
+}
+
+func (x *MyRankMessage) GetMass() uint64 {
+	if x != nil {
+		return x.Mass
+	}
+	return 0
+}
+
+// How the connection looks from the server's side, sent every so often to help find lag
+type ConnectionStatsMessage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PingMs         uint32                 `protobuf:"varint,1,opt,name=ping_ms,json=pingMs,proto3" json:"ping_ms,omitempty"`                         //0 if it hasn't been measured yet
+	TickRate       float64                `protobuf:"fixed64,2,opt,name=tick_rate,json=tickRate,proto3" json:"tick_rate,omitempty"`                  //player updates per second the server is managing for this player
+	DroppedPackets uint64                 `protobuf:"varint,3,opt,name=dropped_packets,json=droppedPackets,proto3" json:"dropped_packets,omitempty"` //packets the server dropped because the client couldn't keep up
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ConnectionStatsMessage) Reset() {
+	*x = ConnectionStatsMessage{}
+	mi := &file_packets_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectionStatsMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionStatsMessage) ProtoMessage() {}
+
+func (x *ConnectionStatsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionStatsMessage.ProtoReflect.Descriptor instead.
+func (*ConnectionStatsMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ConnectionStatsMessage) GetPingMs() uint32 {
+	if x != nil {
+		return x.PingMs
+	}
+	return 0
+}
+
+func (x *ConnectionStatsMessage) GetTickRate() float64 {
+	if x != nil {
+		return x.TickRate
+	}
+	return 0
+}
+
+func (x *ConnectionStatsMessage) GetDroppedPackets() uint64 {
+	if x != nil {
+		return x.DroppedPackets
+	}
+	return 0
+}
+
+// Sent after each life with the totals for every life since the player came in from the menu
+type MatchSummaryMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SporesEaten   uint32                 `protobuf:"varint,1,opt,name=spores_eaten,json=sporesEaten,proto3" json:"spores_eaten,omitempty"`
+	PlayersEaten  uint32                 `protobuf:"varint,2,opt,name=players_eaten,json=playersEaten,proto3" json:"players_eaten,omitempty"`
+	TimesEaten    uint32                 `protobuf:"varint,3,opt,name=times_eaten,json=timesEaten,proto3" json:"times_eaten,omitempty"`
+	MaxMass       uint64                 `protobuf:"varint,4,opt,name=max_mass,json=maxMass,proto3" json:"max_mass,omitempty"`
+	Distance      uint64                 `protobuf:"varint,5,opt,name=distance,proto3" json:"distance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchSummaryMessage) Reset() {
+	*x = MatchSummaryMessage{}
+	mi := &file_packets_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchSummaryMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchSummaryMessage) ProtoMessage() {}
+
+func (x *MatchSummaryMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchSummaryMessage.ProtoReflect.Descriptor instead.
+func (*MatchSummaryMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *MatchSummaryMessage) GetSporesEaten() uint32 {
+	if x != nil {
+		return x.SporesEaten
+	}
+	return 0
+}
+
+func (x *MatchSummaryMessage) GetPlayersEaten() uint32 {
+	if x != nil {
+		return x.PlayersEaten
+	}
+	return 0
+}
+
+func (x *MatchSummaryMessage) GetTimesEaten() uint32 {
+	if x != nil {
+		return x.TimesEaten
+	}
+	return 0
+}
+
+func (x *MatchSummaryMessage) GetMaxMass() uint64 {
+	if x != nil {
+		return x.MaxMass
+	}
+	return 0
+}
+
+func (x *MatchSummaryMessage) GetDistance() uint64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+// Sent reliably at the end of every life, right after the match summary
+type GameOverMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FinalMass     uint64                 `protobuf:"varint,1,opt,name=final_mass,json=finalMass,proto3" json:"final_mass,omitempty"`
+	MaxMass       uint64                 `protobuf:"varint,2,opt,name=max_mass,json=maxMass,proto3" json:"max_mass,omitempty"` //the most the player had this life
+	Rank          uint32                 `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`                      //where the final mass places among the players still in the game
+	Total         uint32                 `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	SessionLength float64                `protobuf:"fixed64,5,opt,name=session_length,json=sessionLength,proto3" json:"session_length,omitempty"` //seconds since the player came in from the menu
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameOverMessage) Reset() {
+	*x = GameOverMessage{}
+	mi := &file_packets_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameOverMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameOverMessage) ProtoMessage() {}
+
+func (x *GameOverMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameOverMessage.ProtoReflect.Descriptor instead.
+func (*GameOverMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GameOverMessage) GetFinalMass() uint64 {
+	if x != nil {
+		return x.FinalMass
+	}
+	return 0
+}
+
+func (x *GameOverMessage) GetMaxMass() uint64 {
+	if x != nil {
+		return x.MaxMass
+	}
+	return 0
+}
+
+func (x *GameOverMessage) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *GameOverMessage) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GameOverMessage) GetSessionLength() float64 {
+	if x != nil {
+		return x.SessionLength
+	}
+	return 0
+}
+
+// The client can ask for the server info before joining
+type RequestServerInfoMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestServerInfoMessage) Reset() {
+	*x = RequestServerInfoMessage{}
+	mi := &file_packets_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestServerInfoMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestServerInfoMessage) ProtoMessage() {}
+
+func (x *RequestServerInfoMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestServerInfoMessage.ProtoReflect.Descriptor instead.
+func (*RequestServerInfoMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{55}
+}
+
+type ServerInfoMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Clients       uint32                 `protobuf:"varint,3,opt,name=clients,proto3" json:"clients,omitempty"`                         //everyone connected, in game or not
+	Players       uint32                 `protobuf:"varint,4,opt,name=players,proto3" json:"players,omitempty"`                         //just the ones in game
+	MaxPlayers    uint32                 `protobuf:"varint,5,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"` //0 for no limit
+	GameMode      string                 `protobuf:"bytes,6,opt,name=game_mode,json=gameMode,proto3" json:"game_mode,omitempty"`
+	UptimeSeconds uint64                 `protobuf:"varint,7,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerInfoMessage) Reset() {
+	*x = ServerInfoMessage{}
+	mi := &file_packets_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerInfoMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerInfoMessage) ProtoMessage() {}
+
+func (x *ServerInfoMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerInfoMessage.ProtoReflect.Descriptor instead.
+func (*ServerInfoMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ServerInfoMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServerInfoMessage) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ServerInfoMessage) GetClients() uint32 {
+	if x != nil {
+		return x.Clients
+	}
+	return 0
+}
+
+func (x *ServerInfoMessage) GetPlayers() uint32 {
+	if x != nil {
+		return x.Players
+	}
+	return 0
+}
+
+func (x *ServerInfoMessage) GetMaxPlayers() uint32 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
+}
+
+func (x *ServerInfoMessage) GetGameMode() string {
+	if x != nil {
+		return x.GameMode
+	}
+	return ""
+}
+
+func (x *ServerInfoMessage) GetUptimeSeconds() uint64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
+// Sent to a player when an admin freezes or unfreezes it, it can't move while frozen
+type FrozenMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Frozen        bool                   `protobuf:"varint,1,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FrozenMessage) Reset() {
+	*x = FrozenMessage{}
+	mi := &file_packets_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FrozenMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrozenMessage) ProtoMessage() {}
+
+func (x *FrozenMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrozenMessage.ProtoReflect.Descriptor instead.
+func (*FrozenMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *FrozenMessage) GetFrozen() bool {
+	if x != nil {
+		return x.Frozen
+	}
+	return false
+}
+
+type HapticMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          HapticKind             `protobuf:"varint,1,opt,name=kind,proto3,enum=packets.HapticKind" json:"kind,omitempty"`
+	Intensity     float64                `protobuf:"fixed64,2,opt,name=intensity,proto3" json:"intensity,omitempty"` //from 0 to 1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HapticMessage) Reset() {
+	*x = HapticMessage{}
+	mi := &file_packets_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HapticMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HapticMessage) ProtoMessage() {}
+
+func (x *HapticMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HapticMessage.ProtoReflect.Descriptor instead.
+func (*HapticMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *HapticMessage) GetKind() HapticKind {
+	if x != nil {
+		return x.Kind
+	}
+	return HapticKind_HAPTIC_ATE_PLAYER
+}
+
+func (x *HapticMessage) GetIntensity() float64 {
+	if x != nil {
+		return x.Intensity
+	}
+	return 0
+}
+
+// A client that thinks it's out of sync asks for the server's version of things
+type RequestResyncMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestResyncMessage) Reset() {
+	*x = RequestResyncMessage{}
+	mi := &file_packets_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestResyncMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestResyncMessage) ProtoMessage() {}
+
+func (x *RequestResyncMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestResyncMessage.ProtoReflect.Descriptor instead.
+func (*RequestResyncMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{59}
+}
+
+type ResyncMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Player        *PlayerMessage         `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`   //the client's own player
+	Players       []*PlayerMessage       `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"` //everyone else in view
+	Spores        []*SporeMessage        `protobuf:"bytes,3,rep,name=spores,proto3" json:"spores,omitempty"`   //every spore in view
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResyncMessage) Reset() {
+	*x = ResyncMessage{}
+	mi := &file_packets_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResyncMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResyncMessage) ProtoMessage() {}
+
+func (x *ResyncMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResyncMessage.ProtoReflect.Descriptor instead.
+func (*ResyncMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ResyncMessage) GetPlayer() *PlayerMessage {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *ResyncMessage) GetPlayers() []*PlayerMessage {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *ResyncMessage) GetSpores() []*SporeMessage {
+	if x != nil {
+		return x.Spores
+	}
+	return nil
+}
+
+// Roster changes, separate from position updates so clients can keep a clean player list
+type PlayerJoinedMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Color         int32                  `protobuf:"varint,3,opt,name=color,proto3" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerJoinedMessage) Reset() {
+	*x = PlayerJoinedMessage{}
+	mi := &file_packets_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerJoinedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerJoinedMessage) ProtoMessage() {}
+
+func (x *PlayerJoinedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerJoinedMessage.ProtoReflect.Descriptor instead.
+func (*PlayerJoinedMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *PlayerJoinedMessage) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PlayerJoinedMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerJoinedMessage) GetColor() int32 {
+	if x != nil {
+		return x.Color
+	}
+	return 0
+}
+
+type PlayerLeftMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerLeftMessage) Reset() {
+	*x = PlayerLeftMessage{}
+	mi := &file_packets_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerLeftMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerLeftMessage) ProtoMessage() {}
+
+func (x *PlayerLeftMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerLeftMessage.ProtoReflect.Descriptor instead.
+func (*PlayerLeftMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *PlayerLeftMessage) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// A player changing its look mid game, the client sends it for itself (player_id isn't needed then)
+// and the server passes it on to everyone else
+type ChangeColorMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      uint64                 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Color         int32                  `protobuf:"varint,2,opt,name=color,proto3" json:"color,omitempty"` //rgba, has to be fully opaque
+	SkinId        uint32                 `protobuf:"varint,3,opt,name=skin_id,json=skinId,proto3" json:"skin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeColorMessage) Reset() {
+	*x = ChangeColorMessage{}
+	mi := &file_packets_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeColorMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeColorMessage) ProtoMessage() {}
+
+func (x *ChangeColorMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeColorMessage.ProtoReflect.Descriptor instead.
+func (*ChangeColorMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ChangeColorMessage) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *ChangeColorMessage) GetColor() int32 {
+	if x != nil {
+		return x.Color
+	}
+	return 0
+}
+
+func (x *ChangeColorMessage) GetSkinId() uint32 {
+	if x != nil {
+		return x.SkinId
+	}
+	return 0
+}
+
+// Stops (or starts again) the server passing on the target's chat to the player, for the rest of the session
+// The server sends it back once it's done
+type MuteMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetId      uint64                 `protobuf:"varint,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteMessage) Reset() {
+	*x = MuteMessage{}
+	mi := &file_packets_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteMessage) ProtoMessage() {}
+
+func (x *MuteMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteMessage.ProtoReflect.Descriptor instead.
+func (*MuteMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *MuteMessage) GetTargetId() uint64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+type UnmuteMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetId      uint64                 `protobuf:"varint,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmuteMessage) Reset() {
+	*x = UnmuteMessage{}
+	mi := &file_packets_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmuteMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteMessage) ProtoMessage() {}
+
+func (x *UnmuteMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteMessage.ProtoReflect.Descriptor instead.
+func (*UnmuteMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *UnmuteMessage) GetTargetId() uint64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+type PlayerStatsMessage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	BestScore      uint64                 `protobuf:"varint,2,opt,name=best_score,json=bestScore,proto3" json:"best_score,omitempty"`
+	MatchesPlayed  uint64                 `protobuf:"varint,3,opt,name=matches_played,json=matchesPlayed,proto3" json:"matches_played,omitempty"`
+	TotalMassEaten uint64                 `protobuf:"varint,4,opt,name=total_mass_eaten,json=totalMassEaten,proto3" json:"total_mass_eaten,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PlayerStatsMessage) Reset() {
+	*x = PlayerStatsMessage{}
+	mi := &file_packets_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerStatsMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerStatsMessage) ProtoMessage() {}
+
+func (x *PlayerStatsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerStatsMessage.ProtoReflect.Descriptor instead.
+func (*PlayerStatsMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *PlayerStatsMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerStatsMessage) GetBestScore() uint64 {
+	if x != nil {
+		return x.BestScore
+	}
+	return 0
+}
+
+func (x *PlayerStatsMessage) GetMatchesPlayed() uint64 {
+	if x != nil {
+		return x.MatchesPlayed
+	}
+	return 0
+}
+
+func (x *PlayerStatsMessage) GetTotalMassEaten() uint64 {
+	if x != nil {
+		return x.TotalMassEaten
+	}
+	return 0
+}
+
+// Creating a wrapper named Packet that packs any message with the sender id
+type Packet struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SenderId uint64                 `protobuf:"varint,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	Seq      uint64                 `protobuf:"varint,100,opt,name=seq,proto3" json:"seq,omitempty"` //only set on important packets that the client should ack
+	// Types that are valid to be assigned to Msg:
+	//
+	//	*Packet_Chat
+	//	*Packet_Id
+	//	*Packet_LoginRequest
+	//	*Packet_RegisterRequest
+	//	*Packet_OkResponse
+	//	*Packet_DenyResponse
+	//	*Packet_Player
+	//	*Packet_PlayerDirection
+	//	*Packet_Spore
+	//	*Packet_SporeConsumed
+	//	*Packet_SporesBatch
+	//	*Packet_PlayerConsumed
+	//	*Packet_HiscoreBoardRequest
+	//	*Packet_Hiscore
+	//	*Packet_HiscoreBoard
+	//	*Packet_FinishedBrowsingHiscores
+	//	*Packet_SearchHiscore
+	//	*Packet_Disconnect
+	//	*Packet_Emote
+	//	*Packet_RequestStats
+	//	*Packet_PlayerStats
+	//	*Packet_EnterGame
+	//	*Packet_WorldBounds
+	//	*Packet_KillFeed
+	//	*Packet_Kick
+	//	*Packet_GameConfig
+	//	*Packet_Leaderboard
+	//	*Packet_ClientInfo
+	//	*Packet_UpdateRequired
+	//	*Packet_Error
+	//	*Packet_Minimap
+	//	*Packet_Ack
+	//	*Packet_Countdown
+	//	*Packet_Reconnect
+	//	*Packet_Settings
+	//	*Packet_SporesDespawned
+	//	*Packet_Heartbeat
+	//	*Packet_Spectate
+	//	*Packet_SpectateEnded
+	//	*Packet_ChatHistory
+	//	*Packet_PlayerDespawned
+	//	*Packet_Eject
+	//	*Packet_LeaderboardDelta
+	//	*Packet_Event
+	//	*Packet_AchievementUnlocked
+	//	*Packet_ChatError
+	//	*Packet_Typing
+	//	*Packet_Respawn
+	//	*Packet_MyRank
+	//	*Packet_ConnectionStats
+	//	*Packet_MatchSummary
+	//	*Packet_RequestServerInfo
+	//	*Packet_ServerInfo
+	//	*Packet_BatchConsume
+	//	*Packet_Frozen
+	//	*Packet_Haptic
+	//	*Packet_RequestResync
+	//	*Packet_Resync
+	//	*Packet_GameOver
+	//	*Packet_PlayerJoined
+	//	*Packet_PlayerLeft
+	//	*Packet_ChangeColor
+	//	*Packet_Mute
+	//	*Packet_Unmute
+	Msg           isPacket_Msg `protobuf_oneof:"msg"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Packet) Reset() {
+	*x = Packet{}
+	mi := &file_packets_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Packet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Packet) ProtoMessage() {}
+
+func (x *Packet) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -966,180 +3937,601 @@ func (x *Packet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Packet.ProtoReflect.Descriptor instead.
 func (*Packet) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{18}
+	return file_packets_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *Packet) GetSenderId() uint64 {
+	if x != nil {
+		return x.SenderId
+	}
+	return 0
+}
+
+func (x *Packet) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *Packet) GetMsg() isPacket_Msg {
+	if x != nil {
+		return x.Msg
+	}
+	return nil
+}
+
+func (x *Packet) GetChat() *ChatMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Chat); ok {
+			return x.Chat
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetId() *IdMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Id); ok {
+			return x.Id
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetLoginRequest() *LoginRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_LoginRequest); ok {
+			return x.LoginRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetRegisterRequest() *RegisterRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_RegisterRequest); ok {
+			return x.RegisterRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetOkResponse() *OkResponseMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_OkResponse); ok {
+			return x.OkResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetDenyResponse() *DenyResponseMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_DenyResponse); ok {
+			return x.DenyResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPlayer() *PlayerMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Player); ok {
+			return x.Player
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPlayerDirection() *PlayerDirectionMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PlayerDirection); ok {
+			return x.PlayerDirection
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSpore() *SporeMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Spore); ok {
+			return x.Spore
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSporeConsumed() *SporeConsumedMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_SporeConsumed); ok {
+			return x.SporeConsumed
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSporesBatch() *SporeBatchMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_SporesBatch); ok {
+			return x.SporesBatch
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPlayerConsumed() *PlayerConsumedMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PlayerConsumed); ok {
+			return x.PlayerConsumed
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetHiscoreBoardRequest() *HiscoreBoardRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_HiscoreBoardRequest); ok {
+			return x.HiscoreBoardRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetHiscore() *HiscoreMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Hiscore); ok {
+			return x.Hiscore
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetHiscoreBoard() *HiscoreBoardMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_HiscoreBoard); ok {
+			return x.HiscoreBoard
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetFinishedBrowsingHiscores() *FinishedBrowsingHiscoresMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_FinishedBrowsingHiscores); ok {
+			return x.FinishedBrowsingHiscores
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSearchHiscore() *SearchHiscoreMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_SearchHiscore); ok {
+			return x.SearchHiscore
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetDisconnect() *DisconnectMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Disconnect); ok {
+			return x.Disconnect
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetEmote() *EmoteMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Emote); ok {
+			return x.Emote
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetRequestStats() *RequestStatsMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_RequestStats); ok {
+			return x.RequestStats
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPlayerStats() *PlayerStatsMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PlayerStats); ok {
+			return x.PlayerStats
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetEnterGame() *EnterGameMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_EnterGame); ok {
+			return x.EnterGame
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetWorldBounds() *WorldBoundsMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_WorldBounds); ok {
+			return x.WorldBounds
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetKillFeed() *KillFeedMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_KillFeed); ok {
+			return x.KillFeed
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetKick() *KickMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Kick); ok {
+			return x.Kick
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetGameConfig() *GameConfigMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_GameConfig); ok {
+			return x.GameConfig
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetLeaderboard() *LeaderboardMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Leaderboard); ok {
+			return x.Leaderboard
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetClientInfo() *ClientInfoMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ClientInfo); ok {
+			return x.ClientInfo
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetUpdateRequired() *UpdateRequiredMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_UpdateRequired); ok {
+			return x.UpdateRequired
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetError() *ErrorMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetMinimap() *MinimapMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Minimap); ok {
+			return x.Minimap
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetAck() *AckMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Ack); ok {
+			return x.Ack
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetCountdown() *CountdownMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Countdown); ok {
+			return x.Countdown
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetReconnect() *ReconnectMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Reconnect); ok {
+			return x.Reconnect
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSettings() *SettingsMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Settings); ok {
+			return x.Settings
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSporesDespawned() *SporesDespawnedMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_SporesDespawned); ok {
+			return x.SporesDespawned
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetHeartbeat() *HeartbeatMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Heartbeat); ok {
+			return x.Heartbeat
+		}
+	}
+	return nil
 }
 
-func (x *Packet) GetSenderId() uint64 {
+func (x *Packet) GetSpectate() *SpectateMessage {
 	if x != nil {
-		return x.SenderId
+		if x, ok := x.Msg.(*Packet_Spectate); ok {
+			return x.Spectate
+		}
 	}
-	return 0
+	return nil
 }
 
-func (x *Packet) GetMsg() isPacket_Msg {
+func (x *Packet) GetSpectateEnded() *SpectateEndedMessage {
 	if x != nil {
-		return x.Msg
+		if x, ok := x.Msg.(*Packet_SpectateEnded); ok {
+			return x.SpectateEnded
+		}
 	}
 	return nil
 }
 
-func (x *Packet) GetChat() *ChatMessage {
+func (x *Packet) GetChatHistory() *ChatHistoryMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Chat); ok {
-			return x.Chat
+		if x, ok := x.Msg.(*Packet_ChatHistory); ok {
+			return x.ChatHistory
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetId() *IdMessage {
+func (x *Packet) GetPlayerDespawned() *PlayerDespawnedMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Id); ok {
-			return x.Id
+		if x, ok := x.Msg.(*Packet_PlayerDespawned); ok {
+			return x.PlayerDespawned
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetLoginRequest() *LoginRequestMessage {
+func (x *Packet) GetEject() *EjectMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_LoginRequest); ok {
-			return x.LoginRequest
+		if x, ok := x.Msg.(*Packet_Eject); ok {
+			return x.Eject
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetRegisterRequest() *RegisterRequestMessage {
+func (x *Packet) GetLeaderboardDelta() *LeaderboardDeltaMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_RegisterRequest); ok {
-			return x.RegisterRequest
+		if x, ok := x.Msg.(*Packet_LeaderboardDelta); ok {
+			return x.LeaderboardDelta
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetOkResponse() *OkResponseMessage {
+func (x *Packet) GetEvent() *EventMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_OkResponse); ok {
-			return x.OkResponse
+		if x, ok := x.Msg.(*Packet_Event); ok {
+			return x.Event
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetDenyResponse() *DenyResponseMessage {
+func (x *Packet) GetAchievementUnlocked() *AchievementUnlockedMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_DenyResponse); ok {
-			return x.DenyResponse
+		if x, ok := x.Msg.(*Packet_AchievementUnlocked); ok {
+			return x.AchievementUnlocked
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetPlayer() *PlayerMessage {
+func (x *Packet) GetChatError() *ChatErrorMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Player); ok {
-			return x.Player
+		if x, ok := x.Msg.(*Packet_ChatError); ok {
+			return x.ChatError
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetPlayerDirection() *PlayerDirectionMessage {
+func (x *Packet) GetTyping() *TypingMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_PlayerDirection); ok {
-			return x.PlayerDirection
+		if x, ok := x.Msg.(*Packet_Typing); ok {
+			return x.Typing
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetSpore() *SporeMessage {
+func (x *Packet) GetRespawn() *RespawnMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Spore); ok {
-			return x.Spore
+		if x, ok := x.Msg.(*Packet_Respawn); ok {
+			return x.Respawn
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetSporeConsumed() *SporeConsumedMessage {
+func (x *Packet) GetMyRank() *MyRankMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_SporeConsumed); ok {
-			return x.SporeConsumed
+		if x, ok := x.Msg.(*Packet_MyRank); ok {
+			return x.MyRank
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetSporesBatch() *SporeBatchMessage {
+func (x *Packet) GetConnectionStats() *ConnectionStatsMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_SporesBatch); ok {
-			return x.SporesBatch
+		if x, ok := x.Msg.(*Packet_ConnectionStats); ok {
+			return x.ConnectionStats
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetPlayerConsumed() *PlayerConsumedMessage {
+func (x *Packet) GetMatchSummary() *MatchSummaryMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_PlayerConsumed); ok {
-			return x.PlayerConsumed
+		if x, ok := x.Msg.(*Packet_MatchSummary); ok {
+			return x.MatchSummary
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetHiscoreBoardRequest() *HiscoreBoardRequestMessage {
+func (x *Packet) GetRequestServerInfo() *RequestServerInfoMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_HiscoreBoardRequest); ok {
-			return x.HiscoreBoardRequest
+		if x, ok := x.Msg.(*Packet_RequestServerInfo); ok {
+			return x.RequestServerInfo
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetHiscore() *HiscoreMessage {
+func (x *Packet) GetServerInfo() *ServerInfoMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Hiscore); ok {
-			return x.Hiscore
+		if x, ok := x.Msg.(*Packet_ServerInfo); ok {
+			return x.ServerInfo
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetHiscoreBoard() *HiscoreBoardMessage {
+func (x *Packet) GetBatchConsume() *BatchConsumeMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_HiscoreBoard); ok {
-			return x.HiscoreBoard
+		if x, ok := x.Msg.(*Packet_BatchConsume); ok {
+			return x.BatchConsume
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetFinishedBrowsingHiscores() *FinishedBrowsingHiscoresMessage {
+func (x *Packet) GetFrozen() *FrozenMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_FinishedBrowsingHiscores); ok {
-			return x.FinishedBrowsingHiscores
+		if x, ok := x.Msg.(*Packet_Frozen); ok {
+			return x.Frozen
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetSearchHiscore() *SearchHiscoreMessage {
+func (x *Packet) GetHaptic() *HapticMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_SearchHiscore); ok {
-			return x.SearchHiscore
+		if x, ok := x.Msg.(*Packet_Haptic); ok {
+			return x.Haptic
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetDisconnect() *DisconnectMessage {
+func (x *Packet) GetRequestResync() *RequestResyncMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Disconnect); ok {
-			return x.Disconnect
+		if x, ok := x.Msg.(*Packet_RequestResync); ok {
+			return x.RequestResync
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetResync() *ResyncMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Resync); ok {
+			return x.Resync
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetGameOver() *GameOverMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_GameOver); ok {
+			return x.GameOver
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPlayerJoined() *PlayerJoinedMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PlayerJoined); ok {
+			return x.PlayerJoined
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPlayerLeft() *PlayerLeftMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PlayerLeft); ok {
+			return x.PlayerLeft
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetChangeColor() *ChangeColorMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ChangeColor); ok {
+			return x.ChangeColor
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetMute() *MuteMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Mute); ok {
+			return x.Mute
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetUnmute() *UnmuteMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Unmute); ok {
+			return x.Unmute
 		}
 	}
 	return nil
@@ -1221,6 +4613,190 @@ type Packet_Disconnect struct {
 	Disconnect *DisconnectMessage `protobuf:"bytes,19,opt,name=disconnect,proto3,oneof"`
 }
 
+type Packet_Emote struct {
+	Emote *EmoteMessage `protobuf:"bytes,20,opt,name=emote,proto3,oneof"`
+}
+
+type Packet_RequestStats struct {
+	RequestStats *RequestStatsMessage `protobuf:"bytes,21,opt,name=request_stats,json=requestStats,proto3,oneof"`
+}
+
+type Packet_PlayerStats struct {
+	PlayerStats *PlayerStatsMessage `protobuf:"bytes,22,opt,name=player_stats,json=playerStats,proto3,oneof"`
+}
+
+type Packet_EnterGame struct {
+	EnterGame *EnterGameMessage `protobuf:"bytes,23,opt,name=enter_game,json=enterGame,proto3,oneof"`
+}
+
+type Packet_WorldBounds struct {
+	WorldBounds *WorldBoundsMessage `protobuf:"bytes,24,opt,name=world_bounds,json=worldBounds,proto3,oneof"`
+}
+
+type Packet_KillFeed struct {
+	KillFeed *KillFeedMessage `protobuf:"bytes,25,opt,name=kill_feed,json=killFeed,proto3,oneof"`
+}
+
+type Packet_Kick struct {
+	Kick *KickMessage `protobuf:"bytes,26,opt,name=kick,proto3,oneof"`
+}
+
+type Packet_GameConfig struct {
+	GameConfig *GameConfigMessage `protobuf:"bytes,27,opt,name=game_config,json=gameConfig,proto3,oneof"`
+}
+
+type Packet_Leaderboard struct {
+	Leaderboard *LeaderboardMessage `protobuf:"bytes,28,opt,name=leaderboard,proto3,oneof"`
+}
+
+type Packet_ClientInfo struct {
+	ClientInfo *ClientInfoMessage `protobuf:"bytes,29,opt,name=client_info,json=clientInfo,proto3,oneof"`
+}
+
+type Packet_UpdateRequired struct {
+	UpdateRequired *UpdateRequiredMessage `protobuf:"bytes,30,opt,name=update_required,json=updateRequired,proto3,oneof"`
+}
+
+type Packet_Error struct {
+	Error *ErrorMessage `protobuf:"bytes,31,opt,name=error,proto3,oneof"`
+}
+
+type Packet_Minimap struct {
+	Minimap *MinimapMessage `protobuf:"bytes,32,opt,name=minimap,proto3,oneof"`
+}
+
+type Packet_Ack struct {
+	Ack *AckMessage `protobuf:"bytes,33,opt,name=ack,proto3,oneof"`
+}
+
+type Packet_Countdown struct {
+	Countdown *CountdownMessage `protobuf:"bytes,34,opt,name=countdown,proto3,oneof"`
+}
+
+type Packet_Reconnect struct {
+	Reconnect *ReconnectMessage `protobuf:"bytes,35,opt,name=reconnect,proto3,oneof"`
+}
+
+type Packet_Settings struct {
+	Settings *SettingsMessage `protobuf:"bytes,36,opt,name=settings,proto3,oneof"`
+}
+
+type Packet_SporesDespawned struct {
+	SporesDespawned *SporesDespawnedMessage `protobuf:"bytes,37,opt,name=spores_despawned,json=sporesDespawned,proto3,oneof"`
+}
+
+type Packet_Heartbeat struct {
+	Heartbeat *HeartbeatMessage `protobuf:"bytes,38,opt,name=heartbeat,proto3,oneof"`
+}
+
+type Packet_Spectate struct {
+	Spectate *SpectateMessage `protobuf:"bytes,39,opt,name=spectate,proto3,oneof"`
+}
+
+type Packet_SpectateEnded struct {
+	SpectateEnded *SpectateEndedMessage `protobuf:"bytes,40,opt,name=spectate_ended,json=spectateEnded,proto3,oneof"`
+}
+
+type Packet_ChatHistory struct {
+	ChatHistory *ChatHistoryMessage `protobuf:"bytes,41,opt,name=chat_history,json=chatHistory,proto3,oneof"`
+}
+
+type Packet_PlayerDespawned struct {
+	PlayerDespawned *PlayerDespawnedMessage `protobuf:"bytes,42,opt,name=player_despawned,json=playerDespawned,proto3,oneof"`
+}
+
+type Packet_Eject struct {
+	Eject *EjectMessage `protobuf:"bytes,43,opt,name=eject,proto3,oneof"`
+}
+
+type Packet_LeaderboardDelta struct {
+	LeaderboardDelta *LeaderboardDeltaMessage `protobuf:"bytes,44,opt,name=leaderboard_delta,json=leaderboardDelta,proto3,oneof"`
+}
+
+type Packet_Event struct {
+	Event *EventMessage `protobuf:"bytes,45,opt,name=event,proto3,oneof"`
+}
+
+type Packet_AchievementUnlocked struct {
+	AchievementUnlocked *AchievementUnlockedMessage `protobuf:"bytes,46,opt,name=achievement_unlocked,json=achievementUnlocked,proto3,oneof"`
+}
+
+type Packet_ChatError struct {
+	ChatError *ChatErrorMessage `protobuf:"bytes,47,opt,name=chat_error,json=chatError,proto3,oneof"`
+}
+
+type Packet_Typing struct {
+	Typing *TypingMessage `protobuf:"bytes,48,opt,name=typing,proto3,oneof"`
+}
+
+type Packet_Respawn struct {
+	Respawn *RespawnMessage `protobuf:"bytes,49,opt,name=respawn,proto3,oneof"`
+}
+
+type Packet_MyRank struct {
+	MyRank *MyRankMessage `protobuf:"bytes,50,opt,name=my_rank,json=myRank,proto3,oneof"`
+}
+
+type Packet_ConnectionStats struct {
+	ConnectionStats *ConnectionStatsMessage `protobuf:"bytes,51,opt,name=connection_stats,json=connectionStats,proto3,oneof"`
+}
+
+type Packet_MatchSummary struct {
+	MatchSummary *MatchSummaryMessage `protobuf:"bytes,52,opt,name=match_summary,json=matchSummary,proto3,oneof"`
+}
+
+type Packet_RequestServerInfo struct {
+	RequestServerInfo *RequestServerInfoMessage `protobuf:"bytes,53,opt,name=request_server_info,json=requestServerInfo,proto3,oneof"`
+}
+
+type Packet_ServerInfo struct {
+	ServerInfo *ServerInfoMessage `protobuf:"bytes,54,opt,name=server_info,json=serverInfo,proto3,oneof"`
+}
+
+type Packet_BatchConsume struct {
+	BatchConsume *BatchConsumeMessage `protobuf:"bytes,55,opt,name=batch_consume,json=batchConsume,proto3,oneof"`
+}
+
+type Packet_Frozen struct {
+	Frozen *FrozenMessage `protobuf:"bytes,56,opt,name=frozen,proto3,oneof"`
+}
+
+type Packet_Haptic struct {
+	Haptic *HapticMessage `protobuf:"bytes,57,opt,name=haptic,proto3,oneof"`
+}
+
+type Packet_RequestResync struct {
+	RequestResync *RequestResyncMessage `protobuf:"bytes,58,opt,name=request_resync,json=requestResync,proto3,oneof"`
+}
+
+type Packet_Resync struct {
+	Resync *ResyncMessage `protobuf:"bytes,59,opt,name=resync,proto3,oneof"`
+}
+
+type Packet_GameOver struct {
+	GameOver *GameOverMessage `protobuf:"bytes,60,opt,name=game_over,json=gameOver,proto3,oneof"`
+}
+
+type Packet_PlayerJoined struct {
+	PlayerJoined *PlayerJoinedMessage `protobuf:"bytes,61,opt,name=player_joined,json=playerJoined,proto3,oneof"`
+}
+
+type Packet_PlayerLeft struct {
+	PlayerLeft *PlayerLeftMessage `protobuf:"bytes,62,opt,name=player_left,json=playerLeft,proto3,oneof"`
+}
+
+type Packet_ChangeColor struct {
+	ChangeColor *ChangeColorMessage `protobuf:"bytes,63,opt,name=change_color,json=changeColor,proto3,oneof"`
+}
+
+type Packet_Mute struct {
+	Mute *MuteMessage `protobuf:"bytes,64,opt,name=mute,proto3,oneof"`
+}
+
+type Packet_Unmute struct {
+	Unmute *UnmuteMessage `protobuf:"bytes,65,opt,name=unmute,proto3,oneof"`
+}
+
 func (*Packet_Chat) isPacket_Msg() {}
 
 func (*Packet_Id) isPacket_Msg() {}
@@ -1257,6 +4833,98 @@ func (*Packet_SearchHiscore) isPacket_Msg() {}
 
 func (*Packet_Disconnect) isPacket_Msg() {}
 
+func (*Packet_Emote) isPacket_Msg() {}
+
+func (*Packet_RequestStats) isPacket_Msg() {}
+
+func (*Packet_PlayerStats) isPacket_Msg() {}
+
+func (*Packet_EnterGame) isPacket_Msg() {}
+
+func (*Packet_WorldBounds) isPacket_Msg() {}
+
+func (*Packet_KillFeed) isPacket_Msg() {}
+
+func (*Packet_Kick) isPacket_Msg() {}
+
+func (*Packet_GameConfig) isPacket_Msg() {}
+
+func (*Packet_Leaderboard) isPacket_Msg() {}
+
+func (*Packet_ClientInfo) isPacket_Msg() {}
+
+func (*Packet_UpdateRequired) isPacket_Msg() {}
+
+func (*Packet_Error) isPacket_Msg() {}
+
+func (*Packet_Minimap) isPacket_Msg() {}
+
+func (*Packet_Ack) isPacket_Msg() {}
+
+func (*Packet_Countdown) isPacket_Msg() {}
+
+func (*Packet_Reconnect) isPacket_Msg() {}
+
+func (*Packet_Settings) isPacket_Msg() {}
+
+func (*Packet_SporesDespawned) isPacket_Msg() {}
+
+func (*Packet_Heartbeat) isPacket_Msg() {}
+
+func (*Packet_Spectate) isPacket_Msg() {}
+
+func (*Packet_SpectateEnded) isPacket_Msg() {}
+
+func (*Packet_ChatHistory) isPacket_Msg() {}
+
+func (*Packet_PlayerDespawned) isPacket_Msg() {}
+
+func (*Packet_Eject) isPacket_Msg() {}
+
+func (*Packet_LeaderboardDelta) isPacket_Msg() {}
+
+func (*Packet_Event) isPacket_Msg() {}
+
+func (*Packet_AchievementUnlocked) isPacket_Msg() {}
+
+func (*Packet_ChatError) isPacket_Msg() {}
+
+func (*Packet_Typing) isPacket_Msg() {}
+
+func (*Packet_Respawn) isPacket_Msg() {}
+
+func (*Packet_MyRank) isPacket_Msg() {}
+
+func (*Packet_ConnectionStats) isPacket_Msg() {}
+
+func (*Packet_MatchSummary) isPacket_Msg() {}
+
+func (*Packet_RequestServerInfo) isPacket_Msg() {}
+
+func (*Packet_ServerInfo) isPacket_Msg() {}
+
+func (*Packet_BatchConsume) isPacket_Msg() {}
+
+func (*Packet_Frozen) isPacket_Msg() {}
+
+func (*Packet_Haptic) isPacket_Msg() {}
+
+func (*Packet_RequestResync) isPacket_Msg() {}
+
+func (*Packet_Resync) isPacket_Msg() {}
+
+func (*Packet_GameOver) isPacket_Msg() {}
+
+func (*Packet_PlayerJoined) isPacket_Msg() {}
+
+func (*Packet_PlayerLeft) isPacket_Msg() {}
+
+func (*Packet_ChangeColor) isPacket_Msg() {}
+
+func (*Packet_Mute) isPacket_Msg() {}
+
+func (*Packet_Unmute) isPacket_Msg() {}
+
 var File_packets_proto protoreflect.FileDescriptor
 
 const file_packets_proto_rawDesc = "" +
@@ -1275,7 +4943,7 @@ const file_packets_proto_rawDesc = "" +
 	"\x05color\x18\x03 \x01(\x05R\x05color\"\x13\n" +
 	"\x11OkResponseMessage\"-\n" +
 	"\x13DenyResponseMessage\x12\x16\n" +
-	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xb1\x01\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xe6\x01\n" +
 	"\rPlayerMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\f\n" +
@@ -1284,20 +4952,31 @@ const file_packets_proto_rawDesc = "" +
 	"\x06radius\x18\x05 \x01(\x01R\x06radius\x12\x1c\n" +
 	"\tdirection\x18\x06 \x01(\x01R\tdirection\x12\x14\n" +
 	"\x05speed\x18\a \x01(\x01R\x05speed\x12\x14\n" +
-	"\x05color\x18\b \x01(\x05R\x05color\"6\n" +
+	"\x05color\x18\b \x01(\x05R\x05color\x12\x17\n" +
+	"\askin_id\x18\t \x01(\rR\x06skinId\x12\x1a\n" +
+	"\bteleport\x18\n" +
+	" \x01(\bR\bteleport\"6\n" +
 	"\x16PlayerDirectionMessage\x12\x1c\n" +
 	"\tdirection\x18\x01 \x01(\x01R\tdirection\"R\n" +
 	"\fSporeMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\f\n" +
 	"\x01x\x18\x02 \x01(\x01R\x01x\x12\f\n" +
 	"\x01y\x18\x03 \x01(\x01R\x01y\x12\x16\n" +
-	"\x06radius\x18\x04 \x01(\x01R\x06radius\"1\n" +
+	"\x06radius\x18\x04 \x01(\x01R\x06radius\"{\n" +
 	"\x14SporeConsumedMessage\x12\x19\n" +
-	"\bspore_id\x18\x01 \x01(\x04R\asporeId\"B\n" +
+	"\bspore_id\x18\x01 \x01(\x04R\asporeId\x124\n" +
+	"\bfeedback\x18\x02 \x01(\x0e2\x18.packets.ConsumeFeedbackR\bfeedback\x12\x12\n" +
+	"\x04mass\x18\x03 \x01(\x01R\x04mass\"|\n" +
+	"\x13BatchConsumeMessage\x12\x1b\n" +
+	"\tspore_ids\x18\x01 \x03(\x04R\bsporeIds\x12\x12\n" +
+	"\x04mass\x18\x02 \x01(\x01R\x04mass\x124\n" +
+	"\bfeedback\x18\x03 \x01(\x0e2\x18.packets.ConsumeFeedbackR\bfeedback\"B\n" +
 	"\x11SporeBatchMessage\x12-\n" +
-	"\x06spores\x18\x01 \x03(\v2\x15.packets.SporeMessageR\x06spores\"4\n" +
+	"\x06spores\x18\x01 \x03(\v2\x15.packets.SporeMessageR\x06spores\"~\n" +
 	"\x15PlayerConsumedMessage\x12\x1b\n" +
-	"\tplayer_id\x18\x01 \x01(\x04R\bplayerId\"\x1c\n" +
+	"\tplayer_id\x18\x01 \x01(\x04R\bplayerId\x124\n" +
+	"\bfeedback\x18\x02 \x01(\x0e2\x18.packets.ConsumeFeedbackR\bfeedback\x12\x12\n" +
+	"\x04mass\x18\x03 \x01(\x01R\x04mass\"\x1c\n" +
 	"\x1aHiscoreBoardRequestMessage\"N\n" +
 	"\x0eHiscoreMessage\x12\x12\n" +
 	"\x04rank\x18\x01 \x01(\x04R\x04rank\x12\x12\n" +
@@ -1307,11 +4986,175 @@ const file_packets_proto_rawDesc = "" +
 	"\bhiscores\x18\x01 \x03(\v2\x17.packets.HiscoreMessageR\bhiscores\"!\n" +
 	"\x1fFinishedBrowsingHiscoresMessage\"*\n" +
 	"\x14SearchHiscoreMessage\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"+\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"`\n" +
 	"\x11DisconnectMessage\x12\x16\n" +
-	"\x06reason\x18\x01 \x01(\tR\x06reason\"\xdd\t\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\x12\x17\n" +
+	"\ain_game\x18\x02 \x01(\bR\x06inGame\x12\f\n" +
+	"\x01x\x18\x03 \x01(\x01R\x01x\x12\f\n" +
+	"\x01y\x18\x04 \x01(\x01R\x01y\"8\n" +
+	"\fEmoteMessage\x12(\n" +
+	"\x05emote\x18\x01 \x01(\x0e2\x12.packets.EmoteTypeR\x05emote\")\n" +
+	"\x13RequestStatsMessage\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"&\n" +
+	"\x10EnterGameMessage\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"*\n" +
+	"\x12WorldBoundsMessage\x12\x14\n" +
+	"\x05bound\x18\x01 \x01(\x01R\x05bound\"\x95\x01\n" +
+	"\x0fKillFeedMessage\x12\x1f\n" +
+	"\vconsumer_id\x18\x01 \x01(\x04R\n" +
+	"consumerId\x12#\n" +
+	"\rconsumer_name\x18\x02 \x01(\tR\fconsumerName\x12\x1b\n" +
+	"\tvictim_id\x18\x03 \x01(\x04R\bvictimId\x12\x1f\n" +
+	"\vvictim_name\x18\x04 \x01(\tR\n" +
+	"victimName\"4\n" +
+	"\x11GameConfigMessage\x12\x1f\n" +
+	"\vworld_bound\x18\x01 \x01(\x01R\n" +
+	"worldBound\"e\n" +
+	"\x17LeaderboardEntryMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04mass\x18\x03 \x01(\x04R\x04mass\x12\x12\n" +
+	"\x04rank\x18\x04 \x01(\rR\x04rank\"P\n" +
+	"\x12LeaderboardMessage\x12:\n" +
+	"\aentries\x18\x01 \x03(\v2 .packets.LeaderboardEntryMessageR\aentries\"v\n" +
+	"\x17LeaderboardDeltaMessage\x12:\n" +
+	"\achanged\x18\x01 \x03(\v2 .packets.LeaderboardEntryMessageR\achanged\x12\x1f\n" +
+	"\vremoved_ids\x18\x02 \x03(\x04R\n" +
+	"removedIds\"R\n" +
+	"\x11ClientInfoMessage\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12#\n" +
+	"\rsupports_acks\x18\x02 \x01(\bR\fsupportsAcks\"\x1e\n" +
+	"\n" +
+	"AckMessage\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x04R\x03seq\"8\n" +
+	"\x15UpdateRequiredMessage\x12\x1f\n" +
+	"\vmin_version\x18\x01 \x01(\tR\n" +
+	"minVersion\"h\n" +
+	"\x14MinimapPlayerMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x15\n" +
+	"\x06cell_x\x18\x02 \x01(\rR\x05cellX\x12\x15\n" +
+	"\x06cell_y\x18\x03 \x01(\rR\x05cellY\x12\x12\n" +
+	"\x04mass\x18\x04 \x01(\x04R\x04mass\"\x8b\x01\n" +
+	"\x0eMinimapMessage\x12\x1b\n" +
+	"\tgrid_size\x18\x01 \x01(\rR\bgridSize\x127\n" +
+	"\aplayers\x18\x02 \x03(\v2\x1d.packets.MinimapPlayerMessageR\aplayers\x12#\n" +
+	"\rspore_density\x18\x03 \x03(\rR\fsporeDensity\"(\n" +
+	"\fErrorMessage\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"%\n" +
+	"\vKickMessage\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\",\n" +
+	"\x10CountdownMessage\x12\x18\n" +
+	"\aseconds\x18\x01 \x01(\rR\aseconds\"G\n" +
+	"\x10ReconnectMessage\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\x04R\bclientId\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"\x88\x02\n" +
+	"\x0fSettingsMessage\x12!\n" +
+	"\fchat_enabled\x18\x01 \x01(\bR\vchatEnabled\x12'\n" +
+	"\x0fminimap_enabled\x18\x02 \x01(\bR\x0eminimapEnabled\x12!\n" +
+	"\fauto_collect\x18\x03 \x01(\bR\vautoCollect\x12\x14\n" +
+	"\x05color\x18\x04 \x01(\x05R\x05color\x12\x17\n" +
+	"\askin_id\x18\x05 \x01(\rR\x06skinId\x12.\n" +
+	"\tself_echo\x18\x06 \x01(\x0e2\x11.packets.SelfEchoR\bselfEcho\x12'\n" +
+	"\x0fhaptics_enabled\x18\a \x01(\bR\x0ehapticsEnabled\"5\n" +
+	"\x16SporesDespawnedMessage\x12\x1b\n" +
+	"\tspore_ids\x18\x01 \x03(\x04R\bsporeIds\"+\n" +
+	"\x10HeartbeatMessage\x12\x17\n" +
+	"\asent_at\x18\x01 \x01(\x03R\x06sentAt\".\n" +
+	"\x0fSpectateMessage\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\x04R\btargetId\".\n" +
+	"\x14SpectateEndedMessage\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\"\x82\x01\n" +
+	"\x17ChatHistoryEntryMessage\x12\x1b\n" +
+	"\tsender_id\x18\x01 \x01(\x04R\bsenderId\x12\x1f\n" +
+	"\vsender_name\x18\x02 \x01(\tR\n" +
+	"senderName\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12\x17\n" +
+	"\asent_at\x18\x04 \x01(\x03R\x06sentAt\"P\n" +
+	"\x12ChatHistoryMessage\x12:\n" +
+	"\aentries\x18\x01 \x03(\v2 .packets.ChatHistoryEntryMessageR\aentries\"5\n" +
+	"\x16PlayerDespawnedMessage\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x04R\bplayerId\"\x0e\n" +
+	"\fEjectMessage\"\xba\x01\n" +
+	"\fEventMessage\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06active\x18\x02 \x01(\bR\x06active\x12!\n" +
+	"\fseconds_left\x18\x03 \x01(\rR\vsecondsLeft\x12'\n" +
+	"\x0fmass_multiplier\x18\x04 \x01(\x01R\x0emassMultiplier\x122\n" +
+	"\x15spore_rate_multiplier\x18\x05 \x01(\x01R\x13sporeRateMultiplier\"@\n" +
+	"\x1aAchievementUnlockedMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"*\n" +
+	"\x10ChatErrorMessage\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\"D\n" +
+	"\rTypingMessage\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x04R\bplayerId\x12\x16\n" +
+	"\x06typing\x18\x02 \x01(\bR\x06typing\"\x10\n" +
+	"\x0eRespawnMessage\"\\\n" +
+	"\rMyRankMessage\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\rR\x04rank\x12#\n" +
+	"\rtotal_players\x18\x02 \x01(\rR\ftotalPlayers\x12\x12\n" +
+	"\x04mass\x18\x03 \x01(\x04R\x04mass\"w\n" +
+	"\x16ConnectionStatsMessage\x12\x17\n" +
+	"\aping_ms\x18\x01 \x01(\rR\x06pingMs\x12\x1b\n" +
+	"\ttick_rate\x18\x02 \x01(\x01R\btickRate\x12'\n" +
+	"\x0fdropped_packets\x18\x03 \x01(\x04R\x0edroppedPackets\"\xb5\x01\n" +
+	"\x13MatchSummaryMessage\x12!\n" +
+	"\fspores_eaten\x18\x01 \x01(\rR\vsporesEaten\x12#\n" +
+	"\rplayers_eaten\x18\x02 \x01(\rR\fplayersEaten\x12\x1f\n" +
+	"\vtimes_eaten\x18\x03 \x01(\rR\n" +
+	"timesEaten\x12\x19\n" +
+	"\bmax_mass\x18\x04 \x01(\x04R\amaxMass\x12\x1a\n" +
+	"\bdistance\x18\x05 \x01(\x04R\bdistance\"\x9c\x01\n" +
+	"\x0fGameOverMessage\x12\x1d\n" +
+	"\n" +
+	"final_mass\x18\x01 \x01(\x04R\tfinalMass\x12\x19\n" +
+	"\bmax_mass\x18\x02 \x01(\x04R\amaxMass\x12\x12\n" +
+	"\x04rank\x18\x03 \x01(\rR\x04rank\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\rR\x05total\x12%\n" +
+	"\x0esession_length\x18\x05 \x01(\x01R\rsessionLength\"\x1a\n" +
+	"\x18RequestServerInfoMessage\"\xda\x01\n" +
+	"\x11ServerInfoMessage\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x18\n" +
+	"\aclients\x18\x03 \x01(\rR\aclients\x12\x18\n" +
+	"\aplayers\x18\x04 \x01(\rR\aplayers\x12\x1f\n" +
+	"\vmax_players\x18\x05 \x01(\rR\n" +
+	"maxPlayers\x12\x1b\n" +
+	"\tgame_mode\x18\x06 \x01(\tR\bgameMode\x12%\n" +
+	"\x0euptime_seconds\x18\a \x01(\x04R\ruptimeSeconds\"'\n" +
+	"\rFrozenMessage\x12\x16\n" +
+	"\x06frozen\x18\x01 \x01(\bR\x06frozen\"V\n" +
+	"\rHapticMessage\x12'\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x13.packets.HapticKindR\x04kind\x12\x1c\n" +
+	"\tintensity\x18\x02 \x01(\x01R\tintensity\"\x16\n" +
+	"\x14RequestResyncMessage\"\xa0\x01\n" +
+	"\rResyncMessage\x12.\n" +
+	"\x06player\x18\x01 \x01(\v2\x16.packets.PlayerMessageR\x06player\x120\n" +
+	"\aplayers\x18\x02 \x03(\v2\x16.packets.PlayerMessageR\aplayers\x12-\n" +
+	"\x06spores\x18\x03 \x03(\v2\x15.packets.SporeMessageR\x06spores\"O\n" +
+	"\x13PlayerJoinedMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\x05R\x05color\"#\n" +
+	"\x11PlayerLeftMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"`\n" +
+	"\x12ChangeColorMessage\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x04R\bplayerId\x12\x14\n" +
+	"\x05color\x18\x02 \x01(\x05R\x05color\x12\x17\n" +
+	"\askin_id\x18\x03 \x01(\rR\x06skinId\"*\n" +
+	"\vMuteMessage\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\x04R\btargetId\",\n" +
+	"\rUnmuteMessage\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\x04R\btargetId\"\x98\x01\n" +
+	"\x12PlayerStatsMessage\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"best_score\x18\x02 \x01(\x04R\tbestScore\x12%\n" +
+	"\x0ematches_played\x18\x03 \x01(\x04R\rmatchesPlayed\x12(\n" +
+	"\x10total_mass_eaten\x18\x04 \x01(\x04R\x0etotalMassEaten\"\xec\x1f\n" +
 	"\x06Packet\x12\x1b\n" +
-	"\tsender_id\x18\x01 \x01(\x04R\bsenderId\x12*\n" +
+	"\tsender_id\x18\x01 \x01(\x04R\bsenderId\x12\x10\n" +
+	"\x03seq\x18d \x01(\x04R\x03seq\x12*\n" +
 	"\x04chat\x18\x02 \x01(\v2\x14.packets.ChatMessageH\x00R\x04chat\x12$\n" +
 	"\x02id\x18\x03 \x01(\v2\x12.packets.IdMessageH\x00R\x02id\x12C\n" +
 	"\rlogin_request\x18\x04 \x01(\v2\x1c.packets.LoginRequestMessageH\x00R\floginRequest\x12L\n" +
@@ -1333,8 +5176,81 @@ const file_packets_proto_rawDesc = "" +
 	"\x0esearch_hiscore\x18\x12 \x01(\v2\x1d.packets.SearchHiscoreMessageH\x00R\rsearchHiscore\x12<\n" +
 	"\n" +
 	"disconnect\x18\x13 \x01(\v2\x1a.packets.DisconnectMessageH\x00R\n" +
-	"disconnectB\x05\n" +
-	"\x03msgB\rZ\vpkg/packetsb\x06proto3"
+	"disconnect\x12-\n" +
+	"\x05emote\x18\x14 \x01(\v2\x15.packets.EmoteMessageH\x00R\x05emote\x12C\n" +
+	"\rrequest_stats\x18\x15 \x01(\v2\x1c.packets.RequestStatsMessageH\x00R\frequestStats\x12@\n" +
+	"\fplayer_stats\x18\x16 \x01(\v2\x1b.packets.PlayerStatsMessageH\x00R\vplayerStats\x12:\n" +
+	"\n" +
+	"enter_game\x18\x17 \x01(\v2\x19.packets.EnterGameMessageH\x00R\tenterGame\x12@\n" +
+	"\fworld_bounds\x18\x18 \x01(\v2\x1b.packets.WorldBoundsMessageH\x00R\vworldBounds\x127\n" +
+	"\tkill_feed\x18\x19 \x01(\v2\x18.packets.KillFeedMessageH\x00R\bkillFeed\x12*\n" +
+	"\x04kick\x18\x1a \x01(\v2\x14.packets.KickMessageH\x00R\x04kick\x12=\n" +
+	"\vgame_config\x18\x1b \x01(\v2\x1a.packets.GameConfigMessageH\x00R\n" +
+	"gameConfig\x12?\n" +
+	"\vleaderboard\x18\x1c \x01(\v2\x1b.packets.LeaderboardMessageH\x00R\vleaderboard\x12=\n" +
+	"\vclient_info\x18\x1d \x01(\v2\x1a.packets.ClientInfoMessageH\x00R\n" +
+	"clientInfo\x12I\n" +
+	"\x0fupdate_required\x18\x1e \x01(\v2\x1e.packets.UpdateRequiredMessageH\x00R\x0eupdateRequired\x12-\n" +
+	"\x05error\x18\x1f \x01(\v2\x15.packets.ErrorMessageH\x00R\x05error\x123\n" +
+	"\aminimap\x18  \x01(\v2\x17.packets.MinimapMessageH\x00R\aminimap\x12'\n" +
+	"\x03ack\x18! \x01(\v2\x13.packets.AckMessageH\x00R\x03ack\x129\n" +
+	"\tcountdown\x18\" \x01(\v2\x19.packets.CountdownMessageH\x00R\tcountdown\x129\n" +
+	"\treconnect\x18# \x01(\v2\x19.packets.ReconnectMessageH\x00R\treconnect\x126\n" +
+	"\bsettings\x18$ \x01(\v2\x18.packets.SettingsMessageH\x00R\bsettings\x12L\n" +
+	"\x10spores_despawned\x18% \x01(\v2\x1f.packets.SporesDespawnedMessageH\x00R\x0fsporesDespawned\x129\n" +
+	"\theartbeat\x18& \x01(\v2\x19.packets.HeartbeatMessageH\x00R\theartbeat\x126\n" +
+	"\bspectate\x18' \x01(\v2\x18.packets.SpectateMessageH\x00R\bspectate\x12F\n" +
+	"\x0espectate_ended\x18( \x01(\v2\x1d.packets.SpectateEndedMessageH\x00R\rspectateEnded\x12@\n" +
+	"\fchat_history\x18) \x01(\v2\x1b.packets.ChatHistoryMessageH\x00R\vchatHistory\x12L\n" +
+	"\x10player_despawned\x18* \x01(\v2\x1f.packets.PlayerDespawnedMessageH\x00R\x0fplayerDespawned\x12-\n" +
+	"\x05eject\x18+ \x01(\v2\x15.packets.EjectMessageH\x00R\x05eject\x12O\n" +
+	"\x11leaderboard_delta\x18, \x01(\v2 .packets.LeaderboardDeltaMessageH\x00R\x10leaderboardDelta\x12-\n" +
+	"\x05event\x18- \x01(\v2\x15.packets.EventMessageH\x00R\x05event\x12X\n" +
+	"\x14achievement_unlocked\x18. \x01(\v2#.packets.AchievementUnlockedMessageH\x00R\x13achievementUnlocked\x12:\n" +
+	"\n" +
+	"chat_error\x18/ \x01(\v2\x19.packets.ChatErrorMessageH\x00R\tchatError\x120\n" +
+	"\x06typing\x180 \x01(\v2\x16.packets.TypingMessageH\x00R\x06typing\x123\n" +
+	"\arespawn\x181 \x01(\v2\x17.packets.RespawnMessageH\x00R\arespawn\x121\n" +
+	"\amy_rank\x182 \x01(\v2\x16.packets.MyRankMessageH\x00R\x06myRank\x12L\n" +
+	"\x10connection_stats\x183 \x01(\v2\x1f.packets.ConnectionStatsMessageH\x00R\x0fconnectionStats\x12C\n" +
+	"\rmatch_summary\x184 \x01(\v2\x1c.packets.MatchSummaryMessageH\x00R\fmatchSummary\x12S\n" +
+	"\x13request_server_info\x185 \x01(\v2!.packets.RequestServerInfoMessageH\x00R\x11requestServerInfo\x12=\n" +
+	"\vserver_info\x186 \x01(\v2\x1a.packets.ServerInfoMessageH\x00R\n" +
+	"serverInfo\x12C\n" +
+	"\rbatch_consume\x187 \x01(\v2\x1c.packets.BatchConsumeMessageH\x00R\fbatchConsume\x120\n" +
+	"\x06frozen\x188 \x01(\v2\x16.packets.FrozenMessageH\x00R\x06frozen\x120\n" +
+	"\x06haptic\x189 \x01(\v2\x16.packets.HapticMessageH\x00R\x06haptic\x12F\n" +
+	"\x0erequest_resync\x18: \x01(\v2\x1d.packets.RequestResyncMessageH\x00R\rrequestResync\x120\n" +
+	"\x06resync\x18; \x01(\v2\x16.packets.ResyncMessageH\x00R\x06resync\x127\n" +
+	"\tgame_over\x18< \x01(\v2\x18.packets.GameOverMessageH\x00R\bgameOver\x12C\n" +
+	"\rplayer_joined\x18= \x01(\v2\x1c.packets.PlayerJoinedMessageH\x00R\fplayerJoined\x12=\n" +
+	"\vplayer_left\x18> \x01(\v2\x1a.packets.PlayerLeftMessageH\x00R\n" +
+	"playerLeft\x12@\n" +
+	"\fchange_color\x18? \x01(\v2\x1b.packets.ChangeColorMessageH\x00R\vchangeColor\x12*\n" +
+	"\x04mute\x18@ \x01(\v2\x14.packets.MuteMessageH\x00R\x04mute\x120\n" +
+	"\x06unmute\x18A \x01(\v2\x16.packets.UnmuteMessageH\x00R\x06unmuteB\x05\n" +
+	"\x03msg*\xb2\x01\n" +
+	"\x0fConsumeFeedback\x12\x19\n" +
+	"\x15CONSUME_FEEDBACK_NONE\x10\x00\x12 \n" +
+	"\x1cCONSUME_FEEDBACK_SMALL_SPORE\x10\x01\x12\x1e\n" +
+	"\x1aCONSUME_FEEDBACK_BIG_SPORE\x10\x02\x12!\n" +
+	"\x1dCONSUME_FEEDBACK_SMALL_PLAYER\x10\x03\x12\x1f\n" +
+	"\x1bCONSUME_FEEDBACK_BIG_PLAYER\x10\x04*Z\n" +
+	"\tEmoteType\x12\x0e\n" +
+	"\n" +
+	"EMOTE_WAVE\x10\x00\x12\x0f\n" +
+	"\vEMOTE_LAUGH\x10\x01\x12\x0f\n" +
+	"\vEMOTE_ANGRY\x10\x02\x12\r\n" +
+	"\tEMOTE_SAD\x10\x03\x12\f\n" +
+	"\bEMOTE_GG\x10\x04*P\n" +
+	"\bSelfEcho\x12\x14\n" +
+	"\x10SELF_ECHO_ALWAYS\x10\x00\x12\x11\n" +
+	"\rSELF_ECHO_OFF\x10\x01\x12\x1b\n" +
+	"\x17SELF_ECHO_ON_DIVERGENCE\x10\x02*5\n" +
+	"\n" +
+	"HapticKind\x12\x15\n" +
+	"\x11HAPTIC_ATE_PLAYER\x10\x00\x12\x10\n" +
+	"\fHAPTIC_EATEN\x10\x01B\rZ\vpkg/packetsb\x06proto3"
 
 var (
 	file_packets_proto_rawDescOnce sync.Once
@@ -1348,54 +5264,167 @@ func file_packets_proto_rawDescGZIP() []byte {
 	return file_packets_proto_rawDescData
 }
 
-var file_packets_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_packets_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_packets_proto_msgTypes = make([]protoimpl.MessageInfo, 68)
 var file_packets_proto_goTypes = []any{
-	(*ChatMessage)(nil),                     // 0: packets.ChatMessage
-	(*IdMessage)(nil),                       // 1: packets.IdMessage
-	(*LoginRequestMessage)(nil),             // 2: packets.LoginRequestMessage
-	(*RegisterRequestMessage)(nil),          // 3: packets.RegisterRequestMessage
-	(*OkResponseMessage)(nil),               // 4: packets.OkResponseMessage
-	(*DenyResponseMessage)(nil),             // 5: packets.DenyResponseMessage
-	(*PlayerMessage)(nil),                   // 6: packets.PlayerMessage
-	(*PlayerDirectionMessage)(nil),          // 7: packets.PlayerDirectionMessage
-	(*SporeMessage)(nil),                    // 8: packets.SporeMessage
-	(*SporeConsumedMessage)(nil),            // 9: packets.SporeConsumedMessage
-	(*SporeBatchMessage)(nil),               // 10: packets.SporeBatchMessage
-	(*PlayerConsumedMessage)(nil),           // 11: packets.PlayerConsumedMessage
-	(*HiscoreBoardRequestMessage)(nil),      // 12: packets.HiscoreBoardRequestMessage
-	(*HiscoreMessage)(nil),                  // 13: packets.HiscoreMessage
-	(*HiscoreBoardMessage)(nil),             // 14: packets.HiscoreBoardMessage
-	(*FinishedBrowsingHiscoresMessage)(nil), // 15: packets.FinishedBrowsingHiscoresMessage
-	(*SearchHiscoreMessage)(nil),            // 16: packets.SearchHiscoreMessage
-	(*DisconnectMessage)(nil),               // 17: packets.DisconnectMessage
-	(*Packet)(nil),                          // 18: packets.Packet
+	(ConsumeFeedback)(0),                    // 0: packets.ConsumeFeedback
+	(EmoteType)(0),                          // 1: packets.EmoteType
+	(SelfEcho)(0),                           // 2: packets.SelfEcho
+	(HapticKind)(0),                         // 3: packets.HapticKind
+	(*ChatMessage)(nil),                     // 4: packets.ChatMessage
+	(*IdMessage)(nil),                       // 5: packets.IdMessage
+	(*LoginRequestMessage)(nil),             // 6: packets.LoginRequestMessage
+	(*RegisterRequestMessage)(nil),          // 7: packets.RegisterRequestMessage
+	(*OkResponseMessage)(nil),               // 8: packets.OkResponseMessage
+	(*DenyResponseMessage)(nil),             // 9: packets.DenyResponseMessage
+	(*PlayerMessage)(nil),                   // 10: packets.PlayerMessage
+	(*PlayerDirectionMessage)(nil),          // 11: packets.PlayerDirectionMessage
+	(*SporeMessage)(nil),                    // 12: packets.SporeMessage
+	(*SporeConsumedMessage)(nil),            // 13: packets.SporeConsumedMessage
+	(*BatchConsumeMessage)(nil),             // 14: packets.BatchConsumeMessage
+	(*SporeBatchMessage)(nil),               // 15: packets.SporeBatchMessage
+	(*PlayerConsumedMessage)(nil),           // 16: packets.PlayerConsumedMessage
+	(*HiscoreBoardRequestMessage)(nil),      // 17: packets.HiscoreBoardRequestMessage
+	(*HiscoreMessage)(nil),                  // 18: packets.HiscoreMessage
+	(*HiscoreBoardMessage)(nil),             // 19: packets.HiscoreBoardMessage
+	(*FinishedBrowsingHiscoresMessage)(nil), // 20: packets.FinishedBrowsingHiscoresMessage
+	(*SearchHiscoreMessage)(nil),            // 21: packets.SearchHiscoreMessage
+	(*DisconnectMessage)(nil),               // 22: packets.DisconnectMessage
+	(*EmoteMessage)(nil),                    // 23: packets.EmoteMessage
+	(*RequestStatsMessage)(nil),             // 24: packets.RequestStatsMessage
+	(*EnterGameMessage)(nil),                // 25: packets.EnterGameMessage
+	(*WorldBoundsMessage)(nil),              // 26: packets.WorldBoundsMessage
+	(*KillFeedMessage)(nil),                 // 27: packets.KillFeedMessage
+	(*GameConfigMessage)(nil),               // 28: packets.GameConfigMessage
+	(*LeaderboardEntryMessage)(nil),         // 29: packets.LeaderboardEntryMessage
+	(*LeaderboardMessage)(nil),              // 30: packets.LeaderboardMessage
+	(*LeaderboardDeltaMessage)(nil),         // 31: packets.LeaderboardDeltaMessage
+	(*ClientInfoMessage)(nil),               // 32: packets.ClientInfoMessage
+	(*AckMessage)(nil),                      // 33: packets.AckMessage
+	(*UpdateRequiredMessage)(nil),           // 34: packets.UpdateRequiredMessage
+	(*MinimapPlayerMessage)(nil),            // 35: packets.MinimapPlayerMessage
+	(*MinimapMessage)(nil),                  // 36: packets.MinimapMessage
+	(*ErrorMessage)(nil),                    // 37: packets.ErrorMessage
+	(*KickMessage)(nil),                     // 38: packets.KickMessage
+	(*CountdownMessage)(nil),                // 39: packets.CountdownMessage
+	(*ReconnectMessage)(nil),                // 40: packets.ReconnectMessage
+	(*SettingsMessage)(nil),                 // 41: packets.SettingsMessage
+	(*SporesDespawnedMessage)(nil),          // 42: packets.SporesDespawnedMessage
+	(*HeartbeatMessage)(nil),                // 43: packets.HeartbeatMessage
+	(*SpectateMessage)(nil),                 // 44: packets.SpectateMessage
+	(*SpectateEndedMessage)(nil),            // 45: packets.SpectateEndedMessage
+	(*ChatHistoryEntryMessage)(nil),         // 46: packets.ChatHistoryEntryMessage
+	(*ChatHistoryMessage)(nil),              // 47: packets.ChatHistoryMessage
+	(*PlayerDespawnedMessage)(nil),          // 48: packets.PlayerDespawnedMessage
+	(*EjectMessage)(nil),                    // 49: packets.EjectMessage
+	(*EventMessage)(nil),                    // 50: packets.EventMessage
+	(*AchievementUnlockedMessage)(nil),      // 51: packets.AchievementUnlockedMessage
+	(*ChatErrorMessage)(nil),                // 52: packets.ChatErrorMessage
+	(*TypingMessage)(nil),                   // 53: packets.TypingMessage
+	(*RespawnMessage)(nil),                  // 54: packets.RespawnMessage
+	(*MyRankMessage)(nil),                   // 55: packets.MyRankMessage
+	(*ConnectionStatsMessage)(nil),          // 56: packets.ConnectionStatsMessage
+	(*MatchSummaryMessage)(nil),             // 57: packets.MatchSummaryMessage
+	(*GameOverMessage)(nil),                 // 58: packets.GameOverMessage
+	(*RequestServerInfoMessage)(nil),        // 59: packets.RequestServerInfoMessage
+	(*ServerInfoMessage)(nil),               // 60: packets.ServerInfoMessage
+	(*FrozenMessage)(nil),                   // 61: packets.FrozenMessage
+	(*HapticMessage)(nil),                   // 62: packets.HapticMessage
+	(*RequestResyncMessage)(nil),            // 63: packets.RequestResyncMessage
+	(*ResyncMessage)(nil),                   // 64: packets.ResyncMessage
+	(*PlayerJoinedMessage)(nil),             // 65: packets.PlayerJoinedMessage
+	(*PlayerLeftMessage)(nil),               // 66: packets.PlayerLeftMessage
+	(*ChangeColorMessage)(nil),              // 67: packets.ChangeColorMessage
+	(*MuteMessage)(nil),                     // 68: packets.MuteMessage
+	(*UnmuteMessage)(nil),                   // 69: packets.UnmuteMessage
+	(*PlayerStatsMessage)(nil),              // 70: packets.PlayerStatsMessage
+	(*Packet)(nil),                          // 71: packets.Packet
 }
 var file_packets_proto_depIdxs = []int32{
-	8,  // 0: packets.SporeBatchMessage.spores:type_name -> packets.SporeMessage
-	13, // 1: packets.HiscoreBoardMessage.hiscores:type_name -> packets.HiscoreMessage
-	0,  // 2: packets.Packet.chat:type_name -> packets.ChatMessage
-	1,  // 3: packets.Packet.id:type_name -> packets.IdMessage
-	2,  // 4: packets.Packet.login_request:type_name -> packets.LoginRequestMessage
-	3,  // 5: packets.Packet.register_request:type_name -> packets.RegisterRequestMessage
-	4,  // 6: packets.Packet.ok_response:type_name -> packets.OkResponseMessage
-	5,  // 7: packets.Packet.deny_response:type_name -> packets.DenyResponseMessage
-	6,  // 8: packets.Packet.player:type_name -> packets.PlayerMessage
-	7,  // 9: packets.Packet.player_direction:type_name -> packets.PlayerDirectionMessage
-	8,  // 10: packets.Packet.spore:type_name -> packets.SporeMessage
-	9,  // 11: packets.Packet.spore_consumed:type_name -> packets.SporeConsumedMessage
-	10, // 12: packets.Packet.spores_batch:type_name -> packets.SporeBatchMessage
-	11, // 13: packets.Packet.player_consumed:type_name -> packets.PlayerConsumedMessage
-	12, // 14: packets.Packet.hiscore_board_request:type_name -> packets.HiscoreBoardRequestMessage
-	13, // 15: packets.Packet.hiscore:type_name -> packets.HiscoreMessage
-	14, // 16: packets.Packet.hiscore_board:type_name -> packets.HiscoreBoardMessage
-	15, // 17: packets.Packet.finished_browsing_hiscores:type_name -> packets.FinishedBrowsingHiscoresMessage
-	16, // 18: packets.Packet.search_hiscore:type_name -> packets.SearchHiscoreMessage
-	17, // 19: packets.Packet.disconnect:type_name -> packets.DisconnectMessage
-	20, // [20:20] is the sub-list for method output_type
-	20, // [20:20] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	0,  // 0: packets.SporeConsumedMessage.feedback:type_name -> packets.ConsumeFeedback
+	0,  // 1: packets.BatchConsumeMessage.feedback:type_name -> packets.ConsumeFeedback
+	12, // 2: packets.SporeBatchMessage.spores:type_name -> packets.SporeMessage
+	0,  // 3: packets.PlayerConsumedMessage.feedback:type_name -> packets.ConsumeFeedback
+	18, // 4: packets.HiscoreBoardMessage.hiscores:type_name -> packets.HiscoreMessage
+	1,  // 5: packets.EmoteMessage.emote:type_name -> packets.EmoteType
+	29, // 6: packets.LeaderboardMessage.entries:type_name -> packets.LeaderboardEntryMessage
+	29, // 7: packets.LeaderboardDeltaMessage.changed:type_name -> packets.LeaderboardEntryMessage
+	35, // 8: packets.MinimapMessage.players:type_name -> packets.MinimapPlayerMessage
+	2,  // 9: packets.SettingsMessage.self_echo:type_name -> packets.SelfEcho
+	46, // 10: packets.ChatHistoryMessage.entries:type_name -> packets.ChatHistoryEntryMessage
+	3,  // 11: packets.HapticMessage.kind:type_name -> packets.HapticKind
+	10, // 12: packets.ResyncMessage.player:type_name -> packets.PlayerMessage
+	10, // 13: packets.ResyncMessage.players:type_name -> packets.PlayerMessage
+	12, // 14: packets.ResyncMessage.spores:type_name -> packets.SporeMessage
+	4,  // 15: packets.Packet.chat:type_name -> packets.ChatMessage
+	5,  // 16: packets.Packet.id:type_name -> packets.IdMessage
+	6,  // 17: packets.Packet.login_request:type_name -> packets.LoginRequestMessage
+	7,  // 18: packets.Packet.register_request:type_name -> packets.RegisterRequestMessage
+	8,  // 19: packets.Packet.ok_response:type_name -> packets.OkResponseMessage
+	9,  // 20: packets.Packet.deny_response:type_name -> packets.DenyResponseMessage
+	10, // 21: packets.Packet.player:type_name -> packets.PlayerMessage
+	11, // 22: packets.Packet.player_direction:type_name -> packets.PlayerDirectionMessage
+	12, // 23: packets.Packet.spore:type_name -> packets.SporeMessage
+	13, // 24: packets.Packet.spore_consumed:type_name -> packets.SporeConsumedMessage
+	15, // 25: packets.Packet.spores_batch:type_name -> packets.SporeBatchMessage
+	16, // 26: packets.Packet.player_consumed:type_name -> packets.PlayerConsumedMessage
+	17, // 27: packets.Packet.hiscore_board_request:type_name -> packets.HiscoreBoardRequestMessage
+	18, // 28: packets.Packet.hiscore:type_name -> packets.HiscoreMessage
+	19, // 29: packets.Packet.hiscore_board:type_name -> packets.HiscoreBoardMessage
+	20, // 30: packets.Packet.finished_browsing_hiscores:type_name -> packets.FinishedBrowsingHiscoresMessage
+	21, // 31: packets.Packet.search_hiscore:type_name -> packets.SearchHiscoreMessage
+	22, // 32: packets.Packet.disconnect:type_name -> packets.DisconnectMessage
+	23, // 33: packets.Packet.emote:type_name -> packets.EmoteMessage
+	24, // 34: packets.Packet.request_stats:type_name -> packets.RequestStatsMessage
+	70, // 35: packets.Packet.player_stats:type_name -> packets.PlayerStatsMessage
+	25, // 36: packets.Packet.enter_game:type_name -> packets.EnterGameMessage
+	26, // 37: packets.Packet.world_bounds:type_name -> packets.WorldBoundsMessage
+	27, // 38: packets.Packet.kill_feed:type_name -> packets.KillFeedMessage
+	38, // 39: packets.Packet.kick:type_name -> packets.KickMessage
+	28, // 40: packets.Packet.game_config:type_name -> packets.GameConfigMessage
+	30, // 41: packets.Packet.leaderboard:type_name -> packets.LeaderboardMessage
+	32, // 42: packets.Packet.client_info:type_name -> packets.ClientInfoMessage
+	34, // 43: packets.Packet.update_required:type_name -> packets.UpdateRequiredMessage
+	37, // 44: packets.Packet.error:type_name -> packets.ErrorMessage
+	36, // 45: packets.Packet.minimap:type_name -> packets.MinimapMessage
+	33, // 46: packets.Packet.ack:type_name -> packets.AckMessage
+	39, // 47: packets.Packet.countdown:type_name -> packets.CountdownMessage
+	40, // 48: packets.Packet.reconnect:type_name -> packets.ReconnectMessage
+	41, // 49: packets.Packet.settings:type_name -> packets.SettingsMessage
+	42, // 50: packets.Packet.spores_despawned:type_name -> packets.SporesDespawnedMessage
+	43, // 51: packets.Packet.heartbeat:type_name -> packets.HeartbeatMessage
+	44, // 52: packets.Packet.spectate:type_name -> packets.SpectateMessage
+	45, // 53: packets.Packet.spectate_ended:type_name -> packets.SpectateEndedMessage
+	47, // 54: packets.Packet.chat_history:type_name -> packets.ChatHistoryMessage
+	48, // 55: packets.Packet.player_despawned:type_name -> packets.PlayerDespawnedMessage
+	49, // 56: packets.Packet.eject:type_name -> packets.EjectMessage
+	31, // 57: packets.Packet.leaderboard_delta:type_name -> packets.LeaderboardDeltaMessage
+	50, // 58: packets.Packet.event:type_name -> packets.EventMessage
+	51, // 59: packets.Packet.achievement_unlocked:type_name -> packets.AchievementUnlockedMessage
+	52, // 60: packets.Packet.chat_error:type_name -> packets.ChatErrorMessage
+	53, // 61: packets.Packet.typing:type_name -> packets.TypingMessage
+	54, // 62: packets.Packet.respawn:type_name -> packets.RespawnMessage
+	55, // 63: packets.Packet.my_rank:type_name -> packets.MyRankMessage
+	56, // 64: packets.Packet.connection_stats:type_name -> packets.ConnectionStatsMessage
+	57, // 65: packets.Packet.match_summary:type_name -> packets.MatchSummaryMessage
+	59, // 66: packets.Packet.request_server_info:type_name -> packets.RequestServerInfoMessage
+	60, // 67: packets.Packet.server_info:type_name -> packets.ServerInfoMessage
+	14, // 68: packets.Packet.batch_consume:type_name -> packets.BatchConsumeMessage
+	61, // 69: packets.Packet.frozen:type_name -> packets.FrozenMessage
+	62, // 70: packets.Packet.haptic:type_name -> packets.HapticMessage
+	63, // 71: packets.Packet.request_resync:type_name -> packets.RequestResyncMessage
+	64, // 72: packets.Packet.resync:type_name -> packets.ResyncMessage
+	58, // 73: packets.Packet.game_over:type_name -> packets.GameOverMessage
+	65, // 74: packets.Packet.player_joined:type_name -> packets.PlayerJoinedMessage
+	66, // 75: packets.Packet.player_left:type_name -> packets.PlayerLeftMessage
+	67, // 76: packets.Packet.change_color:type_name -> packets.ChangeColorMessage
+	68, // 77: packets.Packet.mute:type_name -> packets.MuteMessage
+	69, // 78: packets.Packet.unmute:type_name -> packets.UnmuteMessage
+	79, // [79:79] is the sub-list for method output_type
+	79, // [79:79] is the sub-list for method input_type
+	79, // [79:79] is the sub-list for extension type_name
+	79, // [79:79] is the sub-list for extension extendee
+	0,  // [0:79] is the sub-list for field type_name
 }
 
 func init() { file_packets_proto_init() }
@@ -1403,7 +5432,7 @@ func file_packets_proto_init() {
 	if File_packets_proto != nil {
 		return
 	}
-	file_packets_proto_msgTypes[18].OneofWrappers = []any{
+	file_packets_proto_msgTypes[67].OneofWrappers = []any{
 		(*Packet_Chat)(nil),
 		(*Packet_Id)(nil),
 		(*Packet_LoginRequest)(nil),
@@ -1422,19 +5451,66 @@ func file_packets_proto_init() {
 		(*Packet_FinishedBrowsingHiscores)(nil),
 		(*Packet_SearchHiscore)(nil),
 		(*Packet_Disconnect)(nil),
+		(*Packet_Emote)(nil),
+		(*Packet_RequestStats)(nil),
+		(*Packet_PlayerStats)(nil),
+		(*Packet_EnterGame)(nil),
+		(*Packet_WorldBounds)(nil),
+		(*Packet_KillFeed)(nil),
+		(*Packet_Kick)(nil),
+		(*Packet_GameConfig)(nil),
+		(*Packet_Leaderboard)(nil),
+		(*Packet_ClientInfo)(nil),
+		(*Packet_UpdateRequired)(nil),
+		(*Packet_Error)(nil),
+		(*Packet_Minimap)(nil),
+		(*Packet_Ack)(nil),
+		(*Packet_Countdown)(nil),
+		(*Packet_Reconnect)(nil),
+		(*Packet_Settings)(nil),
+		(*Packet_SporesDespawned)(nil),
+		(*Packet_Heartbeat)(nil),
+		(*Packet_Spectate)(nil),
+		(*Packet_SpectateEnded)(nil),
+		(*Packet_ChatHistory)(nil),
+		(*Packet_PlayerDespawned)(nil),
+		(*Packet_Eject)(nil),
+		(*Packet_LeaderboardDelta)(nil),
+		(*Packet_Event)(nil),
+		(*Packet_AchievementUnlocked)(nil),
+		(*Packet_ChatError)(nil),
+		(*Packet_Typing)(nil),
+		(*Packet_Respawn)(nil),
+		(*Packet_MyRank)(nil),
+		(*Packet_ConnectionStats)(nil),
+		(*Packet_MatchSummary)(nil),
+		(*Packet_RequestServerInfo)(nil),
+		(*Packet_ServerInfo)(nil),
+		(*Packet_BatchConsume)(nil),
+		(*Packet_Frozen)(nil),
+		(*Packet_Haptic)(nil),
+		(*Packet_RequestResync)(nil),
+		(*Packet_Resync)(nil),
+		(*Packet_GameOver)(nil),
+		(*Packet_PlayerJoined)(nil),
+		(*Packet_PlayerLeft)(nil),
+		(*Packet_ChangeColor)(nil),
+		(*Packet_Mute)(nil),
+		(*Packet_Unmute)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_packets_proto_rawDesc), len(file_packets_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   19,
+			NumEnums:      4,
+			NumMessages:   68,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_packets_proto_goTypes,
 		DependencyIndexes: file_packets_proto_depIdxs,
+		EnumInfos:         file_packets_proto_enumTypes,
 		MessageInfos:      file_packets_proto_msgTypes,
 	}.Build()
 	File_packets_proto = out.File
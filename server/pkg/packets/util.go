@@ -1,9 +1,26 @@
 package packets
 
-import "server/internal/server/objects"
+import (
+	"log"
+	"math"
+	"server/internal/server/objects"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
 
 type Msg = isPacket_Msg
 
+// Every position that goes out to the clients passes through here
+// Positions are always world coordinates (never relative to a client's screen), so anything
+// outside the world bound means something went wrong on our side and gets logged
+func worldCoords(kind string, id uint64, x, y float64, bound *objects.WorldBound) (float64, float64) {
+	if bound != nil && !bound.Contains(x, y) {
+		log.Printf("Sending %s %d at (%f, %f) which is outside the world bound of %f", kind, id, x, y, bound.Get())
+	}
+	return x, y
+}
+
 func NewChat(msg string) Msg {
 	return &Packet_Chat{
 		Chat: &ChatMessage{
@@ -34,40 +51,56 @@ func NewDenyResponse(reason string) Msg {
 	}
 }
 
-func NewPlayer(id uint64, player *objects.Player) Msg {
+func NewPlayer(id uint64, player *objects.Player, bound *objects.WorldBound) Msg {
 	return &Packet_Player{
-		Player: &PlayerMessage{
-			Id:        id,
-			Name:      player.Name,
-			X:         player.X,
-			Y:         player.Y,
-			Radius:    player.Radius,
-			Direction: player.Direction,
-			Speed:     player.Speed,
-			Color:     player.Color,
-		},
+		Player: newPlayerMessage(id, player, bound),
+	}
+}
+
+// Same as NewPlayer, but flagged so clients jump the player there instead of moving it smoothly
+func NewTeleport(id uint64, player *objects.Player, bound *objects.WorldBound) Msg {
+	message := newPlayerMessage(id, player, bound)
+	message.Teleport = true
+	return &Packet_Player{
+		Player: message,
+	}
+}
+
+func newPlayerMessage(id uint64, player *objects.Player, bound *objects.WorldBound) *PlayerMessage {
+	x, y := worldCoords("player", id, player.X, player.Y, bound)
+	return &PlayerMessage{
+		Id:        id,
+		Name:      player.Name,
+		X:         x,
+		Y:         y,
+		Radius:    player.Radius,
+		Direction: player.Direction,
+		Speed:     player.Speed,
+		Color:     player.Color,
+		SkinId:    player.SkinId,
 	}
 }
 
-func newSporeMessage(spore_id uint64, spore *objects.Spore) *SporeMessage {
+func newSporeMessage(spore_id uint64, spore *objects.Spore, bound *objects.WorldBound) *SporeMessage {
+	x, y := worldCoords("spore", spore_id, spore.X, spore.Y, bound)
 	return &SporeMessage{
 		Id:     spore_id,
-		X:      spore.X,
-		Y:      spore.Y,
+		X:      x,
+		Y:      y,
 		Radius: spore.Radius,
 	}
 }
 
-func NewSpore(id uint64, spore *objects.Spore) Msg {
+func NewSpore(id uint64, spore *objects.Spore, bound *objects.WorldBound) Msg {
 	return &Packet_Spore{
-		newSporeMessage(id, spore),
+		newSporeMessage(id, spore, bound),
 	}
 }
 
-func NewSporeBatch(spores map[uint64]*objects.Spore) Msg {
+func NewSporeBatch(spores map[uint64]*objects.Spore, bound *objects.WorldBound) Msg {
 	sporesMessages := make([]*SporeMessage, len(spores))
 	for id, spore := range spores {
-		sporesMessages = append(sporesMessages, newSporeMessage(id, spore))
+		sporesMessages = append(sporesMessages, newSporeMessage(id, spore, bound))
 	}
 
 	return &Packet_SporesBatch{
@@ -77,6 +110,11 @@ func NewSporeBatch(spores map[uint64]*objects.Spore) Msg {
 	}
 }
 
+// Function to get how many bytes a message takes once it's encoded in a packet
+func Size(msg Msg) int {
+	return proto.Size(&Packet{Msg: msg})
+}
+
 func NewHiscoreBoard(hiscores []*HiscoreMessage) Msg {
 	return &Packet_HiscoreBoard{
 		HiscoreBoard: &HiscoreBoardMessage{
@@ -92,3 +130,427 @@ func NewDisconnect(reason string) Msg {
 		},
 	}
 }
+
+// Disconnect of a client that had a player in the game, so others can tell how far away it left
+func NewPlayerDisconnect(reason string, playerId uint64, player *objects.Player, bound *objects.WorldBound) Msg {
+	x, y := worldCoords("player", playerId, player.X, player.Y, bound)
+	return &Packet_Disconnect{
+		Disconnect: &DisconnectMessage{
+			Reason: reason,
+			InGame: true,
+			X:      x,
+			Y:      y,
+		},
+	}
+}
+
+func NewPlayerDespawned(playerId uint64) Msg {
+	return &Packet_PlayerDespawned{
+		PlayerDespawned: &PlayerDespawnedMessage{
+			PlayerId: playerId,
+		},
+	}
+}
+
+func NewEmote(emote EmoteType) Msg {
+	return &Packet_Emote{
+		Emote: &EmoteMessage{
+			Emote: emote,
+		},
+	}
+}
+
+func NewPlayerStats(name string, bestScore, matchesPlayed, totalMassEaten int64) Msg {
+	return &Packet_PlayerStats{
+		PlayerStats: &PlayerStatsMessage{
+			Name:           name,
+			BestScore:      uint64(bestScore),
+			MatchesPlayed:  uint64(matchesPlayed),
+			TotalMassEaten: uint64(totalMassEaten),
+		},
+	}
+}
+
+func NewEnterGame(name string) Msg {
+	return &Packet_EnterGame{
+		EnterGame: &EnterGameMessage{
+			Name: name,
+		},
+	}
+}
+
+func NewWorldBounds(bound float64) Msg {
+	return &Packet_WorldBounds{
+		WorldBounds: &WorldBoundsMessage{
+			Bound: bound,
+		},
+	}
+}
+
+func NewKillFeed(consumerId uint64, consumerName string, victimId uint64, victimName string) Msg {
+	return &Packet_KillFeed{
+		KillFeed: &KillFeedMessage{
+			ConsumerId:   consumerId,
+			ConsumerName: consumerName,
+			VictimId:     victimId,
+			VictimName:   victimName,
+		},
+	}
+}
+
+func NewKick(reason string) Msg {
+	return &Packet_Kick{
+		Kick: &KickMessage{
+			Reason: reason,
+		},
+	}
+}
+
+func NewCountdown(seconds uint32) Msg {
+	return &Packet_Countdown{
+		Countdown: &CountdownMessage{
+			Seconds: seconds,
+		},
+	}
+}
+
+func NewReconnect(clientId uint64, secret string) Msg {
+	return &Packet_Reconnect{
+		Reconnect: &ReconnectMessage{
+			ClientId: clientId,
+			Secret:   secret,
+		},
+	}
+}
+
+func NewSettings(player *objects.Player) Msg {
+	return &Packet_Settings{
+		Settings: &SettingsMessage{
+			ChatEnabled:    player.Settings.ChatEnabled,
+			MinimapEnabled: player.Settings.MinimapEnabled,
+			AutoCollect:    player.Settings.AutoCollect,
+			Color:          player.Color,
+			SkinId:         player.SkinId,
+			SelfEcho:       SelfEcho(player.Settings.SelfEcho),
+			HapticsEnabled: player.Settings.HapticsEnabled,
+		},
+	}
+}
+
+func NewSporesDespawned(sporeIds []uint64) Msg {
+	return &Packet_SporesDespawned{
+		SporesDespawned: &SporesDespawnedMessage{
+			SporeIds: sporeIds,
+		},
+	}
+}
+
+func NewSpectate(targetId uint64) Msg {
+	return &Packet_Spectate{
+		Spectate: &SpectateMessage{
+			TargetId: targetId,
+		},
+	}
+}
+
+func NewSpectateEnded(reason string) Msg {
+	return &Packet_SpectateEnded{
+		SpectateEnded: &SpectateEndedMessage{
+			Reason: reason,
+		},
+	}
+}
+
+func NewChatHistory(entries []objects.ChatEntry) Msg {
+	entryMessages := make([]*ChatHistoryEntryMessage, 0, len(entries))
+	for _, entry := range entries {
+		entryMessages = append(entryMessages, &ChatHistoryEntryMessage{
+			SenderId:   entry.SenderId,
+			SenderName: entry.SenderName,
+			Msg:        entry.Message,
+			SentAt:     entry.SentAt.UnixMilli(),
+		})
+	}
+
+	return &Packet_ChatHistory{
+		ChatHistory: &ChatHistoryMessage{
+			Entries: entryMessages,
+		},
+	}
+}
+
+func NewEvent(name string, active bool, timeLeft time.Duration, massMultiplier, sporeRateMultiplier float64) Msg {
+	return &Packet_Event{
+		Event: &EventMessage{
+			Name:                name,
+			Active:              active,
+			SecondsLeft:         uint32(math.Ceil(max(timeLeft.Seconds(), 0))),
+			MassMultiplier:      massMultiplier,
+			SporeRateMultiplier: sporeRateMultiplier,
+		},
+	}
+}
+
+func NewAchievementUnlocked(id string, name string) Msg {
+	return &Packet_AchievementUnlocked{
+		AchievementUnlocked: &AchievementUnlockedMessage{
+			Id:   id,
+			Name: name,
+		},
+	}
+}
+
+func NewChatError(reason string) Msg {
+	return &Packet_ChatError{
+		ChatError: &ChatErrorMessage{
+			Reason: reason,
+		},
+	}
+}
+
+func NewTyping(playerId uint64, typing bool) Msg {
+	return &Packet_Typing{
+		Typing: &TypingMessage{
+			PlayerId: playerId,
+			Typing:   typing,
+		},
+	}
+}
+
+func NewMyRank(rank uint32, totalPlayers uint32, mass float64) Msg {
+	return &Packet_MyRank{
+		MyRank: &MyRankMessage{
+			Rank:         rank,
+			TotalPlayers: totalPlayers,
+			Mass:         uint64(math.Round(mass)),
+		},
+	}
+}
+
+func NewConnectionStats(rtt time.Duration, tickRate float64, droppedPackets uint64) Msg {
+	return &Packet_ConnectionStats{
+		ConnectionStats: &ConnectionStatsMessage{
+			PingMs:         uint32(rtt.Milliseconds()),
+			TickRate:       tickRate,
+			DroppedPackets: droppedPackets,
+		},
+	}
+}
+
+func NewMatchSummary(session objects.SessionStats) Msg {
+	return &Packet_MatchSummary{
+		MatchSummary: &MatchSummaryMessage{
+			SporesEaten:  uint32(session.SporesEaten),
+			PlayersEaten: uint32(session.PlayersEaten),
+			TimesEaten:   uint32(session.TimesEaten),
+			MaxMass:      uint64(math.Round(session.MaxMass)),
+			Distance:     uint64(math.Round(session.Distance)),
+		},
+	}
+}
+
+func NewGameOver(finalMass float64, maxMass float64, rank uint32, total uint32, sessionLength time.Duration) Msg {
+	return &Packet_GameOver{
+		GameOver: &GameOverMessage{
+			FinalMass:     uint64(math.Round(finalMass)),
+			MaxMass:       uint64(math.Round(maxMass)),
+			Rank:          rank,
+			Total:         total,
+			SessionLength: sessionLength.Seconds(),
+		},
+	}
+}
+
+func NewServerInfo(name string, version string, clients int, players int, maxPlayers int, gameMode string, uptimeSeconds int64) Msg {
+	return &Packet_ServerInfo{
+		ServerInfo: &ServerInfoMessage{
+			Name:          name,
+			Version:       version,
+			Clients:       uint32(clients),
+			Players:       uint32(players),
+			MaxPlayers:    uint32(maxPlayers),
+			GameMode:      gameMode,
+			UptimeSeconds: uint64(uptimeSeconds),
+		},
+	}
+}
+
+func NewBatchConsume(sporeIds []uint64, mass float64, feedback ConsumeFeedback) Msg {
+	return &Packet_BatchConsume{
+		BatchConsume: &BatchConsumeMessage{
+			SporeIds: sporeIds,
+			Mass:     mass,
+			Feedback: feedback,
+		},
+	}
+}
+
+func NewFrozen(frozen bool) Msg {
+	return &Packet_Frozen{
+		Frozen: &FrozenMessage{
+			Frozen: frozen,
+		},
+	}
+}
+
+func NewHaptic(kind HapticKind, intensity float64) Msg {
+	return &Packet_Haptic{
+		Haptic: &HapticMessage{
+			Kind:      kind,
+			Intensity: intensity,
+		},
+	}
+}
+
+func NewResync(id uint64, player *objects.Player, players map[uint64]*objects.Player, spores map[uint64]*objects.Spore, bound *objects.WorldBound) Msg {
+	playerMessages := make([]*PlayerMessage, 0, len(players))
+	for playerId, other := range players {
+		playerMessages = append(playerMessages, newPlayerMessage(playerId, other, bound))
+	}
+	sporeMessages := make([]*SporeMessage, 0, len(spores))
+	for sporeId, spore := range spores {
+		sporeMessages = append(sporeMessages, newSporeMessage(sporeId, spore, bound))
+	}
+
+	return &Packet_Resync{
+		Resync: &ResyncMessage{
+			Player:  newPlayerMessage(id, player, bound),
+			Players: playerMessages,
+			Spores:  sporeMessages,
+		},
+	}
+}
+
+func NewPlayerJoined(id uint64, player *objects.Player) Msg {
+	return &Packet_PlayerJoined{
+		PlayerJoined: &PlayerJoinedMessage{
+			Id:    id,
+			Name:  player.Name,
+			Color: player.Color,
+		},
+	}
+}
+
+func NewPlayerLeft(id uint64) Msg {
+	return &Packet_PlayerLeft{
+		PlayerLeft: &PlayerLeftMessage{
+			Id: id,
+		},
+	}
+}
+
+func NewChangeColor(playerId uint64, color int32, skinId uint32) Msg {
+	return &Packet_ChangeColor{
+		ChangeColor: &ChangeColorMessage{
+			PlayerId: playerId,
+			Color:    color,
+			SkinId:   skinId,
+		},
+	}
+}
+
+func NewMute(targetId uint64) Msg {
+	return &Packet_Mute{
+		Mute: &MuteMessage{
+			TargetId: targetId,
+		},
+	}
+}
+
+func NewUnmute(targetId uint64) Msg {
+	return &Packet_Unmute{
+		Unmute: &UnmuteMessage{
+			TargetId: targetId,
+		},
+	}
+}
+
+func NewGameConfig(worldBound float64) Msg {
+	return &Packet_GameConfig{
+		GameConfig: &GameConfigMessage{
+			WorldBound: worldBound,
+		},
+	}
+}
+
+func NewLeaderboard(entries []objects.LeaderboardEntry) Msg {
+	entryMessages := make([]*LeaderboardEntryMessage, 0, len(entries))
+	for i, entry := range entries {
+		entryMessages = append(entryMessages, &LeaderboardEntryMessage{
+			Id:   entry.Id,
+			Name: entry.Name,
+			Mass: uint64(math.Round(entry.Mass)),
+			Rank: uint32(i + 1),
+		})
+	}
+
+	return &Packet_Leaderboard{
+		Leaderboard: &LeaderboardMessage{
+			Entries: entryMessages,
+		},
+	}
+}
+
+// Only the entries that changed (with their new rank) and the ids of the players no longer on the leaderboard
+func NewLeaderboardDelta(changed []objects.RankedEntry, removedIds []uint64) Msg {
+	entryMessages := make([]*LeaderboardEntryMessage, 0, len(changed))
+	for _, entry := range changed {
+		entryMessages = append(entryMessages, &LeaderboardEntryMessage{
+			Id:   entry.Id,
+			Name: entry.Name,
+			Mass: uint64(math.Round(entry.Mass)),
+			Rank: entry.Rank,
+		})
+	}
+
+	return &Packet_LeaderboardDelta{
+		LeaderboardDelta: &LeaderboardDeltaMessage{
+			Changed:    entryMessages,
+			RemovedIds: removedIds,
+		},
+	}
+}
+
+func NewUpdateRequired(minVersion string) Msg {
+	return &Packet_UpdateRequired{
+		UpdateRequired: &UpdateRequiredMessage{
+			MinVersion: minVersion,
+		},
+	}
+}
+
+func NewError(message string) Msg {
+	return &Packet_Error{
+		Error: &ErrorMessage{
+			Message: message,
+		},
+	}
+}
+
+// A player on the minimap, already snapped to its grid cell
+type MinimapPlayer struct {
+	Id    uint64
+	CellX int
+	CellY int
+	Mass  float64
+}
+
+func NewMinimap(gridSize uint32, players []MinimapPlayer, sporeDensity []uint32) Msg {
+	playerMessages := make([]*MinimapPlayerMessage, 0, len(players))
+	for _, player := range players {
+		playerMessages = append(playerMessages, &MinimapPlayerMessage{
+			Id:    player.Id,
+			CellX: uint32(player.CellX),
+			CellY: uint32(player.CellY),
+			Mass:  uint64(math.Round(player.Mass)),
+		})
+	}
+
+	return &Packet_Minimap{
+		Minimap: &MinimapMessage{
+			GridSize:     gridSize,
+			Players:      playerMessages,
+			SporeDensity: sporeDensity,
+		},
+	}
+}
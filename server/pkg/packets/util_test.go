@@ -0,0 +1,43 @@
+package packets
+
+import (
+	"bytes"
+	"log"
+	"server/internal/server/objects"
+	"strings"
+	"testing"
+)
+
+// Everything the standard logger writes while fn runs
+func captureLog(fn func()) string {
+	var out bytes.Buffer
+	previous := log.Writer()
+	log.SetOutput(&out)
+	defer log.SetOutput(previous)
+	fn()
+	return out.String()
+}
+
+func TestPositionsOutsideTheWorldAreLogged(t *testing.T) {
+	bound := objects.NewWorldBound(1000)
+
+	logged := captureLog(func() {
+		NewPlayer(1, &objects.Player{X: 999, Y: -999}, bound)
+		NewSpore(2, &objects.Spore{X: 0, Y: 0}, bound)
+	})
+	if logged != "" {
+		t.Errorf("positions inside the bound were logged: %q", logged)
+	}
+
+	var message Msg
+	logged = captureLog(func() {
+		message = NewSpore(3, &objects.Spore{X: 1500, Y: 0}, bound)
+	})
+	if !strings.Contains(logged, "spore 3") {
+		t.Errorf("a spore outside the bound wasn't logged, got %q", logged)
+	}
+	//It's only a warning, the spore still goes out where it is
+	if spore := message.(*Packet_Spore).Spore; spore.X != 1500 {
+		t.Errorf("spore was sent at x %f, want 1500", spore.X)
+	}
+}